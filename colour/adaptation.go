@@ -0,0 +1,236 @@
+package colour
+
+import (
+	"errors"
+	"fmt"
+	"image/color" //nolint:misspell
+)
+
+// These are the white points of some standard illuminants for the CIE 1931
+// 2 degree standard observer. They are given as XYZ values normalised so
+// that Y is 1.
+//
+//nolint:mnd
+var (
+	// WhiteA is the white point of CIE standard illuminant A, a tungsten
+	// filament lamp (a blackbody radiator at about 2856K)
+	WhiteA = XYZ{X: 1.09850, Y: 1, Z: 0.35585}
+	// WhiteC is the white point of CIE illuminant C, average daylight
+	// (deprecated in favour of D65)
+	WhiteC = XYZ{X: 0.98074, Y: 1, Z: 1.18232}
+	// WhiteD50 is the white point of CIE illuminant D50, horizon light. It
+	// is the white point used by ICC profiles and in the printing industry
+	WhiteD50 = XYZ{X: 0.96422, Y: 1, Z: 0.82521}
+	// WhiteD55 is the white point of CIE illuminant D55, mid-morning or
+	// mid-afternoon daylight
+	WhiteD55 = XYZ{X: 0.95682, Y: 1, Z: 0.92149}
+	// WhiteD65 is the white point of CIE standard illuminant D65, noon
+	// daylight. It is the white point of the sRGB colour space
+	WhiteD65 = XYZ{X: 0.95047, Y: 1, Z: 1.08883}
+	// WhiteD75 is the white point of CIE illuminant D75, north sky daylight
+	WhiteD75 = XYZ{X: 0.94972, Y: 1, Z: 1.22638}
+	// WhiteE is the white point of CIE illuminant E, the equal energy
+	// radiator
+	WhiteE = XYZ{X: 1, Y: 1, Z: 1}
+	// WhiteF2 is the white point of CIE illuminant F2, a cool white
+	// fluorescent lamp
+	WhiteF2 = XYZ{X: 0.99187, Y: 1, Z: 0.67395}
+	// WhiteF7 is the white point of CIE illuminant F7, a broad-band daylight
+	// fluorescent lamp
+	WhiteF7 = XYZ{X: 0.95044, Y: 1, Z: 1.08755}
+	// WhiteF11 is the white point of CIE illuminant F11, a narrow
+	// tri-band fluorescent lamp
+	WhiteF11 = XYZ{X: 1.00966, Y: 1, Z: 0.64370}
+)
+
+// BlackbodyWhite returns the white point of a blackbody radiator at the
+// given temperature (in Kelvin), normalised so that Y is 1. The
+// temperature must be between MinKelvin and MaxKelvin inclusive, otherwise
+// an error is returned.
+func BlackbodyWhite(kelvin float64) (XYZ, error) {
+	if err := checkKelvin(kelvin); err != nil {
+		return XYZ{}, err
+	}
+
+	return blackbodyXYZ(kelvin), nil
+}
+
+// CAT identifies a chromatic adaptation transform. This is a way of
+// predicting how a colour seen under light of one white point will appear
+// under light of a different white point.
+type CAT int
+
+// These are the available chromatic adaptation transforms. They differ in
+// the cone response space in which the white points are matched.
+const (
+	XYZScaling CAT = iota // XYZ scaling
+	VonKries              // von Kries
+	Bradford              // Bradford
+	CAT02                 // CAT02
+	CAT16                 // CAT16
+)
+
+// catMatrices maps each CAT to the matrix which converts XYZ values into
+// the associated cone response space.
+//
+//nolint:mnd
+var catMatrices = map[CAT]matrix3{
+	XYZScaling: {
+		{1, 0, 0},
+		{0, 1, 0},
+		{0, 0, 1},
+	},
+	// the Hunt-Pointer-Estevez matrix normalised to D65
+	VonKries: {
+		{0.40024, 0.70760, -0.08081},
+		{-0.22630, 1.16532, 0.04570},
+		{0, 0, 0.91822},
+	},
+	Bradford: {
+		{0.8951, 0.2664, -0.1614},
+		{-0.7502, 1.7135, 0.0367},
+		{0.0389, -0.0685, 1.0296},
+	},
+	CAT02: {
+		{0.7328, 0.4296, -0.1624},
+		{-0.7036, 1.6975, 0.0061},
+		{0.0030, 0.0136, 0.9834},
+	},
+	CAT16: {
+		{0.401288, 0.650173, -0.051461},
+		{-0.250268, 1.204414, 0.045854},
+		{-0.002079, 0.048952, 0.953127},
+	},
+}
+
+// IsValid returns true if cat is a recognised chromatic adaptation
+// transform, false otherwise.
+func (cat CAT) IsValid() bool {
+	_, ok := catMatrices[cat]
+
+	return ok
+}
+
+// errBadWhitePoint is returned when a white point cannot be used for
+// chromatic adaptation
+var errBadWhitePoint = errors.New("the white point must have a Y value > 0")
+
+// matrix returns the matrix which adapts XYZ values seen under the from
+// white point to the corresponding values under the to white point. It
+// returns a non-nil error if the CAT is not valid or if either white point
+// has a Y value that is not greater than zero.
+func (cat CAT) matrix(from, to XYZ) (matrix3, error) {
+	m, ok := catMatrices[cat]
+	if !ok {
+		return matrix3{}, fmt.Errorf("bad chromatic adaptation transform: %d",
+			int(cat))
+	}
+
+	if from.Y <= 0 || to.Y <= 0 {
+		return matrix3{}, errBadWhitePoint
+	}
+
+	src := m.apply(from.vec())
+	dst := m.apply(to.vec())
+
+	for i := range src {
+		if src[i] == 0 {
+			return matrix3{},
+				fmt.Errorf("the white point (%s) has no %s cone response",
+					from, cat)
+		}
+	}
+
+	gain := diagonal(
+		[3]float64{dst[0] / src[0], dst[1] / src[1], dst[2] / src[2]})
+
+	return m.inverse().mul(gain).mul(m), nil
+}
+
+// Adapt returns the XYZ value which, seen under light with the to white
+// point, corresponds to the given XYZ value seen under light with the from
+// white point. It returns a non-nil error if the CAT is not valid or if
+// either white point has a Y value that is not greater than zero.
+func (cat CAT) Adapt(xyz, from, to XYZ) (XYZ, error) {
+	m, err := cat.matrix(from, to)
+	if err != nil {
+		return xyz, err
+	}
+
+	return makeXYZ(m.apply(xyz.vec())), nil
+}
+
+// AdaptRGBA returns the colour which, seen under light with the to white
+// point, corresponds to the given colour seen under light with the from
+// white point. The alpha value is unchanged. It returns a non-nil error if
+// the CAT is not valid or if either white point has a Y value that is not
+// greater than zero.
+func (cat CAT) AdaptRGBA(
+	c color.RGBA, from, to XYZ, //nolint:misspell
+) (
+	color.RGBA, error, //nolint:misspell
+) {
+	xyz, err := cat.Adapt(RGBA2XYZ(c), from, to)
+	if err != nil {
+		return c, err
+	}
+
+	adapted := xyz.ToRGBA()
+	adapted.A = c.A
+
+	return adapted, nil
+}
+
+// WhiteBalancer holds a correction which maps some reference colour, which
+// should be white (or grey), to a neutral colour of the same luminance. The
+// same correction can then be applied to other colours.
+type WhiteBalancer struct {
+	m matrix3
+}
+
+// MakeWhiteBalancer returns a WhiteBalancer which will map the given
+// reference colour to the neutral (D65) grey with the same luminance using
+// the given chromatic adaptation transform. It returns a non-nil error if
+// the reference colour is black or if the CAT is not valid.
+func MakeWhiteBalancer(
+	white color.RGBA, cat CAT, //nolint:misspell
+) (
+	WhiteBalancer, error,
+) {
+	from := RGBA2XYZ(white)
+	if from.Y == 0 {
+		return WhiteBalancer{},
+			errors.New("the reference white colour must not be black")
+	}
+
+	from = XYZ{X: from.X / from.Y, Y: 1, Z: from.Z / from.Y}
+
+	m, err := cat.matrix(from, WhiteD65)
+	if err != nil {
+		return WhiteBalancer{}, err
+	}
+
+	return WhiteBalancer{
+		m: xyzToLinearSRGB.mul(m).mul(linearSRGBToXYZ),
+	}, nil
+}
+
+// Balance returns the colour with the white balance correction applied. The
+// alpha value is unchanged.
+func (wb WhiteBalancer) Balance(c color.RGBA) color.RGBA { //nolint:misspell
+	return linearToRGBA(wb.m.apply(rgbLinear(c)), c.A)
+}
+
+// WhiteBalance returns the colour corrected so that the reference white
+// colour would be mapped to a neutral grey. It uses the Bradford chromatic
+// adaptation transform. It returns a non-nil error if the reference colour
+// is black. If you want to correct many colours you should use a
+// [WhiteBalancer] which will be more efficient.
+func WhiteBalance(c, white color.RGBA) (color.RGBA, error) { //nolint:misspell
+	wb, err := MakeWhiteBalancer(white, Bradford)
+	if err != nil {
+		return c, err
+	}
+
+	return wb.Balance(c), nil
+}
@@ -0,0 +1,187 @@
+package colour
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/nickwells/colour.mod/v2/colourtesthelper"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestCATMatrix(t *testing.T) {
+	// the expected matrix is as given by Bruce Lindbloom
+	expD65ToD50 := matrix3{
+		{1.0478112, 0.0228866, -0.0501270},
+		{0.0295424, 0.9904844, -0.0170491},
+		{-0.0092345, 0.0150436, 0.7521316},
+	}
+
+	m, err := Bradford.matrix(WhiteD65, WhiteD50)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	for i := range 3 {
+		for j := range 3 {
+			const epsilon = 0.00001
+			testhelper.DiffFloat(t, "Bradford: D65 to D50",
+				fmt.Sprintf("matrix[%d][%d]", i, j),
+				m[i][j], expD65ToD50[i][j], epsilon)
+		}
+	}
+}
+
+func TestCATAdapt(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		cat      CAT
+		from, to XYZ
+	}{
+		{
+			ID: testhelper.MkID("bad CAT"),
+			ExpErr: testhelper.MkExpErr(
+				"bad chromatic adaptation transform: 99"),
+			cat:  CAT(99),
+			from: WhiteD65,
+			to:   WhiteD50,
+		},
+		{
+			ID: testhelper.MkID("bad white point"),
+			ExpErr: testhelper.MkExpErr(
+				"the white point must have a Y value > 0"),
+			cat:  Bradford,
+			from: XYZ{},
+			to:   WhiteD50,
+		},
+		{
+			ID:   testhelper.MkID("XYZ scaling: D65 to D50"),
+			cat:  XYZScaling,
+			from: WhiteD65,
+			to:   WhiteD50,
+		},
+		{
+			ID:   testhelper.MkID("von Kries: A to D65"),
+			cat:  VonKries,
+			from: WhiteA,
+			to:   WhiteD65,
+		},
+		{
+			ID:   testhelper.MkID("Bradford: D50 to D65"),
+			cat:  Bradford,
+			from: WhiteD50,
+			to:   WhiteD65,
+		},
+		{
+			ID:   testhelper.MkID("CAT02: F11 to D55"),
+			cat:  CAT02,
+			from: WhiteF11,
+			to:   WhiteD55,
+		},
+		{
+			ID:   testhelper.MkID("CAT16: D75 to E"),
+			cat:  CAT16,
+			from: WhiteD75,
+			to:   WhiteE,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			xyz, err := tc.cat.Adapt(tc.from, tc.from, tc.to)
+			if testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc) &&
+				err == nil {
+				const epsilon = 0.000001
+				testhelper.DiffFloat(t, tc.IDStr(), "X",
+					xyz.X, tc.to.X, epsilon)
+				testhelper.DiffFloat(t, tc.IDStr(), "Y",
+					xyz.Y, tc.to.Y, epsilon)
+				testhelper.DiffFloat(t, tc.IDStr(), "Z",
+					xyz.Z, tc.to.Z, epsilon)
+			}
+		})
+	}
+}
+
+func TestCATAdaptRGBA(t *testing.T) {
+	tan := rgba{R: 0xc0, G: 0x90, B: 0x60, A: 0x80}
+
+	for _, cat := range []CAT{XYZScaling, VonKries, Bradford, CAT02, CAT16} {
+		id := cat.String() + ": round trip"
+
+		c, err := cat.AdaptRGBA(tan, WhiteD65, WhiteD50)
+		if err != nil {
+			t.Error(id, "unexpected error:", err)
+			continue
+		}
+
+		c, err = cat.AdaptRGBA(c, WhiteD50, WhiteD65)
+		if err != nil {
+			t.Error(id, "unexpected error:", err)
+			continue
+		}
+
+		colourtesthelper.DiffRGBA(t, id, "adapted colour", c, tan)
+	}
+}
+
+func TestWhiteBalance(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		c, white rgba
+		expC     rgba
+	}{
+		{
+			ID: testhelper.MkID("black reference"),
+			ExpErr: testhelper.MkExpErr(
+				"the reference white colour must not be black"),
+			c:     rgba{R: 0x12, G: 0x34, B: 0x56, A: 0xff},
+			white: rgba{A: 0xff},
+			expC:  rgba{R: 0x12, G: 0x34, B: 0x56, A: 0xff},
+		},
+		{
+			ID:    testhelper.MkID("neutral reference"),
+			c:     rgba{R: 0x12, G: 0x34, B: 0x56, A: 0xff},
+			white: rgba{R: 0x80, G: 0x80, B: 0x80, A: 0xff},
+			expC:  rgba{R: 0x12, G: 0x34, B: 0x56, A: 0xff},
+		},
+		{
+			ID:    testhelper.MkID("warm reference"),
+			c:     rgba{R: 0xff, G: 0xe0, B: 0xc0, A: 0xff},
+			white: rgba{R: 0xff, G: 0xe0, B: 0xc0, A: 0xff},
+			expC:  rgba{R: 0xe5, G: 0xe5, B: 0xe5, A: 0xff},
+		},
+		{
+			ID:    testhelper.MkID("cool reference, warm colour"),
+			c:     rgba{R: 0xc0, G: 0x80, B: 0x40, A: 0x40},
+			white: rgba{R: 0xc0, G: 0xd0, B: 0xff, A: 0xff},
+			expC:  rgba{R: 0xc9, G: 0x7f, B: 0x29, A: 0x40},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			c, err := WhiteBalance(tc.c, tc.white)
+			testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc)
+			colourtesthelper.DiffRGBA(t, tc.IDStr(), "balanced colour",
+				c, tc.expC)
+		})
+	}
+}
+
+func TestBlackbodyWhite(t *testing.T) {
+	_, err := BlackbodyWhite(MinKelvin - 1)
+	if err == nil {
+		t.Error("an error was expected for a temperature below MinKelvin")
+	}
+
+	w, err := BlackbodyWhite(2856)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	const epsilon = 0.001
+	testhelper.DiffFloat(t, "Illuminant A", "X", w.X, WhiteA.X, epsilon)
+	testhelper.DiffFloat(t, "Illuminant A", "Z", w.Z, WhiteA.Z, epsilon)
+}
@@ -63,6 +63,8 @@ func distSquared(target, c rgba) int {
 // equal to the given proximity from the given colour amongst the
 // Families. The notion of 'closeness' is those colours having the smallest
 // sum of squares of differences between the red, green and blue components.
+// Fully transparent colours are only matched by a fully transparent target
+// colour.
 //
 // If proximity is equal to zero only exact matches will be returned. If
 // proximity is greater than or equal to MaxColourProximity all colours will
@@ -138,7 +140,8 @@ func coloursWithin(familyColours []FamilyColour, dist int) []FamilyColour {
 // ClosestN returns up to n colours closest to the given colour amongst the
 // Families. The notion of 'closeness' is those colours having the smallest
 // sum of squares of differences between the red, green and blue components
-// of that colour and the target colour 'target'.
+// of that colour and the target colour 'target'. Fully transparent colours
+// are only matched by a fully transparent target colour.
 //
 // The resulting slice may contain fewer than n entries if there are fewer
 // than n distinct colours in the collection of Families.
@@ -202,7 +205,12 @@ func nClosestColours(familyColours []FamilyColour, n int) []FamilyColour {
 
 // generateDists generates the proximities from the target colour for all the
 // colours in all the Families. The Families should have already been checked
-// for validity.
+// for validity. The CSS4 system colours, whose values depend on a
+// CSSSystemTheme, are not included. A fully transparent colour (such as the
+// CSS4 "transparent") is only included if the target colour is also fully
+// transparent; otherwise, as the alpha value does not contribute to the
+// distance, it would match any colour with the same red, green and blue
+// values.
 func (fl Families) generateDists(target rgba) []FamilyColour {
 	results := []FamilyColour{}
 
@@ -214,6 +222,10 @@ func (fl Families) generateDists(target rgba) []FamilyColour {
 
 		for _, fc := range fi.colours {
 			for name, c := range fc.cMap {
+				if c.A == 0 && target.A != 0 {
+					continue
+				}
+
 				results = append(results,
 					FamilyColour{
 						dist:   distSquared(target, c),
@@ -237,3 +249,47 @@ func (fl Families) getSortedDists(target rgba) []FamilyColour {
 
 	return familyColours
 }
+
+// Recolour returns a Transform which replaces a colour with the closest
+// colour amongst the Families, using the same notion of 'closeness' as
+// ClosestN. Where two colours are equally close the choice between them is
+// made consistently. The alpha value of the colour is preserved.
+//
+// If no families are given then the standard families are used. An error
+// is returned if the Families are invalid.
+func (fl Families) Recolour() (Transform, error) {
+	if len(fl) == 0 {
+		fl = standardFamilies
+	}
+
+	if err := fl.Check(); err != nil {
+		return nil, err
+	}
+
+	familyColours := fl.getSortedDists(rgba{})
+
+	colours := make([]rgba, 0, len(familyColours))
+	for _, fc := range familyColours {
+		c := fc.Colour
+		c.A = math.MaxUint8
+
+		if !slices.Contains(colours, c) {
+			colours = append(colours, c)
+		}
+	}
+
+	return func(c rgba) rgba {
+		closest := colours[0]
+		closestDist := distSquared(c, closest)
+
+		for _, fc := range colours[1:] {
+			if d := distSquared(c, fc); d < closestDist {
+				closest, closestDist = fc, d
+			}
+		}
+
+		closest.A = c.A
+
+		return closest
+	}, nil
+}
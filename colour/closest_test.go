@@ -4,6 +4,7 @@ import (
 	"slices"
 	"testing"
 
+	"github.com/nickwells/colour.mod/v2/colourtesthelper"
 	"github.com/nickwells/testhelper.mod/v2/testhelper"
 )
 
@@ -374,3 +375,51 @@ func TestFamilyColour_FullNames(t *testing.T) {
 		})
 	}
 }
+
+func TestRecolour(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		fl        Families
+		c         rgba
+		expColour rgba
+	}{
+		{
+			ID:     testhelper.MkID("bad family"),
+			ExpErr: testhelper.MkExpErr(`"nonesuch" is not a valid Family`),
+			fl:     Families{"nonesuch"},
+		},
+		{
+			ID:        testhelper.MkID("CGA: exact"),
+			fl:        Families{CGAColours},
+			c:         rgba{R: 0xff, A: 0xff},
+			expColour: rgba{R: 0xff, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("CGA: orange"),
+			fl:        Families{CGAColours},
+			c:         rgba{R: 0xff, G: 0x80, A: 0xff},
+			expColour: rgba{R: 0x80, G: 0x80, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("CGA: grey, with alpha"),
+			fl:        Families{CGAColours},
+			c:         rgba{R: 0x80, G: 0x80, B: 0x81, A: 0x40},
+			expColour: rgba{R: 0x80, G: 0x80, B: 0x80, A: 0x40},
+		},
+		{
+			ID:        testhelper.MkID("standard families"),
+			c:         rgba{R: 0xfe, G: 0xfe, B: 0xfe, A: 0xff},
+			expColour: rgba{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+		},
+	}
+
+	for _, tc := range testCases {
+		tr, err := tc.fl.Recolour()
+		if testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc) &&
+			err == nil {
+			colourtesthelper.DiffRGBA(t, tc.IDStr(), "colour",
+				tr(tc.c), tc.expColour)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package colour
 
 import (
 	"regexp"
+	"strings"
 )
 
 // repl represents a regular expression and an associated substitution to be
@@ -21,8 +22,28 @@ type replPair struct {
 var (
 	apostropheRE = regexp.MustCompile(`'`)
 	spaceRE      = regexp.MustCompile(`\s+`)
+
+	// diacriticFolder replaces letters with diacritics (as used in the
+	// romanisations of Japanese and Chinese and in names taken from French,
+	// German or Spanish) with the plain ASCII letters, the German sharp s
+	// with "ss" and the typographic apostrophe with the ASCII one
+	diacriticFolder = strings.NewReplacer(
+		"ā", "a", "á", "a", "ǎ", "a", "à", "a", "â", "a", "ä", "a",
+		"ē", "e", "é", "e", "ě", "e", "è", "e", "ê", "e", "ë", "e",
+		"ī", "i", "í", "i", "ǐ", "i", "ì", "i", "î", "i", "ï", "i",
+		"ō", "o", "ó", "o", "ǒ", "o", "ò", "o", "ô", "o", "ö", "o",
+		"ū", "u", "ú", "u", "ǔ", "u", "ù", "u", "û", "u",
+		"ǖ", "u", "ǘ", "u", "ǚ", "u", "ǜ", "u", "ü", "u",
+		"ç", "c", "ñ", "n", "ß", "ss", "’", "'",
+	)
 )
 
+// foldDiacritics returns the name with any letters having diacritics
+// replaced by the plain ASCII letters
+func foldDiacritics(name string) string {
+	return diacriticFolder.Replace(name)
+}
+
 // makeSpellings constructs a slice of replPairs from an internal list
 // of word pairs representing UK and US alternative spellings.
 func makeSpellings() []replPair {
@@ -56,6 +77,7 @@ func makeSpellings() []replPair {
 //
 //	xxx's   -> xxxs
 //	xxx yyy -> xxx-yyy
+//	kōbai   -> kobai
 //
 // it then returns the original map with the alias values added.
 func withAliases(m colourNameToRGBA) colourNameToRGBA {
@@ -113,17 +135,24 @@ func transformColourNames(names []string) map[string]bool {
 
 	for _, name := range names {
 		transformed[name] = true
+		transformed[foldDiacritics(name)] = true
 
 		for _, repl := range replacements {
 			name = repl.re.ReplaceAllString(name, repl.sub)
 		}
 
 		transformed[name] = true
+		transformed[foldDiacritics(name)] = true
 	}
 
 	return transformed
 }
 
+// hasDiacritics returns true if the name has any letters with diacritics
+func hasDiacritics(name string) bool {
+	return foldDiacritics(name) != name
+}
+
 // firstIsUK2ndIsUSA returns true if the first string matches the UK Regexp
 // and the second matches the USA Regexp, false otherwise
 func firstIsUK2ndIsUSA(ukRE, usaRE *regexp.Regexp, s1, s2 string) bool {
@@ -154,6 +183,14 @@ func choosePreferredAlias(s1, s2 string) string {
 		}
 	}
 
+	if hasDiacritics(s1) && !hasDiacritics(s2) {
+		return s1
+	}
+
+	if hasDiacritics(s2) && !hasDiacritics(s1) {
+		return s2
+	}
+
 	spellings := makeSpellings()
 	for _, sp := range spellings {
 		if firstIsUK2ndIsUSA(sp.uk2usa.re, sp.usa2uk.re, s1, s2) {
@@ -202,6 +202,21 @@ func TestTransformColourNames(t *testing.T) {
 				"three-and-four": true,
 			},
 		},
+		{
+			ID: testhelper.MkID("diacritic changes"),
+			names: []string{
+				"kōbai",
+				"rikyū nezumi",
+			},
+			expResults: map[string]bool{
+				"kōbai":        true,
+				"kobai":        true,
+				"rikyū nezumi": true,
+				"rikyu nezumi": true,
+				"rikyū-nezumi": true,
+				"rikyu-nezumi": true,
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -404,6 +419,20 @@ func TestIsAnAlias(t *testing.T) {
 			expIs:   true,
 			expPref: "nancy's grey blushes",
 		},
+		{
+			ID:      testhelper.MkID("with diacritics"),
+			name1:   "kōbai",
+			name2:   "kobai",
+			expIs:   true,
+			expPref: "kōbai",
+		},
+		{
+			ID:      testhelper.MkID("with pinyin tone marks and spaces"),
+			name1:   "cōng lǜ",
+			name2:   "cong-lu",
+			expIs:   true,
+			expPref: "cōng lǜ",
+		},
 	}
 
 	for _, tc := range testCases {
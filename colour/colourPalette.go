@@ -0,0 +1,230 @@
+package colour
+
+import (
+	"errors"
+	"image/color" //nolint:misspell
+	"math"
+	"slices"
+)
+
+// Palette returns the distinct colours in the Family as a color.Palette
+// from the standard library. The colours are sorted using RGBACompare so
+// that the order, and so the index of each colour, is always the same. A
+// non-nil error is returned if the Family is not recognised.
+//
+//nolint:misspell
+func (f Family) Palette() (color.Palette, error) {
+	return Families{f}.Palette()
+}
+
+// Palette returns the distinct colours in each of the Families as a
+// color.Palette from the standard library. The colours are sorted using
+// RGBACompare so that the order, and so the index of each colour, is always
+// the same. A non-nil error is returned if any Family in the list is not
+// recognised.
+//
+// If no families are given then the standard families are used.
+//
+//nolint:misspell
+func (fl Families) Palette() (color.Palette, error) {
+	cs, err := fl.AllColours()
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(cs, RGBACompare)
+
+	p := make(color.Palette, 0, len(cs)) //nolint:misspell
+	for _, c := range cs {
+		p = append(p, c)
+	}
+
+	return p, nil
+}
+
+// NamedColourPalette returns the colours of the NamedColours as a
+// color.Palette from the standard library. The colours are given in the
+// same order as the NamedColours; if a colour appears more than once only
+// the first is kept.
+//
+//nolint:misspell
+func NamedColourPalette(ncs []NamedColour) color.Palette {
+	p := make(color.Palette, 0, len(ncs)) //nolint:misspell
+	seen := map[rgba]bool{}
+
+	for _, nc := range ncs {
+		if seen[nc.colour] {
+			continue
+		}
+
+		seen[nc.colour] = true
+
+		p = append(p, nc.colour)
+	}
+
+	return p
+}
+
+// paletteColours returns the entries of the color.Palette with their alpha
+// values not premultiplied, as for all the colours in this package
+//
+//nolint:misspell
+func paletteColours(p color.Palette) []rgba {
+	cs := make([]rgba, 0, len(p))
+
+	for _, pc := range p {
+		nc := color.NRGBAModel.Convert(pc)      //nolint:misspell
+		cs = append(cs, rgba(nc.(color.NRGBA))) //nolint:misspell
+	}
+
+	return cs
+}
+
+// DescribeColourPalette returns a description of each entry in the
+// color.Palette, in the same order as the entries. It searches in the
+// standard families. See [Families.Describe].
+//
+//nolint:misspell
+func DescribeColourPalette(p color.Palette) []string {
+	return standardFamilies.DescribeColourPalette(p)
+}
+
+// DescribeColourPalette returns a description of each entry in the
+// color.Palette, in the same order as the entries. Each entry is described
+// as by [Families.Describe].
+//
+//nolint:misspell
+func (fl Families) DescribeColourPalette(p color.Palette) []string {
+	descs := make([]string, 0, len(p))
+
+	for _, c := range paletteColours(p) {
+		descs = append(descs, fl.Describe(c))
+	}
+
+	return descs
+}
+
+// PerceptualPalette is a palette of colours which can be used in place of
+// the standard library's color.Palette. It implements the color.Model
+// interface but, unlike color.Palette, which finds the closest colour
+// using the distance in the RGBA colour cube, it finds the closest colour
+// using its Metric. Use [MakePerceptualPalette] or
+// [Families.PerceptualPalette] to make one.
+type PerceptualPalette struct {
+	colours []rgba
+	labs    []Oklab
+
+	// Metric is the way that the closest palette colour is found
+	Metric DistanceMetric
+}
+
+// MakePerceptualPalette returns a PerceptualPalette using the given
+// colours. The alpha values of the colours are ignored and duplicate
+// colours are removed. The Metric is set to OklabDistance. An error is
+// returned if there are no colours.
+//
+//nolint:misspell
+func MakePerceptualPalette(cs []color.RGBA) (PerceptualPalette, error) {
+	colours := make([]rgba, 0, len(cs))
+
+	for _, c := range cs {
+		c.A = math.MaxUint8
+		if !slices.Contains(colours, c) {
+			colours = append(colours, c)
+		}
+	}
+
+	if len(colours) == 0 {
+		return PerceptualPalette{},
+			errors.New("there are no colours in the palette")
+	}
+
+	pp := PerceptualPalette{
+		colours: colours,
+		labs:    make([]Oklab, 0, len(colours)),
+		Metric:  OklabDistance,
+	}
+
+	for _, c := range colours {
+		pp.labs = append(pp.labs, RGBA2Oklab(c))
+	}
+
+	return pp, nil
+}
+
+// PerceptualPalette returns a PerceptualPalette using the colours in the
+// Families, sorted using RGBACompare. The Metric is set to OklabDistance.
+// An error is returned if any of the Families is not recognised.
+//
+// If no families are given then the standard families are used.
+func (fl Families) PerceptualPalette() (PerceptualPalette, error) {
+	p, err := fl.Palette()
+	if err != nil {
+		return PerceptualPalette{}, err
+	}
+
+	return MakePerceptualPalette(paletteColours(p))
+}
+
+// Palette returns the colours of the PerceptualPalette as a color.Palette
+// from the standard library, in the same order
+//
+//nolint:misspell
+func (pp PerceptualPalette) Palette() color.Palette {
+	p := make(color.Palette, 0, len(pp.colours)) //nolint:misspell
+	for _, c := range pp.colours {
+		p = append(p, c)
+	}
+
+	return p
+}
+
+// Convert returns the palette colour closest to the given colour. This
+// satisfies the color.Model interface. The alpha value of the given colour
+// is ignored and the returned colour is always opaque.
+//
+//nolint:misspell
+func (pp PerceptualPalette) Convert(c color.Color) color.Color {
+	if len(pp.colours) == 0 {
+		return c
+	}
+
+	return pp.colours[pp.Index(c)]
+}
+
+// Index returns the index of the palette colour closest to the given
+// colour. The alpha value of the given colour is ignored.
+//
+//nolint:misspell
+func (pp PerceptualPalette) Index(c color.Color) int {
+	nc := color.NRGBAModel.Convert(c) //nolint:misspell
+
+	return pp.closest(rgba(nc.(color.NRGBA))) //nolint:misspell
+}
+
+// closest returns the index of the palette colour closest to the colour
+func (pp PerceptualPalette) closest(c rgba) int {
+	best := 0
+
+	switch pp.Metric {
+	case OklabDistance:
+		lab := RGBA2Oklab(c)
+		bestDist := math.Inf(1)
+
+		for i, pLab := range pp.labs {
+			if d := oklabDistSquared(lab, pLab); d < bestDist {
+				best, bestDist = i, d
+			}
+		}
+	default:
+		bestDist := math.MaxInt
+
+		for i, pc := range pp.colours {
+			if d := distSquared(c, pc); d < bestDist {
+				best, bestDist = i, d
+			}
+		}
+	}
+
+	return best
+}
@@ -0,0 +1,233 @@
+package colour
+
+import (
+	"image/color" //nolint:misspell
+	"testing"
+
+	"github.com/nickwells/colour.mod/v2/colourtesthelper"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestFamiliesPalette(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		fl       Families
+		expLen   int
+		expFirst rgba
+		expLast  rgba
+	}{
+		{
+			ID:       testhelper.MkID("web"),
+			fl:       Families{WebColours},
+			expLen:   16,
+			expFirst: rgba{A: 0xff},
+			expLast:  rgba{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+		},
+		{
+			ID:       testhelper.MkID("web and X11"),
+			fl:       Families{WebColours, X11Colours},
+			expLen:   510,
+			expFirst: rgba{A: 0xff},
+			expLast:  rgba{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+		},
+		{
+			ID:     testhelper.MkID("bad family"),
+			ExpErr: testhelper.MkExpErr(`bad colour family: "nonesuch"`),
+			fl:     Families{Family("nonesuch")},
+		},
+	}
+
+	for _, tc := range testCases {
+		p, err := tc.fl.Palette()
+		if !testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc) ||
+			err != nil {
+			continue
+		}
+
+		testhelper.DiffInt(t, tc.IDStr(), "palette size", len(p), tc.expLen)
+
+		cs := paletteColours(p)
+		colourtesthelper.DiffRGBA(t, tc.IDStr(), "first colour",
+			cs[0], tc.expFirst)
+		colourtesthelper.DiffRGBA(t, tc.IDStr(), "last colour",
+			cs[len(cs)-1], tc.expLast)
+
+		for i := 1; i < len(cs); i++ {
+			if RGBACompare(cs[i-1], cs[i]) >= 0 {
+				t.Log(tc.IDStr())
+				t.Errorf("\t: colours %d and %d are out of order", i-1, i)
+			}
+		}
+
+		again, _ := tc.fl.Palette()
+		testhelper.DiffSlice(t, tc.IDStr(), "repeated palette", again, p)
+	}
+}
+
+func TestCSS4Palette(t *testing.T) {
+	p, err := CSS4Colours.Palette()
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	named := map[rgba]bool{}
+	for _, c := range css4Colours {
+		named[c] = true
+	}
+
+	testhelper.DiffInt(t, "CSS4", "palette size", len(p), len(named))
+
+	for _, c := range paletteColours(p) {
+		if !named[c] {
+			t.Errorf("CSS4: %#v is in the palette but is not a named colour", c)
+		}
+	}
+
+	n, err := CSS4Colours.DistinctColourCount()
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	testhelper.DiffInt(t, "CSS4", "distinct colour count", n, len(named))
+}
+
+func TestNamedColourPalette(t *testing.T) {
+	red := rgba{R: 0xff, A: 0xff}
+	blue := rgba{B: 0xff, A: 0xff}
+
+	testCases := []struct {
+		testhelper.ID
+		ncs        []NamedColour
+		expPalette color.Palette //nolint:misspell
+	}{
+		{
+			ID:         testhelper.MkID("none"),
+			expPalette: color.Palette{}, //nolint:misspell
+		},
+		{
+			ID: testhelper.MkID("order kept, duplicates removed"),
+			ncs: []NamedColour{
+				MakeNamedColour("red", red),
+				MakeNamedColour("blue", blue),
+				MakeNamedColour("scarlet", red),
+			},
+			expPalette: color.Palette{red, blue}, //nolint:misspell
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.DiffSlice(t, tc.IDStr(), "palette",
+			NamedColourPalette(tc.ncs), tc.expPalette)
+	}
+}
+
+func TestDescribeColourPalette(t *testing.T) {
+	p := color.Palette{ //nolint:misspell
+		rgba{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+		color.Gray{Y: 0x80}, //nolint:misspell
+		rgba{R: 1, A: 0xff},
+	}
+
+	testhelper.DiffSlice(t, "web", "descriptions",
+		Families{WebColours}.DescribeColourPalette(p),
+		[]string{
+			"white",
+			"gray",
+			"color.RGBA{R:0x01, G:0x00, B:0x00, A:0xff}",
+		})
+}
+
+func TestPerceptualPalette(t *testing.T) {
+	white := rgba{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+	black := rgba{A: 0xff}
+	red := rgba{R: 0xff, A: 0xff}
+	navy := rgba{B: 0x80, A: 0xff}
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		cs        []rgba
+		metric    DistanceMetric
+		c         color.Color //nolint:misspell
+		expIndex  int
+		expColour rgba
+	}{
+		{
+			ID:     testhelper.MkID("no colours"),
+			ExpErr: testhelper.MkExpErr("there are no colours in the palette"),
+		},
+		{
+			ID:        testhelper.MkID("exact match"),
+			cs:        []rgba{black, white, red},
+			metric:    OklabDistance,
+			c:         red,
+			expIndex:  2,
+			expColour: red,
+		},
+		{
+			ID:        testhelper.MkID("alpha ignored"),
+			cs:        []rgba{black, white, red},
+			metric:    OklabDistance,
+			c:         color.NRGBA{R: 0xf0, G: 0x10, A: 0x20}, //nolint:misspell
+			expIndex:  2,
+			expColour: red,
+		},
+		{
+			ID:        testhelper.MkID("dark blue, RGB distance"),
+			cs:        []rgba{black, white, navy},
+			metric:    RGBDistance,
+			c:         rgba{R: 0x30, G: 0x30, B: 0x60, A: 0xff},
+			expIndex:  2,
+			expColour: navy,
+		},
+		{
+			ID:        testhelper.MkID("grey, Oklab distance"),
+			cs:        []rgba{black, white, red},
+			metric:    OklabDistance,
+			c:         color.Gray{Y: 0xc0}, //nolint:misspell
+			expIndex:  1,
+			expColour: white,
+		},
+	}
+
+	for _, tc := range testCases {
+		pp, err := MakePerceptualPalette(tc.cs)
+		if !testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc) ||
+			err != nil {
+			continue
+		}
+
+		testhelper.DiffInt(t, tc.IDStr(), "default metric",
+			pp.Metric, OklabDistance)
+
+		pp.Metric = tc.metric
+
+		testhelper.DiffInt(t, tc.IDStr(), "index", pp.Index(tc.c), tc.expIndex)
+
+		var m color.Model = pp //nolint:misspell
+
+		nc := color.NRGBAModel.Convert(m.Convert(tc.c)) //nolint:misspell
+		colourtesthelper.DiffRGBA(t, tc.IDStr(), "colour",
+			rgba(nc.(color.NRGBA)), tc.expColour) //nolint:misspell
+	}
+}
+
+func TestFamiliesPerceptualPalette(t *testing.T) {
+	pp, err := Families{WebColours}.PerceptualPalette()
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	p, err := WebColours.Palette()
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	testhelper.DiffSlice(t, "web", "palette", pp.Palette(), p)
+
+	_, err = Families{Family("nonesuch")}.PerceptualPalette()
+	if err == nil {
+		t.Error("an error was expected for a bad family")
+	}
+}
@@ -0,0 +1,347 @@
+package colour
+
+import "strings"
+
+// colours from British Standard BS 381C:1996, the colours used for
+// identification, coding and special purposes (including many of the
+// British military and infrastructure colours). Each colour is given by its
+// three-digit number and by its name. BSI do not publish sRGB values for
+// the colours; these are unofficial approximations and should be checked
+// against the physical standard where an exact match matters.
+//
+//nolint:mnd
+var bs381cColours = withAliases(colourNameToRGBA{
+	"101":                         {R: 0x9b, G: 0xb7, B: 0xd4, A: 0xff},
+	"sky blue":                    {R: 0x9b, G: 0xb7, B: 0xd4, A: 0xff},
+	"102":                         {R: 0x00, G: 0xa3, B: 0xb4, A: 0xff},
+	"turquoise blue":              {R: 0x00, G: 0xa3, B: 0xb4, A: 0xff},
+	"103":                         {R: 0x00, G: 0x5f, B: 0x6a, A: 0xff},
+	"peacock blue":                {R: 0x00, G: 0x5f, B: 0x6a, A: 0xff},
+	"104":                         {R: 0x3a, G: 0x6e, B: 0x8f, A: 0xff},
+	"azure blue":                  {R: 0x3a, G: 0x6e, B: 0x8f, A: 0xff},
+	"105":                         {R: 0x1f, G: 0x3a, B: 0x5f, A: 0xff},
+	"oxford blue":                 {R: 0x1f, G: 0x3a, B: 0x5f, A: 0xff},
+	"106":                         {R: 0x1b, G: 0x3f, B: 0x8b, A: 0xff},
+	"royal blue":                  {R: 0x1b, G: 0x3f, B: 0x8b, A: 0xff},
+	"107":                         {R: 0x2e, G: 0x58, B: 0x94, A: 0xff},
+	"strong blue":                 {R: 0x2e, G: 0x58, B: 0x94, A: 0xff},
+	"108":                         {R: 0x2b, G: 0x3d, B: 0x52, A: 0xff},
+	"aircraft blue":               {R: 0x2b, G: 0x3d, B: 0x52, A: 0xff},
+	"109":                         {R: 0x5b, G: 0x7f, B: 0xa6, A: 0xff},
+	"middle blue":                 {R: 0x5b, G: 0x7f, B: 0xa6, A: 0xff},
+	"110":                         {R: 0x2b, G: 0x3a, B: 0x67, A: 0xff},
+	"roundel blue":                {R: 0x2b, G: 0x3a, B: 0x67, A: 0xff},
+	"111":                         {R: 0x9d, G: 0xba, B: 0xd5, A: 0xff},
+	"pale blue":                   {R: 0x9d, G: 0xba, B: 0xd5, A: 0xff},
+	"112":                         {R: 0x7d, G: 0xa2, B: 0xc1, A: 0xff},
+	"arctic blue":                 {R: 0x7d, G: 0xa2, B: 0xc1, A: 0xff},
+	"113":                         {R: 0x40, G: 0x5f, B: 0x89, A: 0xff},
+	"deep saxe blue":              {R: 0x40, G: 0x5f, B: 0x89, A: 0xff},
+	"114":                         {R: 0x2a, G: 0x45, B: 0x59, A: 0xff},
+	"rail blue":                   {R: 0x2a, G: 0x45, B: 0x59, A: 0xff},
+	"115":                         {R: 0x1f, G: 0x4e, B: 0x99, A: 0xff},
+	"cobalt blue":                 {R: 0x1f, G: 0x4e, B: 0x99, A: 0xff},
+	"166":                         {R: 0x34, G: 0x63, B: 0xa0, A: 0xff},
+	"french blue":                 {R: 0x34, G: 0x63, B: 0xa0, A: 0xff},
+	"169":                         {R: 0x1c, G: 0x5a, B: 0x8c, A: 0xff},
+	"traffic blue":                {R: 0x1c, G: 0x5a, B: 0x8c, A: 0xff},
+	"172":                         {R: 0x7f, G: 0x9b, B: 0xbf, A: 0xff},
+	"pale roundel blue":           {R: 0x7f, G: 0x9b, B: 0xbf, A: 0xff},
+	"174":                         {R: 0x2a, G: 0x5b, B: 0x7d, A: 0xff},
+	"orient blue":                 {R: 0x2a, G: 0x5b, B: 0x7d, A: 0xff},
+	"175":                         {R: 0x5c, G: 0x82, B: 0xb4, A: 0xff},
+	"light french blue":           {R: 0x5c, G: 0x82, B: 0xb4, A: 0xff},
+	"210":                         {R: 0xb5, G: 0xc9, B: 0xa6, A: 0xff},
+	"sky":                         {R: 0xb5, G: 0xc9, B: 0xa6, A: 0xff},
+	"216":                         {R: 0xbd, G: 0xd5, B: 0xb2, A: 0xff},
+	"eau de nil":                  {R: 0xbd, G: 0xd5, B: 0xb2, A: 0xff},
+	"217":                         {R: 0x6a, G: 0x9a, B: 0x6b, A: 0xff},
+	"sea green":                   {R: 0x6a, G: 0x9a, B: 0x6b, A: 0xff},
+	"218":                         {R: 0x46, G: 0x6d, B: 0x3a, A: 0xff},
+	"grass green":                 {R: 0x46, G: 0x6d, B: 0x3a, A: 0xff},
+	"219":                         {R: 0x8a, G: 0x9a, B: 0x74, A: 0xff},
+	"sage green":                  {R: 0x8a, G: 0x9a, B: 0x74, A: 0xff},
+	"220":                         {R: 0x4b, G: 0x5a, B: 0x3a, A: 0xff},
+	"olive green":                 {R: 0x4b, G: 0x5a, B: 0x3a, A: 0xff},
+	"221":                         {R: 0x00, G: 0x7a, B: 0x4a, A: 0xff},
+	"brilliant green":             {R: 0x00, G: 0x7a, B: 0x4a, A: 0xff},
+	"222":                         {R: 0x5d, G: 0x63, B: 0x46, A: 0xff},
+	"light bronze green":          {R: 0x5d, G: 0x63, B: 0x46, A: 0xff},
+	"223":                         {R: 0x4a, G: 0x4f, B: 0x3a, A: 0xff},
+	"middle bronze green":         {R: 0x4a, G: 0x4f, B: 0x3a, A: 0xff},
+	"224":                         {R: 0x34, G: 0x3a, B: 0x2c, A: 0xff},
+	"deep bronze green":           {R: 0x34, G: 0x3a, B: 0x2c, A: 0xff},
+	"225":                         {R: 0x39, G: 0x75, B: 0x4c, A: 0xff},
+	"light brunswick green":       {R: 0x39, G: 0x75, B: 0x4c, A: 0xff},
+	"226":                         {R: 0x1f, G: 0x50, B: 0x34, A: 0xff},
+	"mid brunswick green":         {R: 0x1f, G: 0x50, B: 0x34, A: 0xff},
+	"227":                         {R: 0x1c, G: 0x3b, B: 0x2a, A: 0xff},
+	"deep brunswick green":        {R: 0x1c, G: 0x3b, B: 0x2a, A: 0xff},
+	"228":                         {R: 0x0f, G: 0x6b, B: 0x42, A: 0xff},
+	"emerald green":               {R: 0x0f, G: 0x6b, B: 0x42, A: 0xff},
+	"241":                         {R: 0x24, G: 0x40, B: 0x2c, A: 0xff},
+	"dark green":                  {R: 0x24, G: 0x40, B: 0x2c, A: 0xff},
+	"262":                         {R: 0x4d, G: 0x89, B: 0x48, A: 0xff},
+	"bold green":                  {R: 0x4d, G: 0x89, B: 0x48, A: 0xff},
+	"267":                         {R: 0x3a, G: 0x4a, B: 0x32, A: 0xff},
+	"deep chrome green":           {R: 0x3a, G: 0x4a, B: 0x32, A: 0xff},
+	"275":                         {R: 0xc8, G: 0xd7, B: 0xb2, A: 0xff},
+	"opaline green":               {R: 0xc8, G: 0xd7, B: 0xb2, A: 0xff},
+	"276":                         {R: 0x3d, G: 0x4d, B: 0x33, A: 0xff},
+	"lincoln green":               {R: 0x3d, G: 0x4d, B: 0x33, A: 0xff},
+	"277":                         {R: 0x3d, G: 0x4b, B: 0x3b, A: 0xff},
+	"cypress green":               {R: 0x3d, G: 0x4b, B: 0x3b, A: 0xff},
+	"278":                         {R: 0x6e, G: 0x7d, B: 0x52, A: 0xff},
+	"light olive green":           {R: 0x6e, G: 0x7d, B: 0x52, A: 0xff},
+	"279":                         {R: 0x4f, G: 0x5e, B: 0x4a, A: 0xff},
+	"steel furniture green":       {R: 0x4f, G: 0x5e, B: 0x4a, A: 0xff},
+	"280":                         {R: 0x5e, G: 0x9a, B: 0x8a, A: 0xff},
+	"verdigris green":             {R: 0x5e, G: 0x9a, B: 0x8a, A: 0xff},
+	"282":                         {R: 0x3a, G: 0x4e, B: 0x38, A: 0xff},
+	"forest green":                {R: 0x3a, G: 0x4e, B: 0x38, A: 0xff},
+	"283":                         {R: 0x8a, G: 0x9a, B: 0x82, A: 0xff},
+	"aircraft grey green":         {R: 0x8a, G: 0x9a, B: 0x82, A: 0xff},
+	"284":                         {R: 0x5d, G: 0x7b, B: 0x5b, A: 0xff},
+	"spruce green":                {R: 0x5d, G: 0x7b, B: 0x5b, A: 0xff},
+	"285":                         {R: 0x4a, G: 0x53, B: 0x34, A: 0xff},
+	"nato green":                  {R: 0x4a, G: 0x53, B: 0x34, A: 0xff},
+	"298":                         {R: 0x4e, G: 0x4b, B: 0x35, A: 0xff},
+	"olive drab":                  {R: 0x4e, G: 0x4b, B: 0x35, A: 0xff},
+	"309":                         {R: 0xf2, G: 0xd1, B: 0x2c, A: 0xff},
+	"canary yellow":               {R: 0xf2, G: 0xd1, B: 0x2c, A: 0xff},
+	"310":                         {R: 0xe9, G: 0xd9, B: 0x9b, A: 0xff},
+	"primrose":                    {R: 0xe9, G: 0xd9, B: 0x9b, A: 0xff},
+	"315":                         {R: 0xf1, G: 0xe3, B: 0x9b, A: 0xff},
+	"grapefruit":                  {R: 0xf1, G: 0xe3, B: 0x9b, A: 0xff},
+	"320":                         {R: 0x9b, G: 0x6a, B: 0x3c, A: 0xff},
+	"light brown":                 {R: 0x9b, G: 0x6a, B: 0x3c, A: 0xff},
+	"337":                         {R: 0xf1, G: 0xe5, B: 0xb6, A: 0xff},
+	"buttermilk":                  {R: 0xf1, G: 0xe5, B: 0xb6, A: 0xff},
+	"350":                         {R: 0x6e, G: 0x5a, B: 0x3d, A: 0xff},
+	"dark earth":                  {R: 0x6e, G: 0x5a, B: 0x3d, A: 0xff},
+	"352":                         {R: 0xf2, G: 0xe6, B: 0xc5, A: 0xff},
+	"pale cream":                  {R: 0xf2, G: 0xe6, B: 0xc5, A: 0xff},
+	"353":                         {R: 0xee, G: 0xd9, B: 0xa3, A: 0xff},
+	"deep cream":                  {R: 0xee, G: 0xd9, B: 0xa3, A: 0xff},
+	"355":                         {R: 0xef, G: 0xd5, B: 0x4a, A: 0xff},
+	"lemon":                       {R: 0xef, G: 0xd5, B: 0x4a, A: 0xff},
+	"356":                         {R: 0xf0, G: 0xb4, B: 0x00, A: 0xff},
+	"golden yellow":               {R: 0xf0, G: 0xb4, B: 0x00, A: 0xff},
+	"358":                         {R: 0xe3, G: 0xc4, B: 0x8e, A: 0xff},
+	"light buff":                  {R: 0xe3, G: 0xc4, B: 0x8e, A: 0xff},
+	"359":                         {R: 0xd2, G: 0xa8, B: 0x73, A: 0xff},
+	"middle buff":                 {R: 0xd2, G: 0xa8, B: 0x73, A: 0xff},
+	"361":                         {R: 0xd0, G: 0xc0, B: 0x9f, A: 0xff},
+	"light stone":                 {R: 0xd0, G: 0xc0, B: 0x9f, A: 0xff},
+	"362":                         {R: 0xc5, G: 0xa9, B: 0x6b, A: 0xff},
+	"middle stone":                {R: 0xc5, G: 0xa9, B: 0x6b, A: 0xff},
+	"363":                         {R: 0xf4, G: 0xc1, B: 0x00, A: 0xff},
+	"bold yellow":                 {R: 0xf4, G: 0xc1, B: 0x00, A: 0xff},
+	"364":                         {R: 0xbd, G: 0x95, B: 0x66, A: 0xff},
+	"deep buff":                   {R: 0xbd, G: 0x95, B: 0x66, A: 0xff},
+	"365":                         {R: 0xe6, G: 0xd5, B: 0xa8, A: 0xff},
+	"vellum":                      {R: 0xe6, G: 0xd5, B: 0xa8, A: 0xff},
+	"366":                         {R: 0xd8, G: 0xc7, B: 0xa8, A: 0xff},
+	"light beige":                 {R: 0xd8, G: 0xc7, B: 0xa8, A: 0xff},
+	"367":                         {R: 0xe7, G: 0xd1, B: 0x8e, A: 0xff},
+	"manilla":                     {R: 0xe7, G: 0xd1, B: 0x8e, A: 0xff},
+	"368":                         {R: 0xf4, G: 0xb4, B: 0x00, A: 0xff},
+	"traffic yellow":              {R: 0xf4, G: 0xb4, B: 0x00, A: 0xff},
+	"369":                         {R: 0xd9, G: 0xbe, B: 0x93, A: 0xff},
+	"biscuit":                     {R: 0xd9, G: 0xbe, B: 0x93, A: 0xff},
+	"380":                         {R: 0xc9, G: 0xa9, B: 0x7a, A: 0xff},
+	"camouflage desert sand":      {R: 0xc9, G: 0xa9, B: 0x7a, A: 0xff},
+	"384":                         {R: 0xe6, G: 0xd3, B: 0xa3, A: 0xff},
+	"light straw":                 {R: 0xe6, G: 0xd3, B: 0xa3, A: 0xff},
+	"386":                         {R: 0xe8, G: 0xd7, B: 0xb8, A: 0xff},
+	"champagne":                   {R: 0xe8, G: 0xd7, B: 0xb8, A: 0xff},
+	"388":                         {R: 0xd6, G: 0xbc, B: 0x94, A: 0xff},
+	"beige":                       {R: 0xd6, G: 0xbc, B: 0x94, A: 0xff},
+	"389":                         {R: 0xb2, G: 0x9f, B: 0x7c, A: 0xff},
+	"camouflage beige":            {R: 0xb2, G: 0x9f, B: 0x7c, A: 0xff},
+	"397":                         {R: 0xf4, G: 0xd9, B: 0x7e, A: 0xff},
+	"jasmine yellow":              {R: 0xf4, G: 0xd9, B: 0x7e, A: 0xff},
+	"411":                         {R: 0x6e, G: 0x4a, B: 0x2e, A: 0xff},
+	"middle brown":                {R: 0x6e, G: 0x4a, B: 0x2e, A: 0xff},
+	"412":                         {R: 0x4f, G: 0x38, B: 0x28, A: 0xff},
+	"dark brown":                  {R: 0x4f, G: 0x38, B: 0x28, A: 0xff},
+	"413":                         {R: 0x6b, G: 0x4a, B: 0x35, A: 0xff},
+	"nut brown":                   {R: 0x6b, G: 0x4a, B: 0x35, A: 0xff},
+	"414":                         {R: 0xa3, G: 0x6a, B: 0x2f, A: 0xff},
+	"golden brown":                {R: 0xa3, G: 0x6a, B: 0x2f, A: 0xff},
+	"415":                         {R: 0x4a, G: 0x34, B: 0x27, A: 0xff},
+	"imperial brown":              {R: 0x4a, G: 0x34, B: 0x27, A: 0xff},
+	"420":                         {R: 0x4a, G: 0x3b, B: 0x2e, A: 0xff},
+	"dark camouflage brown":       {R: 0x4a, G: 0x3b, B: 0x2e, A: 0xff},
+	"435":                         {R: 0x8f, G: 0x5a, B: 0x41, A: 0xff},
+	"camouflage red":              {R: 0x8f, G: 0x5a, B: 0x41, A: 0xff},
+	"436":                         {R: 0x9c, G: 0x84, B: 0x65, A: 0xff},
+	"dark camouflage desert sand": {R: 0x9c, G: 0x84, B: 0x65, A: 0xff},
+	"439":                         {R: 0x9f, G: 0x5a, B: 0x2b, A: 0xff},
+	"orange brown":                {R: 0x9f, G: 0x5a, B: 0x2b, A: 0xff},
+	"443":                         {R: 0xe0, G: 0x8a, B: 0x6b, A: 0xff},
+	"salmon":                      {R: 0xe0, G: 0x8a, B: 0x6b, A: 0xff},
+	"444":                         {R: 0xb1, G: 0x5f, B: 0x45, A: 0xff},
+	"terracotta":                  {R: 0xb1, G: 0x5f, B: 0x45, A: 0xff},
+	"445":                         {R: 0x8e, G: 0x3b, B: 0x2e, A: 0xff},
+	"venetian red":                {R: 0x8e, G: 0x3b, B: 0x2e, A: 0xff},
+	"446":                         {R: 0x72, G: 0x39, B: 0x2c, A: 0xff},
+	"red oxide":                   {R: 0x72, G: 0x39, B: 0x2c, A: 0xff},
+	"447":                         {R: 0xe9, G: 0xa0, B: 0x8b, A: 0xff},
+	"salmon pink":                 {R: 0xe9, G: 0xa0, B: 0x8b, A: 0xff},
+	"448":                         {R: 0x6b, G: 0x2f, B: 0x2a, A: 0xff},
+	"deep indian red":             {R: 0x6b, G: 0x2f, B: 0x2a, A: 0xff},
+	"449":                         {R: 0x7a, G: 0x4a, B: 0x44, A: 0xff},
+	"light purple brown":          {R: 0x7a, G: 0x4a, B: 0x44, A: 0xff},
+	"452":                         {R: 0x6a, G: 0x2a, B: 0x2f, A: 0xff},
+	"dark crimson":                {R: 0x6a, G: 0x2a, B: 0x2f, A: 0xff},
+	"453":                         {R: 0xea, G: 0xc6, B: 0xb6, A: 0xff},
+	"shell pink":                  {R: 0xea, G: 0xc6, B: 0xb6, A: 0xff},
+	"473":                         {R: 0xa0, G: 0x3a, B: 0x33, A: 0xff},
+	"gulf red":                    {R: 0xa0, G: 0x3a, B: 0x33, A: 0xff},
+	"489":                         {R: 0x6b, G: 0x4b, B: 0x2f, A: 0xff},
+	"leaf brown":                  {R: 0x6b, G: 0x4b, B: 0x2f, A: 0xff},
+	"490":                         {R: 0x5e, G: 0x4a, B: 0x38, A: 0xff},
+	"beech brown":                 {R: 0x5e, G: 0x4a, B: 0x38, A: 0xff},
+	"499":                         {R: 0x4f, G: 0x42, B: 0x35, A: 0xff},
+	"service brown":               {R: 0x4f, G: 0x42, B: 0x35, A: 0xff},
+	"537":                         {R: 0xc0, G: 0x2a, B: 0x2a, A: 0xff},
+	"signal red":                  {R: 0xc0, G: 0x2a, B: 0x2a, A: 0xff},
+	"538":                         {R: 0xb2, G: 0x2a, B: 0x2e, A: 0xff},
+	"post office red":             {R: 0xb2, G: 0x2a, B: 0x2e, A: 0xff},
+	"539":                         {R: 0x8a, G: 0x2b, B: 0x3a, A: 0xff},
+	"currant red":                 {R: 0x8a, G: 0x2b, B: 0x3a, A: 0xff},
+	"540":                         {R: 0x9a, G: 0x26, B: 0x32, A: 0xff},
+	"crimson":                     {R: 0x9a, G: 0x26, B: 0x32, A: 0xff},
+	"541":                         {R: 0x6a, G: 0x25, B: 0x30, A: 0xff},
+	"maroon":                      {R: 0x6a, G: 0x25, B: 0x30, A: 0xff},
+	"542":                         {R: 0x8b, G: 0x26, B: 0x35, A: 0xff},
+	"ruby":                        {R: 0x8b, G: 0x26, B: 0x35, A: 0xff},
+	"557":                         {R: 0xf0, G: 0x8c, B: 0x3a, A: 0xff},
+	"light orange":                {R: 0xf0, G: 0x8c, B: 0x3a, A: 0xff},
+	"568":                         {R: 0xf0, G: 0xa0, B: 0x60, A: 0xff},
+	"apricot":                     {R: 0xf0, G: 0xa0, B: 0x60, A: 0xff},
+	"570":                         {R: 0xc0, G: 0x26, B: 0x2a, A: 0xff},
+	"traffic red":                 {R: 0xc0, G: 0x26, B: 0x2a, A: 0xff},
+	"591":                         {R: 0xd9, G: 0x61, B: 0x1a, A: 0xff},
+	"deep orange":                 {R: 0xd9, G: 0x61, B: 0x1a, A: 0xff},
+	"592":                         {R: 0xe0, G: 0x54, B: 0x1e, A: 0xff},
+	"international orange":        {R: 0xe0, G: 0x54, B: 0x1e, A: 0xff},
+	"593":                         {R: 0xb7, G: 0x3a, B: 0x2e, A: 0xff},
+	"rail red":                    {R: 0xb7, G: 0x3a, B: 0x2e, A: 0xff},
+	"626":                         {R: 0x6e, G: 0x6e, B: 0x5e, A: 0xff},
+	"camouflage grey":             {R: 0x6e, G: 0x6e, B: 0x5e, A: 0xff},
+	"627":                         {R: 0xbf, G: 0xc3, B: 0xbb, A: 0xff},
+	"light aircraft grey":         {R: 0xbf, G: 0xc3, B: 0xbb, A: 0xff},
+	"628":                         {R: 0x9d, G: 0xa3, B: 0xa3, A: 0xff},
+	"silver grey":                 {R: 0x9d, G: 0xa3, B: 0xa3, A: 0xff},
+	"629":                         {R: 0x4e, G: 0x4f, B: 0x48, A: 0xff},
+	"dark camouflage grey":        {R: 0x4e, G: 0x4f, B: 0x48, A: 0xff},
+	"630":                         {R: 0xbf, G: 0xc0, B: 0xb9, A: 0xff},
+	"french grey":                 {R: 0xbf, G: 0xc0, B: 0xb9, A: 0xff},
+	"631":                         {R: 0x9f, G: 0xa3, B: 0xa2, A: 0xff},
+	"light grey":                  {R: 0x9f, G: 0xa3, B: 0xa2, A: 0xff},
+	"632":                         {R: 0x5f, G: 0x65, B: 0x69, A: 0xff},
+	"dark admiralty grey":         {R: 0x5f, G: 0x65, B: 0x69, A: 0xff},
+	"633":                         {R: 0x5c, G: 0x6b, B: 0x78, A: 0xff},
+	"raf blue grey":               {R: 0x5c, G: 0x6b, B: 0x78, A: 0xff},
+	"634":                         {R: 0x5b, G: 0x63, B: 0x67, A: 0xff},
+	"slate":                       {R: 0x5b, G: 0x63, B: 0x67, A: 0xff},
+	"635":                         {R: 0x6a, G: 0x6e, B: 0x6e, A: 0xff},
+	"lead":                        {R: 0x6a, G: 0x6e, B: 0x6e, A: 0xff},
+	"636":                         {R: 0x5f, G: 0x7c, B: 0x93, A: 0xff},
+	"pru blue":                    {R: 0x5f, G: 0x7c, B: 0x93, A: 0xff},
+	"637":                         {R: 0x86, G: 0x8e, B: 0x90, A: 0xff},
+	"medium sea grey":             {R: 0x86, G: 0x8e, B: 0x90, A: 0xff},
+	"638":                         {R: 0x5c, G: 0x65, B: 0x66, A: 0xff},
+	"dark sea grey":               {R: 0x5c, G: 0x65, B: 0x66, A: 0xff},
+	"639":                         {R: 0x7f, G: 0x87, B: 0x85, A: 0xff},
+	"light slate grey":            {R: 0x7f, G: 0x87, B: 0x85, A: 0xff},
+	"640":                         {R: 0x47, G: 0x4e, B: 0x52, A: 0xff},
+	"extra dark sea grey":         {R: 0x47, G: 0x4e, B: 0x52, A: 0xff},
+	"642":                         {R: 0x2a, G: 0x2c, B: 0x2e, A: 0xff},
+	"night":                       {R: 0x2a, G: 0x2c, B: 0x2e, A: 0xff},
+	"671":                         {R: 0x4e, G: 0x52, B: 0x54, A: 0xff},
+	"middle graphite":             {R: 0x4e, G: 0x52, B: 0x54, A: 0xff},
+	"676":                         {R: 0xaa, G: 0xb0, B: 0xad, A: 0xff},
+	"light weatherwork grey":      {R: 0xaa, G: 0xb0, B: 0xad, A: 0xff},
+	"677":                         {R: 0x7d, G: 0x85, B: 0x84, A: 0xff},
+	"dark weatherwork grey":       {R: 0x7d, G: 0x85, B: 0x84, A: 0xff},
+	"692":                         {R: 0x8e, G: 0x8f, B: 0x88, A: 0xff},
+	"smoke grey":                  {R: 0x8e, G: 0x8f, B: 0x88, A: 0xff},
+	"693":                         {R: 0xa3, G: 0xa6, B: 0x9d, A: 0xff},
+	"aircraft grey":               {R: 0xa3, G: 0xa6, B: 0x9d, A: 0xff},
+	"694":                         {R: 0xa2, G: 0x9f, B: 0x95, A: 0xff},
+	"dove grey":                   {R: 0xa2, G: 0x9f, B: 0x95, A: 0xff},
+	"697":                         {R: 0xa5, G: 0xab, B: 0xa6, A: 0xff},
+	"light admiralty grey":        {R: 0xa5, G: 0xab, B: 0xa6, A: 0xff},
+	"796":                         {R: 0x3c, G: 0x2c, B: 0x49, A: 0xff},
+	"dark violet":                 {R: 0x3c, G: 0x2c, B: 0x49, A: 0xff},
+	"797":                         {R: 0xbb, G: 0xa7, B: 0xc9, A: 0xff},
+	"light violet":                {R: 0xbb, G: 0xa7, B: 0xc9, A: 0xff},
+})
+
+// withRunTogetherCodes returns the map with an extra alias for each colour
+// code with the spaces removed, so the code "08 e 51" can also be given as
+// "08e51". Names which do not start with a digit are not codes and are left
+// alone.
+func withRunTogetherCodes(m colourNameToRGBA) colourNameToRGBA {
+	for name, c := range m {
+		if name == "" || !strings.ContainsAny(name[:1], "0123456789") {
+			continue
+		}
+
+		if alias := strings.ReplaceAll(name, " ", ""); alias != name {
+			m[alias] = c
+		}
+	}
+
+	return m
+}
+
+// colours from British Standard BS 4800:2011, the paint colours for
+// building purposes. Each colour is given by its code of hue, greyness and
+// weight (such as "04 e 53"), which may also be given without the spaces
+// ("04e53"). The standard identifies its colours only by code; where a
+// colour has a well established trade name (such as "magnolia") that is
+// given too. BSI do not publish sRGB values for the colours; these are
+// unofficial approximations and should be checked against the physical
+// standard where an exact match matters.
+//
+//nolint:mnd
+var bs4800Colours = withAliases(withRunTogetherCodes(colourNameToRGBA{
+	"00 a 01":  {R: 0xf2, G: 0xf2, B: 0xee, A: 0xff},
+	"00 a 05":  {R: 0xc7, G: 0xc8, B: 0xc4, A: 0xff},
+	"00 a 09":  {R: 0x8e, G: 0x90, B: 0x8f, A: 0xff},
+	"00 a 13":  {R: 0x5d, G: 0x5f, B: 0x60, A: 0xff},
+	"00 e 53":  {R: 0x1e, G: 0x1e, B: 0x1f, A: 0xff},
+	"black":    {R: 0x1e, G: 0x1e, B: 0x1f, A: 0xff},
+	"04 b 15":  {R: 0xe8, G: 0xcf, B: 0xc5, A: 0xff},
+	"04 d 44":  {R: 0xa8, G: 0x32, B: 0x2d, A: 0xff},
+	"04 e 53":  {R: 0xc6, G: 0x28, B: 0x28, A: 0xff},
+	"poppy":    {R: 0xc6, G: 0x28, B: 0x28, A: 0xff},
+	"06 c 39":  {R: 0xa4, G: 0x5a, B: 0x3b, A: 0xff},
+	"06 e 51":  {R: 0xe0, G: 0x5a, B: 0x1b, A: 0xff},
+	"08 b 15":  {R: 0xee, G: 0xe5, B: 0xd1, A: 0xff},
+	"magnolia": {R: 0xee, G: 0xe5, B: 0xd1, A: 0xff},
+	"08 b 17":  {R: 0xd9, G: 0xc9, B: 0xa9, A: 0xff},
+	"08 b 21":  {R: 0xc7, G: 0xb1, B: 0x8c, A: 0xff},
+	"08 b 25":  {R: 0x9c, G: 0x8a, B: 0x6a, A: 0xff},
+	"08 c 35":  {R: 0xd9, G: 0xa3, B: 0x5c, A: 0xff},
+	"08 e 51":  {R: 0xe0, G: 0x7b, B: 0x1f, A: 0xff},
+	"10 b 15":  {R: 0xed, G: 0xe3, B: 0xc1, A: 0xff},
+	"10 c 31":  {R: 0xe9, G: 0xd3, B: 0x8e, A: 0xff},
+	"10 e 50":  {R: 0xf1, G: 0xd0, B: 0x4c, A: 0xff},
+	"10 e 53":  {R: 0xf5, G: 0xb8, B: 0x00, A: 0xff},
+	"12 b 21":  {R: 0xc8, G: 0xc3, B: 0xa0, A: 0xff},
+	"14 c 39":  {R: 0x6e, G: 0x8a, B: 0x4e, A: 0xff},
+	"14 e 53":  {R: 0x3a, G: 0x8a, B: 0x3a, A: 0xff},
+	"18 b 21":  {R: 0xb8, G: 0xc8, B: 0xc0, A: 0xff},
+	"18 c 39":  {R: 0x3d, G: 0x6f, B: 0x63, A: 0xff},
+	"18 e 53":  {R: 0x00, G: 0x78, B: 0x5a, A: 0xff},
+	"20 c 40":  {R: 0x3e, G: 0x6a, B: 0x8c, A: 0xff},
+	"20 d 45":  {R: 0x2d, G: 0x5b, B: 0x8a, A: 0xff},
+	"20 e 51":  {R: 0x1d, G: 0x4e, B: 0x9a, A: 0xff},
+	"22 c 37":  {R: 0x5a, G: 0x54, B: 0x80, A: 0xff},
+	"24 c 39":  {R: 0x6e, G: 0x4f, B: 0x6f, A: 0xff},
+}))
@@ -0,0 +1,118 @@
+package colour
+
+import (
+	"testing"
+
+	"github.com/nickwells/colour.mod/v2/colourtesthelper"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestStandardsColours(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		name      string
+		expColour rgba
+	}{
+		{
+			ID:        testhelper.MkID("BS 381C by number"),
+			name:      "bs381c:538",
+			expColour: rgba{R: 0xb2, G: 0x2a, B: 0x2e, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("BS 381C by name"),
+			name:      "BS381C:post office red",
+			expColour: rgba{R: 0xb2, G: 0x2a, B: 0x2e, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("BS 381C by name, US spelling"),
+			name:      "bs381c:dark sea gray",
+			expColour: rgba{R: 0x5c, G: 0x65, B: 0x66, A: 0xff},
+		},
+		{
+			ID:     testhelper.MkID("BS 381C, bad number"),
+			ExpErr: testhelper.MkExpErr(`bad colour name: "999"`),
+			name:   "bs381c:999",
+		},
+		{
+			ID:        testhelper.MkID("BS 4800 by code"),
+			name:      "bs4800:04 E 53",
+			expColour: rgba{R: 0xc6, G: 0x28, B: 0x28, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("BS 4800 by code, without spaces"),
+			name:      "bs4800:08E51",
+			expColour: rgba{R: 0xe0, G: 0x7b, B: 0x1f, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("BS 4800 by name"),
+			name:      "bs4800:Magnolia",
+			expColour: rgba{R: 0xee, G: 0xe5, B: 0xd1, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("FS 595 by number"),
+			name:      "fs595:36375",
+			expColour: rgba{R: 0xa7, G: 0xab, B: 0xb0, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("FS 595 by name, with hyphens"),
+			name:      "fs595:light-ghost-grey",
+			expColour: rgba{R: 0xa7, G: 0xab, B: 0xb0, A: 0xff},
+		},
+	}
+
+	for _, tc := range testCases {
+		nc, err := ParseNamedColour(nil, tc.name)
+		if testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc) &&
+			err == nil {
+			colourtesthelper.DiffRGBA(t, tc.IDStr(), "colour",
+				nc.Colour(), tc.expColour)
+		}
+	}
+}
+
+func TestStandardsClosest(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		fl       Families
+		c        rgba
+		expNames []string
+	}{
+		{
+			ID:       testhelper.MkID("BS 381C"),
+			fl:       Families{BS381CColours},
+			c:        rgba{R: 0xb0, G: 0x28, B: 0x30, A: 0xff},
+			expNames: []string{"538", "post office red", "post-office-red"},
+		},
+		{
+			ID:       testhelper.MkID("BS 4800"),
+			fl:       Families{BS4800Colours},
+			c:        rgba{R: 0xf0, G: 0xb8, B: 0x08, A: 0xff},
+			expNames: []string{"10 e 53", "10-e-53", "10e53"},
+		},
+		{
+			ID: testhelper.MkID("FS 595"),
+			fl: Families{FS595Colours},
+			c:  rgba{R: 0x4a, G: 0x50, B: 0x52, A: 0xff},
+			expNames: []string{
+				"36118",
+				"gunship gray", "gunship grey",
+				"gunship-gray", "gunship-grey",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		fcs, err := tc.fl.ClosestN(tc.c, 1)
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		if len(fcs) != 1 {
+			t.Fatalf("expected 1 colour, got %d", len(fcs))
+		}
+
+		testhelper.DiffSlice(t, tc.IDStr(), "names",
+			fcs[0].CNames, tc.expNames)
+	}
+}
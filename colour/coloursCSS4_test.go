@@ -0,0 +1,287 @@
+package colour
+
+import (
+	"testing"
+
+	"github.com/nickwells/colour.mod/v2/colourtesthelper"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestCSS4Colours(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		fl        Families
+		name      string
+		expColour rgba
+	}{
+		{
+			ID:        testhelper.MkID("rebeccapurple"),
+			name:      "css4:rebeccapurple",
+			expColour: rgba{R: 0x66, G: 0x33, B: 0x99, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("grey differs from X11"),
+			name:      "css4:grey",
+			expColour: rgba{R: 0x80, G: 0x80, B: 0x80, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("transparent"),
+			name:      "css4:transparent",
+			expColour: rgba{},
+		},
+		{
+			ID:        testhelper.MkID("transparent, family list"),
+			fl:        Families{CSS4Colours},
+			name:      "Transparent",
+			expColour: rgba{},
+		},
+		{
+			ID:        testhelper.MkID("system colour"),
+			name:      "css4:CanvasText",
+			expColour: rgba{A: 0xff},
+		},
+		{
+			ID:     testhelper.MkID("no aliases"),
+			ExpErr: testhelper.MkExpErr(`bad colour name: "rebecca purple"`),
+			name:   "css4:rebecca purple",
+		},
+	}
+
+	for _, tc := range testCases {
+		nc, err := ParseNamedColour(tc.fl, tc.name)
+		if testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc) &&
+			err == nil {
+			colourtesthelper.DiffRGBA(t, tc.IDStr(), "colour",
+				nc.Colour(), tc.expColour)
+		}
+	}
+
+	n, err := CSS4Colours.ColourNameCount()
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	testhelper.DiffInt(t, "CSS4", "names",
+		n, 149+len(cssSystemColourNames))
+}
+
+func TestCSS4Describe(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		c        rgba
+		expDesc  string
+		expNames []string
+	}{
+		{
+			ID:       testhelper.MkID("opaque black is not transparent"),
+			c:        rgba{A: 0xff},
+			expDesc:  "black",
+			expNames: []string{"black"},
+		},
+		{
+			ID:       testhelper.MkID("white is not a system colour"),
+			c:        rgba{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+			expDesc:  "white",
+			expNames: []string{"white"},
+		},
+		{
+			ID:       testhelper.MkID("link text colour is not named"),
+			c:        rgba{B: 0xee, A: 0xff},
+			expDesc:  "color.RGBA{R:0x00, G:0x00, B:0xee, A:0xff}",
+			expNames: []string{"blue"},
+		},
+		{
+			ID:       testhelper.MkID("half-transparent black"),
+			c:        rgba{A: 0x80},
+			expDesc:  "black",
+			expNames: []string{"black"},
+		},
+		{
+			ID:       testhelper.MkID("fully transparent"),
+			c:        rgba{},
+			expDesc:  `"CSS4:black" or "CSS4:transparent"`,
+			expNames: []string{"transparent"},
+		},
+	}
+
+	for _, tc := range testCases {
+		fl := Families{CSS4Colours}
+
+		testhelper.DiffString(t, tc.IDStr(), "description",
+			fl.Describe(tc.c), tc.expDesc)
+
+		fcs, err := fl.ClosestN(tc.c, 1)
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		if len(fcs) != 1 {
+			t.Fatalf("%s: expected 1 colour, got %d", tc.IDStr(), len(fcs))
+		}
+
+		testhelper.DiffStringSlice(t, tc.IDStr(), "closest names",
+			fcs[0].CNames, tc.expNames)
+	}
+}
+
+// checkThemeErr reports an error if err is nil or has the wrong text
+func checkThemeErr(t *testing.T, id string, err error, expErr string) {
+	t.Helper()
+
+	if err == nil {
+		t.Errorf("%s: an error was expected", id)
+		return
+	}
+
+	testhelper.DiffString(t, id, "error", err.Error(), expErr)
+}
+
+func TestCSSSystemTheme(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		theme CSSSystemTheme
+	}{
+		{name: "light", theme: CSSLightTheme()},
+		{name: "dark", theme: CSSDarkTheme()},
+	} {
+		if err := tc.theme.Check(); err != nil {
+			t.Errorf("%s: unexpected error: %s", tc.name, err)
+		}
+	}
+
+	badTheme := CSSLightTheme()
+	delete(badTheme, "canvas")
+
+	checkThemeErr(t, "missing name", badTheme.Check(),
+		`the CSS system theme has no colour for "canvas"`)
+
+	badTheme = CSSLightTheme()
+	badTheme["papyrus"] = rgba{}
+
+	checkThemeErr(t, "extra name", badTheme.Check(),
+		`"papyrus" is not a CSS system colour`)
+
+	darkCanvas := rgba{R: 0x12, G: 0x12, B: 0x12, A: 0xff}
+	white := rgba{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+
+	nc, err := ParseNamedColour(nil, "css4:canvas")
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	colourtesthelper.DiffRGBA(t, "family", "canvas", nc.Colour(), white)
+
+	c, err := EvalCSSColour(nil, "Canvas")
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	colourtesthelper.DiffRGBA(t, "default theme", "Canvas", c, white)
+
+	c, err = CSSColourEvaluator{SystemTheme: CSSDarkTheme()}.Eval("canvas")
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	colourtesthelper.DiffRGBA(t, "evaluator theme", "canvas", c, darkCanvas)
+
+	c, err = CSSColourEvaluator{SystemTheme: CSSDarkTheme()}.Eval("css4:canvas")
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	colourtesthelper.DiffRGBA(t, "evaluator theme", "css4:canvas",
+		c, darkCanvas)
+}
+
+func TestCSSSystemThemeLookup(t *testing.T) {
+	darkCanvas := rgba{R: 0x12, G: 0x12, B: 0x12, A: 0xff}
+	white := rgba{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		p   NamedColourParser
+		s   string
+		exp rgba
+	}{
+		{
+			ID:  testhelper.MkID("no theme, family and name"),
+			s:   "css4:canvas",
+			exp: white,
+		},
+		{
+			ID:  testhelper.MkID("dark theme, family and name"),
+			p:   NamedColourParser{SystemTheme: CSSDarkTheme()},
+			s:   "css4:Canvas",
+			exp: darkCanvas,
+		},
+		{
+			ID: testhelper.MkID("dark theme, name only"),
+			p: NamedColourParser{
+				Families:    Families{CSS4Colours},
+				SystemTheme: CSSDarkTheme(),
+			},
+			s:   "canvas",
+			exp: darkCanvas,
+		},
+		{
+			ID: testhelper.MkID("partial theme"),
+			p: NamedColourParser{
+				SystemTheme: CSSSystemTheme{"canvas": darkCanvas},
+			},
+			s:   "css4:field",
+			exp: white,
+		},
+		{
+			ID:  testhelper.MkID("dark theme, named colour"),
+			p:   NamedColourParser{SystemTheme: CSSDarkTheme()},
+			s:   "css4:white",
+			exp: white,
+		},
+		{
+			ID:     testhelper.MkID("dark theme, not in the family"),
+			ExpErr: testhelper.MkExpErr(`bad colour name: "canvas"`),
+			p:      NamedColourParser{SystemTheme: CSSDarkTheme()},
+			s:      "x11:canvas",
+		},
+	}
+
+	for _, tc := range testCases {
+		nc, err := tc.p.Parse(tc.s)
+		if testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc) &&
+			err == nil {
+			colourtesthelper.DiffRGBA(t, tc.IDStr(), "colour",
+				nc.Colour(), tc.exp)
+		}
+	}
+
+	c, err := CSS4Colours.ThemedColour(CSSDarkTheme(), "canvas")
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	colourtesthelper.DiffRGBA(t, "Family.ThemedColour", "canvas",
+		c, darkCanvas)
+
+	c, err = CSSDarkTheme().Colour("CanvasText")
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	colourtesthelper.DiffRGBA(t, "CSSSystemTheme.Colour", "CanvasText",
+		c, white)
+
+	c, err = CSSSystemTheme(nil).Colour("canvas")
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	colourtesthelper.DiffRGBA(t, "nil CSSSystemTheme.Colour", "canvas",
+		c, white)
+
+	_, err = CSSDarkTheme().Colour("white")
+	checkThemeErr(t, "CSSSystemTheme.Colour", err,
+		`bad colour name: "white"`)
+}
@@ -8,13 +8,13 @@ package colour
 // 1) Japanese names in brackets after the English names are split out into
 // separate named entries.
 //
-// 2) Where the Japanese names include non-ASCII characters an additional
-// entry with a corresponding ASCII character has been added.
-//
-// 3) Colours with duplicate names have had their names adjusted to
+// 2) Colours with duplicate names have had their names adjusted to
 // deduplicate them.
 //
-// 4) all names have been mapped to lower case
+// 3) all names have been mapped to lower case
+//
+// Where names include letters with diacritics (as many of the Japanese
+// names do) an ASCII alias is generated by withAliases.
 //
 //nolint:mnd
 var encycolorpediaColours = withAliases(colourNameToRGBA{
@@ -116,7 +116,6 @@ var encycolorpediaColours = withAliases(colourNameToRGBA{
 	"baby powder":                         {R: 0xfe, G: 0xfe, B: 0xfa, A: 0xff},
 	"baiko brown":                         {R: 0x85, G: 0x7c, B: 0x55, A: 0xff},
 	"baikōcha":                            {R: 0x85, G: 0x7c, B: 0x55, A: 0xff},
-	"baikocha":                            {R: 0x85, G: 0x7c, B: 0x55, A: 0xff},
 	"baker-miller pink":                   {R: 0xff, G: 0x91, B: 0xaf, A: 0xff},
 	"ball blue":                           {R: 0x21, G: 0xab, B: 0xcd, A: 0xff},
 	"banana mania":                        {R: 0xfa, G: 0xe7, B: 0xb5, A: 0xff},
@@ -141,10 +140,8 @@ var encycolorpediaColours = withAliases(colourNameToRGBA{
 	"beige":                               {R: 0xf5, G: 0xf5, B: 0xdc, A: 0xff},
 	"bellflower":                          {R: 0x5d, G: 0x3f, B: 0x6a, A: 0xff},
 	"kikyō-iro":                           {R: 0x5d, G: 0x3f, B: 0x6a, A: 0xff},
-	"kikyo-iro":                           {R: 0x5d, G: 0x3f, B: 0x6a, A: 0xff},
 	"betel nut dye":                       {R: 0x35, G: 0x29, B: 0x25, A: 0xff},
 	"binrōjizome":                         {R: 0x35, G: 0x29, B: 0x25, A: 0xff},
-	"binrojizome":                         {R: 0x35, G: 0x29, B: 0x25, A: 0xff},
 	"big dip o’ruby":                      {R: 0x9c, G: 0x25, B: 0x42, A: 0xff},
 	"big foot feet":                       {R: 0xe8, G: 0x8e, B: 0x5a, A: 0xff},
 	"birch brown":                         {R: 0xb1, G: 0x4a, B: 0x30, A: 0xff},
@@ -390,10 +387,8 @@ var encycolorpediaColours = withAliases(colourNameToRGBA{
 	"classic rose":                        {R: 0xfb, G: 0xcc, B: 0xe7, A: 0xff},
 	"clove-brown":                         {R: 0x8f, G: 0x58, B: 0x3c, A: 0xff},
 	"chōjicha":                            {R: 0x8f, G: 0x58, B: 0x3c, A: 0xff},
-	"chojicha":                            {R: 0x8f, G: 0x58, B: 0x3c, A: 0xff},
 	"clove-dyed":                          {R: 0xa9, G: 0x62, B: 0x32, A: 0xff},
 	"chōjizome":                           {R: 0xa9, G: 0x62, B: 0x32, A: 0xff},
-	"chojizome":                           {R: 0xa9, G: 0x62, B: 0x32, A: 0xff},
 	"coarse wool":                         {R: 0x18, G: 0x1b, B: 0x26, A: 0xff},
 	"kachi-iro":                           {R: 0x18, G: 0x1b, B: 0x26, A: 0xff},
 	"cobalt blue":                         {R: 0x00, G: 0x47, B: 0xab, A: 0xff},
@@ -408,7 +403,6 @@ var encycolorpediaColours = withAliases(colourNameToRGBA{
 	"congo pink":                          {R: 0xf8, G: 0x83, B: 0x79, A: 0xff},
 	"contemplation in a tea garden":       {R: 0x66, G: 0x53, B: 0x43, A: 0xff},
 	"rokōcha":                             {R: 0x66, G: 0x53, B: 0x43, A: 0xff},
-	"rokocha":                             {R: 0x66, G: 0x53, B: 0x43, A: 0xff},
 	"cookies and cream":                   {R: 0xee, G: 0xe0, B: 0xb1, A: 0xff},
 	"cool black":                          {R: 0x00, G: 0x2e, B: 0x63, A: 0xff},
 	"cool grey":                           {R: 0x8c, G: 0x92, B: 0xac, A: 0xff},
@@ -428,7 +422,6 @@ var encycolorpediaColours = withAliases(colourNameToRGBA{
 	"corn":                                {R: 0xfb, G: 0xec, B: 0x5d, A: 0xff},
 	"corn 2":                              {R: 0xfa, G: 0xa9, B: 0x45, A: 0xff},
 	"tōmorokoshi-iro":                     {R: 0xfa, G: 0xa9, B: 0x45, A: 0xff},
-	"tomorokoshi-iro":                     {R: 0xfa, G: 0xa9, B: 0x45, A: 0xff},
 	"cornflower":                          {R: 0x93, G: 0xcc, B: 0xea, A: 0xff},
 	"cornflower blue":                     {R: 0x64, G: 0x95, B: 0xed, A: 0xff},
 	"cornsilk":                            {R: 0xff, G: 0xf8, B: 0xdc, A: 0xff},
@@ -538,7 +531,6 @@ var encycolorpediaColours = withAliases(colourNameToRGBA{
 	"akebono-iro":                         {R: 0xfa, G: 0x7b, B: 0x62, A: 0xff},
 	"daylily":                             {R: 0xff, G: 0x89, B: 0x36, A: 0xff},
 	"kanzō-iro":                           {R: 0xff, G: 0x89, B: 0x36, A: 0xff},
-	"kanzo-iro":                           {R: 0xff, G: 0x89, B: 0x36, A: 0xff},
 	"debian red":                          {R: 0xd7, G: 0x0a, B: 0x53, A: 0xff},
 	"decaying leaves":                     {R: 0xd5, G: 0x78, B: 0x35, A: 0xff},
 	"kuchiba-iro":                         {R: 0xd5, G: 0x78, B: 0x35, A: 0xff},
@@ -650,10 +642,8 @@ var encycolorpediaColours = withAliases(colourNameToRGBA{
 	"facebook blue":                       {R: 0x39, G: 0x56, B: 0x9c, A: 0xff},
 	"faded sen no rikyu's tea":            {R: 0xb0, G: 0x92, B: 0x7a, A: 0xff},
 	"rikyūshiracha":                       {R: 0xb0, G: 0x92, B: 0x7a, A: 0xff},
-	"rikyushiracha":                       {R: 0xb0, G: 0x92, B: 0x7a, A: 0xff},
 	"faded spicy red-brown":               {R: 0x9b, G: 0x53, B: 0x3f, A: 0xff},
 	"sōdenkaracha":                        {R: 0x9b, G: 0x53, B: 0x3f, A: 0xff},
-	"sodenkaracha":                        {R: 0x9b, G: 0x53, B: 0x3f, A: 0xff},
 	"fake purple":                         {R: 0x43, G: 0x24, B: 0x2a, A: 0xff},
 	"nisemurasaki":                        {R: 0x43, G: 0x24, B: 0x2a, A: 0xff},
 	"falu red":                            {R: 0x80, G: 0x18, B: 0x18, A: 0xff},
@@ -724,7 +714,6 @@ var encycolorpediaColours = withAliases(colourNameToRGBA{
 	"gamboge":                             {R: 0xe4, G: 0x9b, B: 0x0f, A: 0xff},
 	"gamboge 2":                           {R: 0xff, G: 0xb6, B: 0x1e, A: 0xff},
 	"tōō":                                 {R: 0xff, G: 0xb6, B: 0x1e, A: 0xff},
-	"too":                                 {R: 0xff, G: 0xb6, B: 0x1e, A: 0xff},
 	"gamboge orange (brown)":              {R: 0x99, G: 0x66, B: 0x00, A: 0xff},
 	"gargoyle gas":                        {R: 0xff, G: 0xdf, B: 0x46, A: 0xff},
 	"garnet":                              {R: 0x73, G: 0x36, B: 0x35, A: 0xff},
@@ -767,13 +756,11 @@ var encycolorpediaColours = withAliases(colourNameToRGBA{
 	"google chrome yellow":                {R: 0xff, G: 0xce, B: 0x44, A: 0xff},
 	"goryeo storeroom ":                   {R: 0x20, G: 0x38, B: 0x38, A: 0xff},
 	"kōrainando":                          {R: 0x20, G: 0x38, B: 0x38, A: 0xff},
-	"korainando":                          {R: 0x20, G: 0x38, B: 0x38, A: 0xff},
 	"granite gray":                        {R: 0x67, G: 0x67, B: 0x67, A: 0xff},
 	"granny smith apple":                  {R: 0xa8, G: 0xe4, B: 0xa0, A: 0xff},
 	"grape":                               {R: 0x6f, G: 0x2d, B: 0xa8, A: 0xff},
 	"grape mouse":                         {R: 0x63, G: 0x42, B: 0x4b, A: 0xff},
 	"budōnezumi":                          {R: 0x63, G: 0x42, B: 0x4b, A: 0xff},
-	"budonezumi":                          {R: 0x63, G: 0x42, B: 0x4b, A: 0xff},
 	"gray (x11 gray)":                     {R: 0xbe, G: 0xbe, B: 0xbe, A: 0xff},
 	"gray-asparagus":                      {R: 0x46, G: 0x59, B: 0x45, A: 0xff},
 	"green":                               {R: 0x00, G: 0x80, B: 0x01, A: 0xff},
@@ -804,7 +791,6 @@ var encycolorpediaColours = withAliases(colourNameToRGBA{
 	"ginshu":                              {R: 0xbc, G: 0x2d, B: 0x29, A: 0xff},
 	"greyish dark green":                  {R: 0x65, G: 0x62, B: 0x55, A: 0xff},
 	"rikyūnezumi":                         {R: 0x65, G: 0x62, B: 0x55, A: 0xff},
-	"rikyunezumi":                         {R: 0x65, G: 0x62, B: 0x55, A: 0xff},
 	"grullo":                              {R: 0xa9, G: 0x9a, B: 0x86, A: 0xff},
 	"gunmetal":                            {R: 0x2a, G: 0x34, B: 0x39, A: 0xff},
 	"guppie green":                        {R: 0x00, G: 0xff, B: 0x7f, A: 0xff},
@@ -950,7 +936,6 @@ var encycolorpediaColours = withAliases(colourNameToRGBA{
 	"lawn green":                                {R: 0x7c, G: 0xfc, B: 0x00, A: 0xff},
 	"legal dye":                                 {R: 0x2e, G: 0x21, B: 0x1b, A: 0xff},
 	"kenpōzome":                                 {R: 0x2e, G: 0x21, B: 0x1b, A: 0xff},
-	"kenpozome":                                 {R: 0x2e, G: 0x21, B: 0x1b, A: 0xff},
 	"lemon":                                     {R: 0xff, G: 0xf7, B: 0x00, A: 0xff},
 	"lemon chiffon":                             {R: 0xff, G: 0xfa, B: 0xcd, A: 0xff},
 	"lemon curry":                               {R: 0xcc, G: 0xa0, B: 0x1d, A: 0xff},
@@ -1024,7 +1009,6 @@ var encycolorpediaColours = withAliases(colourNameToRGBA{
 	"liver chestnut":                            {R: 0x98, G: 0x74, B: 0x56, A: 0xff},
 	"long spring":                               {R: 0xb9, G: 0x57, B: 0x54, A: 0xff},
 	"chōshun-iro":                               {R: 0xb9, G: 0x57, B: 0x54, A: 0xff},
-	"choshun-iro":                               {R: 0xb9, G: 0x57, B: 0x54, A: 0xff},
 	"loquat brown":                              {R: 0xab, G: 0x61, B: 0x34, A: 0xff},
 	"biwacha":                                   {R: 0xab, G: 0x61, B: 0x34, A: 0xff},
 	"lotion":                                    {R: 0xfe, G: 0xfd, B: 0xfa, A: 0xff},
@@ -1174,7 +1158,6 @@ var encycolorpediaColours = withAliases(colourNameToRGBA{
 	"muddy brown":                               {R: 0xcb, G: 0x66, B: 0x49, A: 0xff},
 	"distant river brown":                       {R: 0xcb, G: 0x66, B: 0x49, A: 0xff},
 	"enshūcha":                                  {R: 0xcb, G: 0x66, B: 0x49, A: 0xff},
-	"enshucha":                                  {R: 0xcb, G: 0x66, B: 0x49, A: 0xff},
 	"mughal green":                              {R: 0x30, G: 0x60, B: 0x30, A: 0xff},
 	"mulberry":                                  {R: 0xc5, G: 0x4b, B: 0x8c, A: 0xff},
 	"mulberry (crayola)":                        {R: 0xc8, G: 0x50, B: 0x9b, A: 0xff},
@@ -1197,7 +1180,6 @@ var encycolorpediaColours = withAliases(colourNameToRGBA{
 	"navy blue":                                 {R: 0x00, G: 0x00, B: 0x80, A: 0xff},
 	"navy blue bellflower":                      {R: 0x19, G: 0x1f, B: 0x45, A: 0xff},
 	"konkikyō":                                  {R: 0x19, G: 0x1f, B: 0x45, A: 0xff},
-	"konkikyo":                                  {R: 0x19, G: 0x1f, B: 0x45, A: 0xff},
 	"neon blue":                                 {R: 0x1b, G: 0x03, B: 0xa3, A: 0xff},
 	"neon carrot":                               {R: 0xff, G: 0xa3, B: 0x43, A: 0xff},
 	"neon fuchsia":                              {R: 0xfe, G: 0x41, B: 0x64, A: 0xff},
@@ -1219,11 +1201,9 @@ var encycolorpediaColours = withAliases(colourNameToRGBA{
 	"ochre 2":                                   {R: 0xff, G: 0x4e, B: 0x20, A: 0xff},
 	"earthen yellow-red-brown":                  {R: 0xff, G: 0x4e, B: 0x20, A: 0xff},
 	"ōtan":                                      {R: 0xff, G: 0x4e, B: 0x20, A: 0xff},
-	"otan":                                      {R: 0xff, G: 0x4e, B: 0x20, A: 0xff},
 	"ochre 3":                                   {R: 0xbe, G: 0x7f, B: 0x51, A: 0xff},
 	"earthen yellow":                            {R: 0xbe, G: 0x7f, B: 0x51, A: 0xff},
 	"ōdo-iro":                                   {R: 0xbe, G: 0x7f, B: 0x51, A: 0xff},
-	"odo-iro":                                   {R: 0xbe, G: 0x7f, B: 0x51, A: 0xff},
 	"ogre odour":                                {R: 0xfd, G: 0x52, B: 0x40, A: 0xff},
 	"old bamboo":                                {R: 0x5e, G: 0x64, B: 0x4f, A: 0xff},
 	"oitake-iro":                                {R: 0x5e, G: 0x64, B: 0x4f, A: 0xff},
@@ -1293,7 +1273,6 @@ var encycolorpediaColours = withAliases(colourNameToRGBA{
 	"mizuasagi":                                 {R: 0x74, G: 0x9f, B: 0x8d, A: 0xff},
 	"pale incense":                              {R: 0xff, G: 0xa5, B: 0x65, A: 0xff},
 	"usukō":                                     {R: 0xff, G: 0xa5, B: 0x65, A: 0xff},
-	"usuko":                                     {R: 0xff, G: 0xa5, B: 0x65, A: 0xff},
 	"pale lavender":                             {R: 0xdc, G: 0xd0, B: 0xff, A: 0xff},
 	"pale magenta":                              {R: 0xf9, G: 0x84, B: 0xe5, A: 0xff},
 	"pale magenta-pink":                         {R: 0xff, G: 0x99, B: 0xcc, A: 0xff},
@@ -1331,7 +1310,6 @@ var encycolorpediaColours = withAliases(colourNameToRGBA{
 	"pastel yellow":                             {R: 0xfd, G: 0xfd, B: 0x96, A: 0xff},
 	"patina":                                    {R: 0x40, G: 0x7a, B: 0x52, A: 0xff},
 	"rokushō":                                   {R: 0x40, G: 0x7a, B: 0x52, A: 0xff},
-	"rokusho":                                   {R: 0x40, G: 0x7a, B: 0x52, A: 0xff},
 	"patriarch":                                 {R: 0x80, G: 0x00, B: 0x80, A: 0xff},
 	"patrinia flowers":                          {R: 0xd9, G: 0xb6, B: 0x11, A: 0xff},
 	"patrinia scabiosaefolia":                   {R: 0xd9, G: 0xb6, B: 0x11, A: 0xff},
@@ -1425,7 +1403,6 @@ var encycolorpediaColours = withAliases(colourNameToRGBA{
 	"prussian blue":                             {R: 0x00, G: 0x31, B: 0x53, A: 0xff},
 	"prussian blue 2":                           {R: 0x00, G: 0x31, B: 0x71, A: 0xff},
 	"konjō-iro":                                 {R: 0x00, G: 0x31, B: 0x71, A: 0xff},
-	"konjo-iro":                                 {R: 0x00, G: 0x31, B: 0x71, A: 0xff},
 	"puce":                                      {R: 0xcc, G: 0x88, B: 0x99, A: 0xff},
 	"puce red":                                  {R: 0x72, G: 0x2f, B: 0x37, A: 0xff},
 	"pullman brown":                             {R: 0x64, G: 0x41, B: 0x17, A: 0xff},
@@ -1486,14 +1463,12 @@ var encycolorpediaColours = withAliases(colourNameToRGBA{
 	"red devil":                                 {R: 0x86, G: 0x01, B: 0x11, A: 0xff},
 	"red incense":                               {R: 0xf0, G: 0x7f, B: 0x5e, A: 0xff},
 	"akakō-iro":                                 {R: 0xf0, G: 0x7f, B: 0x5e, A: 0xff},
-	"akako-iro":                                 {R: 0xf0, G: 0x7f, B: 0x5e, A: 0xff},
 	"red kite":                                  {R: 0x91, G: 0x32, B: 0x28, A: 0xff},
 	"benitobi":                                  {R: 0x91, G: 0x32, B: 0x28, A: 0xff},
 	"red ochre":                                 {R: 0x9f, G: 0x52, B: 0x33, A: 0xff},
 	"taisha-iro":                                {R: 0x9f, G: 0x52, B: 0x33, A: 0xff},
 	"red plum":                                  {R: 0xdb, G: 0x5a, B: 0x6b, A: 0xff},
 	"kōbai-iro":                                 {R: 0xdb, G: 0x5a, B: 0x6b, A: 0xff},
-	"kobai-iro":                                 {R: 0xdb, G: 0x5a, B: 0x6b, A: 0xff},
 	"red salsa":                                 {R: 0xfd, G: 0x3a, B: 0x4a, A: 0xff},
 	"red wisteria":                              {R: 0xbb, G: 0x77, B: 0x96, A: 0xff},
 	"benifuji":                                  {R: 0xbb, G: 0x77, B: 0x96, A: 0xff},
@@ -1629,7 +1604,6 @@ var encycolorpediaColours = withAliases(colourNameToRGBA{
 	"selective yellow":                          {R: 0xff, G: 0xba, B: 0x00, A: 0xff},
 	"sen no rikyu's tea":                        {R: 0x82, G: 0x6b, B: 0x58, A: 0xff},
 	"rikyūcha":                                  {R: 0x82, G: 0x6b, B: 0x58, A: 0xff},
-	"rikyucha":                                  {R: 0x82, G: 0x6b, B: 0x58, A: 0xff},
 	"sepia":                                     {R: 0x70, G: 0x42, B: 0x14, A: 0xff},
 	"sesame street green":                       {R: 0x00, G: 0xa8, B: 0x70, A: 0xff},
 	"shadow":                                    {R: 0x8a, G: 0x79, B: 0x5d, A: 0xff},
@@ -1735,7 +1709,6 @@ var encycolorpediaColours = withAliases(colourNameToRGBA{
 	"hajizome":                                  {R: 0xe0, G: 0x8a, B: 0x1e, A: 0xff},
 	"sumac-dyed 2":                              {R: 0x59, G: 0x2b, B: 0x1f, A: 0xff},
 	"kōrozen":                                   {R: 0x59, G: 0x2b, B: 0x1f, A: 0xff},
-	"korozen":                                   {R: 0x59, G: 0x2b, B: 0x1f, A: 0xff},
 	"sunburnt cyclops":                          {R: 0xff, G: 0x40, B: 0x4c, A: 0xff},
 	"sunglow":                                   {R: 0xff, G: 0xcc, B: 0x33, A: 0xff},
 	"sunny":                                     {R: 0xf2, G: 0xf2, B: 0x7a, A: 0xff},
@@ -1792,7 +1765,6 @@ var encycolorpediaColours = withAliases(colourNameToRGBA{
 	"tractor red":                               {R: 0xfd, G: 0x0e, B: 0x35, A: 0xff},
 	"tree peony":                                {R: 0xa4, G: 0x34, B: 0x5d, A: 0xff},
 	"bōtan":                                     {R: 0xa4, G: 0x34, B: 0x5d, A: 0xff},
-	"botan":                                     {R: 0xa4, G: 0x34, B: 0x5d, A: 0xff},
 	"trolley grey":                              {R: 0x80, G: 0x80, B: 0x80, A: 0xff},
 	"tropical rain forest":                      {R: 0x00, G: 0x75, B: 0x5e, A: 0xff},
 	"tropical violet":                           {R: 0xcd, G: 0xa4, B: 0xde, A: 0xff},
@@ -1825,7 +1797,6 @@ var encycolorpediaColours = withAliases(colourNameToRGBA{
 	"ultramarine blue":                          {R: 0x41, G: 0x66, B: 0xf5, A: 0xff},
 	"ultramarine":                               {R: 0x5d, G: 0x8c, B: 0xae, A: 0xff},
 	"gunjō-iro":                                 {R: 0x5d, G: 0x8c, B: 0xae, A: 0xff},
-	"gunjo-iro":                                 {R: 0x5d, G: 0x8c, B: 0xae, A: 0xff},
 	"umber":                                     {R: 0x63, G: 0x51, B: 0x47, A: 0xff},
 	"unbleached silk":                           {R: 0xff, G: 0xdd, B: 0xca, A: 0xff},
 	"underside of willow leaves":                {R: 0xbc, G: 0xb5, B: 0x8c, A: 0xff},
@@ -1844,7 +1815,6 @@ var encycolorpediaColours = withAliases(colourNameToRGBA{
 	"vegas gold":                                {R: 0xc5, G: 0xb3, B: 0x58, A: 0xff},
 	"velvet":                                    {R: 0x22, G: 0x46, B: 0x34, A: 0xff},
 	"birōdo":                                    {R: 0x22, G: 0x46, B: 0x34, A: 0xff},
-	"birodo":                                    {R: 0x22, G: 0x46, B: 0x34, A: 0xff},
 	"venetian red":                              {R: 0xc8, G: 0x08, B: 0x15, A: 0xff},
 	"verdigris":                                 {R: 0x43, G: 0xb3, B: 0xae, A: 0xff},
 	"verizon red":                               {R: 0xcd, G: 0x04, B: 0x0b, A: 0xff},
@@ -0,0 +1,85 @@
+package colour
+
+// colours from US Federal Standard 595C (FED-STD-595C, issued by the US
+// General Services Administration in 2008). This is a selection of 34 of
+// the standard's colours, chiefly those used on military vehicles and
+// aircraft. Each colour is given by its five-digit number, where the first
+// digit gives the finish (1 for gloss, 2 for semi-gloss and 3 for flat) and
+// the second the colour group, and by the informal name by which it is
+// commonly known; the standard itself does not name its colours and some
+// of these names (such as "brown" or "tan") are no more than descriptions.
+// The standard does not define sRGB values; these are unofficial
+// approximations and should be checked against the physical standard where
+// an exact match matters.
+//
+//nolint:mnd
+var fs595Colours = withAliases(colourNameToRGBA{
+	"10049":                {R: 0x5e, G: 0x3a, B: 0x24, A: 0xff},
+	"gloss brown":          {R: 0x5e, G: 0x3a, B: 0x24, A: 0xff},
+	"11136":                {R: 0xa0, G: 0x28, B: 0x2e, A: 0xff},
+	"insignia red":         {R: 0xa0, G: 0x28, B: 0x2e, A: 0xff},
+	"12197":                {R: 0xe3, G: 0x53, B: 0x1e, A: 0xff},
+	"international orange": {R: 0xe3, G: 0x53, B: 0x1e, A: 0xff},
+	"13538":                {R: 0xf3, G: 0xb1, B: 0x1f, A: 0xff},
+	"chrome yellow":        {R: 0xf3, G: 0xb1, B: 0x1f, A: 0xff},
+	"14187":                {R: 0x3c, G: 0x7a, B: 0x3a, A: 0xff},
+	"gloss green":          {R: 0x3c, G: 0x7a, B: 0x3a, A: 0xff},
+	"15044":                {R: 0x2b, G: 0x39, B: 0x53, A: 0xff},
+	"insignia blue":        {R: 0x2b, G: 0x39, B: 0x53, A: 0xff},
+	"15102":                {R: 0x2f, G: 0x4a, B: 0x6d, A: 0xff},
+	"gloss dark blue":      {R: 0x2f, G: 0x4a, B: 0x6d, A: 0xff},
+	"17038":                {R: 0x0a, G: 0x0a, B: 0x0a, A: 0xff},
+	"gloss black":          {R: 0x0a, G: 0x0a, B: 0x0a, A: 0xff},
+	"17875":                {R: 0xf5, G: 0xf5, B: 0xf5, A: 0xff},
+	"gloss white":          {R: 0xf5, G: 0xf5, B: 0xf5, A: 0xff},
+	"30118":                {R: 0x5b, G: 0x4e, B: 0x3b, A: 0xff},
+	"field drab":           {R: 0x5b, G: 0x4e, B: 0x3b, A: 0xff},
+	"30140":                {R: 0x5e, G: 0x4a, B: 0x34, A: 0xff},
+	"brown":                {R: 0x5e, G: 0x4a, B: 0x34, A: 0xff},
+	"30219":                {R: 0x8e, G: 0x6d, B: 0x4e, A: 0xff},
+	"tan":                  {R: 0x8e, G: 0x6d, B: 0x4e, A: 0xff},
+	"33531":                {R: 0xcd, G: 0xb5, B: 0x8c, A: 0xff},
+	"sand":                 {R: 0xcd, G: 0xb5, B: 0x8c, A: 0xff},
+	"33538":                {R: 0xf2, G: 0xb2, B: 0x30, A: 0xff},
+	"yellow":               {R: 0xf2, G: 0xb2, B: 0x30, A: 0xff},
+	"34079":                {R: 0x3b, G: 0x4a, B: 0x35, A: 0xff},
+	"forest green":         {R: 0x3b, G: 0x4a, B: 0x35, A: 0xff},
+	"34087":                {R: 0x5b, G: 0x58, B: 0x35, A: 0xff},
+	"olive drab":           {R: 0x5b, G: 0x58, B: 0x35, A: 0xff},
+	"34092":                {R: 0x38, G: 0x40, B: 0x35, A: 0xff},
+	"european green":       {R: 0x38, G: 0x40, B: 0x35, A: 0xff},
+	"34102":                {R: 0x4f, G: 0x6a, B: 0x41, A: 0xff},
+	"medium green":         {R: 0x4f, G: 0x6a, B: 0x41, A: 0xff},
+	"34151":                {R: 0x64, G: 0x7b, B: 0x4e, A: 0xff},
+	"interior green":       {R: 0x64, G: 0x7b, B: 0x4e, A: 0xff},
+	"34227":                {R: 0x85, G: 0xa2, B: 0x8a, A: 0xff},
+	"pale green":           {R: 0x85, G: 0xa2, B: 0x8a, A: 0xff},
+	"35042":                {R: 0x23, G: 0x30, B: 0x41, A: 0xff},
+	"sea blue":             {R: 0x23, G: 0x30, B: 0x41, A: 0xff},
+	"35237":                {R: 0x66, G: 0x74, B: 0x89, A: 0xff},
+	"blue grey":            {R: 0x66, G: 0x74, B: 0x89, A: 0xff},
+	"35622":                {R: 0xb9, G: 0xcb, B: 0xc4, A: 0xff},
+	"duck egg blue":        {R: 0xb9, G: 0xcb, B: 0xc4, A: 0xff},
+	"36118":                {R: 0x4b, G: 0x4f, B: 0x52, A: 0xff},
+	"gunship grey":         {R: 0x4b, G: 0x4f, B: 0x52, A: 0xff},
+	"36176":                {R: 0x4c, G: 0x50, B: 0x54, A: 0xff},
+	"dark grey":            {R: 0x4c, G: 0x50, B: 0x54, A: 0xff},
+	"36231":                {R: 0x5e, G: 0x64, B: 0x66, A: 0xff},
+	"dark gull grey":       {R: 0x5e, G: 0x64, B: 0x66, A: 0xff},
+	"36270":                {R: 0x7f, G: 0x85, B: 0x85, A: 0xff},
+	"neutral grey":         {R: 0x7f, G: 0x85, B: 0x85, A: 0xff},
+	"36320":                {R: 0x7d, G: 0x83, B: 0x8a, A: 0xff},
+	"dark ghost grey":      {R: 0x7d, G: 0x83, B: 0x8a, A: 0xff},
+	"36375":                {R: 0xa7, G: 0xab, B: 0xb0, A: 0xff},
+	"light ghost grey":     {R: 0xa7, G: 0xab, B: 0xb0, A: 0xff},
+	"36440":                {R: 0x98, G: 0x9b, B: 0x9b, A: 0xff},
+	"light gull grey":      {R: 0x98, G: 0x9b, B: 0x9b, A: 0xff},
+	"36495":                {R: 0xb9, G: 0xbc, B: 0xbb, A: 0xff},
+	"light grey":           {R: 0xb9, G: 0xbc, B: 0xbb, A: 0xff},
+	"36622":                {R: 0xc1, G: 0xc5, B: 0xc1, A: 0xff},
+	"camouflage grey":      {R: 0xc1, G: 0xc5, B: 0xc1, A: 0xff},
+	"37038":                {R: 0x1c, G: 0x1c, B: 0x1c, A: 0xff},
+	"flat black":           {R: 0x1c, G: 0x1c, B: 0x1c, A: 0xff},
+	"37875":                {R: 0xf2, G: 0xf2, B: 0xf0, A: 0xff},
+	"flat white":           {R: 0xf2, G: 0xf2, B: 0xf0, A: 0xff},
+})
@@ -0,0 +1,447 @@
+package colour
+
+import (
+	"fmt"
+	"image/color" //nolint:misspell
+	"strconv"
+	"strings"
+)
+
+// isccNBSCentroid records the name of an ISCC-NBS colour category and the
+// colour of its centroid
+type isccNBSCentroid struct {
+	name   string
+	colour rgba
+}
+
+// colours from the ISCC-NBS colour-name dictionary. These are the centroid
+// colours of the 267 categories of the Inter-Society Color Council and
+// National Bureau of Standards system, in the order of their numbers (from
+// 1 for "vivid pink" to 267 for "black"). The names are the level 3 names;
+// see isccNBSLevel2Names for the coarser levels.
+//
+//nolint:mnd
+var isccNBSCentroids = []isccNBSCentroid{
+	{name: "vivid pink", colour: rgba{R: 0xff, G: 0xb5, B: 0xba, A: 0xff}},
+	{name: "strong pink", colour: rgba{R: 0xea, G: 0x93, B: 0x99, A: 0xff}},
+	{name: "deep pink", colour: rgba{R: 0xe4, G: 0x71, B: 0x7a, A: 0xff}},
+	{name: "light pink", colour: rgba{R: 0xf9, G: 0xcc, B: 0xca, A: 0xff}},
+	{name: "moderate pink", colour: rgba{R: 0xde, G: 0xa5, B: 0xa4, A: 0xff}},
+	{name: "dark pink", colour: rgba{R: 0xc0, G: 0x80, B: 0x81, A: 0xff}},
+	{name: "pale pink", colour: rgba{R: 0xea, G: 0xd8, B: 0xd7, A: 0xff}},
+	{name: "grayish pink", colour: rgba{R: 0xc4, G: 0xae, B: 0xad, A: 0xff}},
+	{name: "pinkish white", colour: rgba{R: 0xea, G: 0xe3, B: 0xe1, A: 0xff}},
+	{name: "pinkish gray", colour: rgba{R: 0xc1, G: 0xb6, B: 0xb3, A: 0xff}},
+	{name: "vivid red", colour: rgba{R: 0xbe, G: 0x00, B: 0x32, A: 0xff}},
+	{name: "strong red", colour: rgba{R: 0xbc, G: 0x3f, B: 0x4a, A: 0xff}},
+	{name: "deep red", colour: rgba{R: 0x84, G: 0x1b, B: 0x2d, A: 0xff}},
+	{name: "very deep red", colour: rgba{R: 0x5c, G: 0x09, B: 0x23, A: 0xff}},
+	{name: "moderate red", colour: rgba{R: 0xab, G: 0x4e, B: 0x52, A: 0xff}},
+	{name: "dark red", colour: rgba{R: 0x72, G: 0x2f, B: 0x37, A: 0xff}},
+	{name: "very dark red", colour: rgba{R: 0x3f, G: 0x17, B: 0x28, A: 0xff}},
+	{name: "light grayish red", colour: rgba{R: 0xad, G: 0x88, B: 0x84, A: 0xff}},
+	{name: "grayish red", colour: rgba{R: 0x90, G: 0x5d, B: 0x5d, A: 0xff}},
+	{name: "dark grayish red", colour: rgba{R: 0x54, G: 0x3d, B: 0x3f, A: 0xff}},
+	{name: "blackish red", colour: rgba{R: 0x2e, G: 0x1d, B: 0x21, A: 0xff}},
+	{name: "reddish gray", colour: rgba{R: 0x8f, G: 0x81, B: 0x7f, A: 0xff}},
+	{name: "dark reddish gray", colour: rgba{R: 0x5c, G: 0x50, B: 0x4f, A: 0xff}},
+	{name: "reddish black", colour: rgba{R: 0x23, G: 0x20, B: 0x21, A: 0xff}},
+	{name: "vivid yellowish pink", colour: rgba{R: 0xff, G: 0xb7, B: 0xa5, A: 0xff}},
+	{name: "strong yellowish pink", colour: rgba{R: 0xf9, G: 0x93, B: 0x79, A: 0xff}},
+	{name: "deep yellowish pink", colour: rgba{R: 0xe6, G: 0x67, B: 0x61, A: 0xff}},
+	{name: "light yellowish pink", colour: rgba{R: 0xf4, G: 0xc2, B: 0xc2, A: 0xff}},
+	{name: "moderate yellowish pink", colour: rgba{R: 0xd9, G: 0xa6, B: 0xa9, A: 0xff}},
+	{name: "dark yellowish pink", colour: rgba{R: 0xc4, G: 0x83, B: 0x79, A: 0xff}},
+	{name: "pale yellowish pink", colour: rgba{R: 0xec, G: 0xd5, B: 0xc5, A: 0xff}},
+	{name: "grayish yellowish pink", colour: rgba{R: 0xc7, G: 0xad, B: 0xa3, A: 0xff}},
+	{name: "brownish pink", colour: rgba{R: 0xc2, G: 0xac, B: 0x99, A: 0xff}},
+	{name: "vivid reddish orange", colour: rgba{R: 0xe2, G: 0x58, B: 0x22, A: 0xff}},
+	{name: "strong reddish orange", colour: rgba{R: 0xd9, G: 0x60, B: 0x3b, A: 0xff}},
+	{name: "deep reddish orange", colour: rgba{R: 0xaa, G: 0x38, B: 0x1e, A: 0xff}},
+	{name: "moderate reddish orange", colour: rgba{R: 0xcb, G: 0x6d, B: 0x51, A: 0xff}},
+	{name: "dark reddish orange", colour: rgba{R: 0x9e, G: 0x47, B: 0x32, A: 0xff}},
+	{name: "grayish reddish orange", colour: rgba{R: 0xb4, G: 0x74, B: 0x5e, A: 0xff}},
+	{name: "strong reddish brown", colour: rgba{R: 0x88, G: 0x2d, B: 0x17, A: 0xff}},
+	{name: "deep reddish brown", colour: rgba{R: 0x56, G: 0x07, B: 0x0c, A: 0xff}},
+	{name: "light reddish brown", colour: rgba{R: 0xa8, G: 0x7c, B: 0x6d, A: 0xff}},
+	{name: "moderate reddish brown", colour: rgba{R: 0x79, G: 0x44, B: 0x3b, A: 0xff}},
+	{name: "dark reddish brown", colour: rgba{R: 0x3e, G: 0x1d, B: 0x1e, A: 0xff}},
+	{name: "light grayish reddish brown", colour: rgba{R: 0x97, G: 0x7f, B: 0x73, A: 0xff}},
+	{name: "grayish reddish brown", colour: rgba{R: 0x67, G: 0x4c, B: 0x47, A: 0xff}},
+	{name: "dark grayish reddish brown", colour: rgba{R: 0x43, G: 0x30, B: 0x2e, A: 0xff}},
+	{name: "vivid orange", colour: rgba{R: 0xf3, G: 0x84, B: 0x00, A: 0xff}},
+	{name: "brilliant orange", colour: rgba{R: 0xfd, G: 0x94, B: 0x3f, A: 0xff}},
+	{name: "strong orange", colour: rgba{R: 0xed, G: 0x87, B: 0x2d, A: 0xff}},
+	{name: "deep orange", colour: rgba{R: 0xbe, G: 0x65, B: 0x16, A: 0xff}},
+	{name: "light orange", colour: rgba{R: 0xfa, G: 0xb5, B: 0x7f, A: 0xff}},
+	{name: "moderate orange", colour: rgba{R: 0xd9, G: 0x90, B: 0x58, A: 0xff}},
+	{name: "brownish orange", colour: rgba{R: 0xae, G: 0x69, B: 0x38, A: 0xff}},
+	{name: "strong brown", colour: rgba{R: 0x80, G: 0x46, B: 0x1b, A: 0xff}},
+	{name: "deep brown", colour: rgba{R: 0x59, G: 0x33, B: 0x19, A: 0xff}},
+	{name: "light brown", colour: rgba{R: 0xa6, G: 0x7b, B: 0x5b, A: 0xff}},
+	{name: "moderate brown", colour: rgba{R: 0x6f, G: 0x4e, B: 0x37, A: 0xff}},
+	{name: "dark brown", colour: rgba{R: 0x42, G: 0x25, B: 0x18, A: 0xff}},
+	{name: "light grayish brown", colour: rgba{R: 0x95, G: 0x80, B: 0x70, A: 0xff}},
+	{name: "grayish brown", colour: rgba{R: 0x63, G: 0x51, B: 0x47, A: 0xff}},
+	{name: "dark grayish brown", colour: rgba{R: 0x3e, G: 0x32, B: 0x2c, A: 0xff}},
+	{name: "light brownish gray", colour: rgba{R: 0x8e, G: 0x82, B: 0x79, A: 0xff}},
+	{name: "brownish gray", colour: rgba{R: 0x5b, G: 0x50, B: 0x4f, A: 0xff}},
+	{name: "brownish black", colour: rgba{R: 0x28, G: 0x20, B: 0x1c, A: 0xff}},
+	{name: "vivid orange yellow", colour: rgba{R: 0xf6, G: 0xa6, B: 0x00, A: 0xff}},
+	{name: "brilliant orange yellow", colour: rgba{R: 0xff, G: 0xc1, B: 0x4f, A: 0xff}},
+	{name: "strong orange yellow", colour: rgba{R: 0xea, G: 0xa2, B: 0x21, A: 0xff}},
+	{name: "deep orange yellow", colour: rgba{R: 0xc9, G: 0x85, B: 0x00, A: 0xff}},
+	{name: "light orange yellow", colour: rgba{R: 0xfb, G: 0xc9, B: 0x7f, A: 0xff}},
+	{name: "moderate orange yellow", colour: rgba{R: 0xe3, G: 0xa8, B: 0x57, A: 0xff}},
+	{name: "dark orange yellow", colour: rgba{R: 0xbe, G: 0x8a, B: 0x3d, A: 0xff}},
+	{name: "pale orange yellow", colour: rgba{R: 0xfa, G: 0xd6, B: 0xa5, A: 0xff}},
+	{name: "strong yellowish brown", colour: rgba{R: 0x99, G: 0x65, B: 0x15, A: 0xff}},
+	{name: "deep yellowish brown", colour: rgba{R: 0x65, G: 0x45, B: 0x22, A: 0xff}},
+	{name: "light yellowish brown", colour: rgba{R: 0xc1, G: 0x9a, B: 0x6b, A: 0xff}},
+	{name: "moderate yellowish brown", colour: rgba{R: 0x82, G: 0x66, B: 0x44, A: 0xff}},
+	{name: "dark yellowish brown", colour: rgba{R: 0x4b, G: 0x36, B: 0x21, A: 0xff}},
+	{name: "light grayish yellowish brown", colour: rgba{R: 0xae, G: 0x9b, B: 0x82, A: 0xff}},
+	{name: "grayish yellowish brown", colour: rgba{R: 0x7e, G: 0x6d, B: 0x5a, A: 0xff}},
+	{name: "dark grayish yellowish brown", colour: rgba{R: 0x48, G: 0x3c, B: 0x32, A: 0xff}},
+	{name: "vivid yellow", colour: rgba{R: 0xf3, G: 0xc3, B: 0x00, A: 0xff}},
+	{name: "brilliant yellow", colour: rgba{R: 0xfa, G: 0xda, B: 0x5e, A: 0xff}},
+	{name: "strong yellow", colour: rgba{R: 0xd4, G: 0xaf, B: 0x37, A: 0xff}},
+	{name: "deep yellow", colour: rgba{R: 0xaf, G: 0x8d, B: 0x13, A: 0xff}},
+	{name: "light yellow", colour: rgba{R: 0xf8, G: 0xde, B: 0x7e, A: 0xff}},
+	{name: "moderate yellow", colour: rgba{R: 0xc9, G: 0xae, B: 0x5d, A: 0xff}},
+	{name: "dark yellow", colour: rgba{R: 0xab, G: 0x91, B: 0x44, A: 0xff}},
+	{name: "pale yellow", colour: rgba{R: 0xf3, G: 0xe5, B: 0xab, A: 0xff}},
+	{name: "grayish yellow", colour: rgba{R: 0xc2, G: 0xb2, B: 0x80, A: 0xff}},
+	{name: "dark grayish yellow", colour: rgba{R: 0xa1, G: 0x8f, B: 0x60, A: 0xff}},
+	{name: "yellowish white", colour: rgba{R: 0xf0, G: 0xea, B: 0xd6, A: 0xff}},
+	{name: "yellowish gray", colour: rgba{R: 0xbf, G: 0xb8, B: 0xa5, A: 0xff}},
+	{name: "light olive brown", colour: rgba{R: 0x96, G: 0x71, B: 0x17, A: 0xff}},
+	{name: "moderate olive brown", colour: rgba{R: 0x6c, G: 0x54, B: 0x1e, A: 0xff}},
+	{name: "dark olive brown", colour: rgba{R: 0x3b, G: 0x31, B: 0x21, A: 0xff}},
+	{name: "vivid greenish yellow", colour: rgba{R: 0xdc, G: 0xd3, B: 0x00, A: 0xff}},
+	{name: "brilliant greenish yellow", colour: rgba{R: 0xe9, G: 0xe4, B: 0x50, A: 0xff}},
+	{name: "strong greenish yellow", colour: rgba{R: 0xbe, G: 0xb7, B: 0x2e, A: 0xff}},
+	{name: "deep greenish yellow", colour: rgba{R: 0x9b, G: 0x94, B: 0x00, A: 0xff}},
+	{name: "light greenish yellow", colour: rgba{R: 0xea, G: 0xe6, B: 0x79, A: 0xff}},
+	{name: "moderate greenish yellow", colour: rgba{R: 0xb9, G: 0xb4, B: 0x59, A: 0xff}},
+	{name: "dark greenish yellow", colour: rgba{R: 0x98, G: 0x94, B: 0x3e, A: 0xff}},
+	{name: "pale greenish yellow", colour: rgba{R: 0xeb, G: 0xe8, B: 0xa4, A: 0xff}},
+	{name: "grayish greenish yellow", colour: rgba{R: 0xb9, G: 0xb5, B: 0x7d, A: 0xff}},
+	{name: "light olive", colour: rgba{R: 0x86, G: 0x7e, B: 0x36, A: 0xff}},
+	{name: "moderate olive", colour: rgba{R: 0x66, G: 0x5d, B: 0x1e, A: 0xff}},
+	{name: "dark olive", colour: rgba{R: 0x40, G: 0x3d, B: 0x21, A: 0xff}},
+	{name: "light grayish olive", colour: rgba{R: 0x8c, G: 0x87, B: 0x67, A: 0xff}},
+	{name: "grayish olive", colour: rgba{R: 0x5b, G: 0x58, B: 0x42, A: 0xff}},
+	{name: "dark grayish olive", colour: rgba{R: 0x36, G: 0x35, B: 0x27, A: 0xff}},
+	{name: "light olive gray", colour: rgba{R: 0x8a, G: 0x87, B: 0x76, A: 0xff}},
+	{name: "olive gray", colour: rgba{R: 0x57, G: 0x55, B: 0x4c, A: 0xff}},
+	{name: "olive black", colour: rgba{R: 0x25, G: 0x24, B: 0x1d, A: 0xff}},
+	{name: "vivid yellow green", colour: rgba{R: 0x8d, G: 0xb6, B: 0x00, A: 0xff}},
+	{name: "brilliant yellow green", colour: rgba{R: 0xbd, G: 0xda, B: 0x57, A: 0xff}},
+	{name: "strong yellow green", colour: rgba{R: 0x7e, G: 0x9f, B: 0x2e, A: 0xff}},
+	{name: "deep yellow green", colour: rgba{R: 0x46, G: 0x71, B: 0x29, A: 0xff}},
+	{name: "light yellow green", colour: rgba{R: 0xc9, G: 0xdc, B: 0x89, A: 0xff}},
+	{name: "moderate yellow green", colour: rgba{R: 0x8a, G: 0x9a, B: 0x5b, A: 0xff}},
+	{name: "pale yellow green", colour: rgba{R: 0xda, G: 0xdf, B: 0xb7, A: 0xff}},
+	{name: "grayish yellow green", colour: rgba{R: 0x8f, G: 0x97, B: 0x79, A: 0xff}},
+	{name: "strong olive green", colour: rgba{R: 0x40, G: 0x4f, B: 0x00, A: 0xff}},
+	{name: "deep olive green", colour: rgba{R: 0x23, G: 0x2f, B: 0x00, A: 0xff}},
+	{name: "moderate olive green", colour: rgba{R: 0x4a, G: 0x5d, B: 0x23, A: 0xff}},
+	{name: "dark olive green", colour: rgba{R: 0x2b, G: 0x3d, B: 0x26, A: 0xff}},
+	{name: "grayish olive green", colour: rgba{R: 0x51, G: 0x57, B: 0x44, A: 0xff}},
+	{name: "dark grayish olive green", colour: rgba{R: 0x31, G: 0x36, B: 0x2b, A: 0xff}},
+	{name: "vivid yellowish green", colour: rgba{R: 0x27, G: 0xa6, B: 0x4c, A: 0xff}},
+	{name: "brilliant yellowish green", colour: rgba{R: 0x83, G: 0xd3, B: 0x7d, A: 0xff}},
+	{name: "strong yellowish green", colour: rgba{R: 0x44, G: 0x94, B: 0x4a, A: 0xff}},
+	{name: "deep yellowish green", colour: rgba{R: 0x00, G: 0x62, B: 0x2d, A: 0xff}},
+	{name: "very deep yellowish green", colour: rgba{R: 0x00, G: 0x31, B: 0x18, A: 0xff}},
+	{name: "very light yellowish green", colour: rgba{R: 0xb6, G: 0xe5, B: 0xaf, A: 0xff}},
+	{name: "light yellowish green", colour: rgba{R: 0x93, G: 0xc5, B: 0x92, A: 0xff}},
+	{name: "moderate yellowish green", colour: rgba{R: 0x67, G: 0x92, B: 0x67, A: 0xff}},
+	{name: "dark yellowish green", colour: rgba{R: 0x35, G: 0x5e, B: 0x3b, A: 0xff}},
+	{name: "very dark yellowish green", colour: rgba{R: 0x17, G: 0x36, B: 0x20, A: 0xff}},
+	{name: "vivid green", colour: rgba{R: 0x00, G: 0x88, B: 0x56, A: 0xff}},
+	{name: "brilliant green", colour: rgba{R: 0x3e, G: 0xb4, B: 0x89, A: 0xff}},
+	{name: "strong green", colour: rgba{R: 0x00, G: 0x79, B: 0x59, A: 0xff}},
+	{name: "deep green", colour: rgba{R: 0x00, G: 0x54, B: 0x3d, A: 0xff}},
+	{name: "very light green", colour: rgba{R: 0x8e, G: 0xd1, B: 0xb2, A: 0xff}},
+	{name: "light green", colour: rgba{R: 0x6a, G: 0xab, B: 0x8e, A: 0xff}},
+	{name: "moderate green", colour: rgba{R: 0x3b, G: 0x78, B: 0x61, A: 0xff}},
+	{name: "dark green", colour: rgba{R: 0x1b, G: 0x4d, B: 0x3e, A: 0xff}},
+	{name: "very dark green", colour: rgba{R: 0x1c, G: 0x35, B: 0x2d, A: 0xff}},
+	{name: "very pale green", colour: rgba{R: 0xc7, G: 0xe6, B: 0xd7, A: 0xff}},
+	{name: "pale green", colour: rgba{R: 0x8d, G: 0xa3, B: 0x99, A: 0xff}},
+	{name: "grayish green", colour: rgba{R: 0x5e, G: 0x71, B: 0x6a, A: 0xff}},
+	{name: "dark grayish green", colour: rgba{R: 0x3a, G: 0x4b, B: 0x47, A: 0xff}},
+	{name: "blackish green", colour: rgba{R: 0x1a, G: 0x24, B: 0x21, A: 0xff}},
+	{name: "greenish white", colour: rgba{R: 0xdf, G: 0xed, B: 0xe8, A: 0xff}},
+	{name: "light greenish gray", colour: rgba{R: 0xb2, G: 0xbe, B: 0xb5, A: 0xff}},
+	{name: "greenish gray", colour: rgba{R: 0x7d, G: 0x89, B: 0x84, A: 0xff}},
+	{name: "dark greenish gray", colour: rgba{R: 0x4e, G: 0x57, B: 0x55, A: 0xff}},
+	{name: "greenish black", colour: rgba{R: 0x1e, G: 0x23, B: 0x21, A: 0xff}},
+	{name: "vivid bluish green", colour: rgba{R: 0x00, G: 0x88, B: 0x82, A: 0xff}},
+	{name: "brilliant bluish green", colour: rgba{R: 0x00, G: 0xa6, B: 0x93, A: 0xff}},
+	{name: "strong bluish green", colour: rgba{R: 0x00, G: 0x7a, B: 0x74, A: 0xff}},
+	{name: "deep bluish green", colour: rgba{R: 0x00, G: 0x44, B: 0x3f, A: 0xff}},
+	{name: "very light bluish green", colour: rgba{R: 0x96, G: 0xde, B: 0xd1, A: 0xff}},
+	{name: "light bluish green", colour: rgba{R: 0x66, G: 0xad, B: 0xa4, A: 0xff}},
+	{name: "moderate bluish green", colour: rgba{R: 0x31, G: 0x78, B: 0x73, A: 0xff}},
+	{name: "dark bluish green", colour: rgba{R: 0x00, G: 0x4b, B: 0x49, A: 0xff}},
+	{name: "very dark bluish green", colour: rgba{R: 0x00, G: 0x2a, B: 0x29, A: 0xff}},
+	{name: "vivid greenish blue", colour: rgba{R: 0x00, G: 0x85, B: 0xa1, A: 0xff}},
+	{name: "brilliant greenish blue", colour: rgba{R: 0x23, G: 0x9e, B: 0xba, A: 0xff}},
+	{name: "strong greenish blue", colour: rgba{R: 0x00, G: 0x77, B: 0x91, A: 0xff}},
+	{name: "deep greenish blue", colour: rgba{R: 0x2e, G: 0x84, B: 0x95, A: 0xff}},
+	{name: "very light greenish blue", colour: rgba{R: 0x9c, G: 0xd1, B: 0xdc, A: 0xff}},
+	{name: "light greenish blue", colour: rgba{R: 0x66, G: 0xaa, B: 0xbc, A: 0xff}},
+	{name: "moderate greenish blue", colour: rgba{R: 0x36, G: 0x75, B: 0x88, A: 0xff}},
+	{name: "dark greenish blue", colour: rgba{R: 0x00, G: 0x49, B: 0x58, A: 0xff}},
+	{name: "very dark greenish blue", colour: rgba{R: 0x00, G: 0x2e, B: 0x3b, A: 0xff}},
+	{name: "vivid blue", colour: rgba{R: 0x00, G: 0xa1, B: 0xc2, A: 0xff}},
+	{name: "brilliant blue", colour: rgba{R: 0x49, G: 0x97, B: 0xd0, A: 0xff}},
+	{name: "strong blue", colour: rgba{R: 0x00, G: 0x67, B: 0xa5, A: 0xff}},
+	{name: "deep blue", colour: rgba{R: 0x00, G: 0x41, B: 0x6a, A: 0xff}},
+	{name: "very light blue", colour: rgba{R: 0xa1, G: 0xca, B: 0xf1, A: 0xff}},
+	{name: "light blue", colour: rgba{R: 0x70, G: 0xa3, B: 0xcc, A: 0xff}},
+	{name: "moderate blue", colour: rgba{R: 0x43, G: 0x6b, B: 0x95, A: 0xff}},
+	{name: "dark blue", colour: rgba{R: 0x00, G: 0x30, B: 0x4e, A: 0xff}},
+	{name: "very pale blue", colour: rgba{R: 0xbc, G: 0xd4, B: 0xe6, A: 0xff}},
+	{name: "pale blue", colour: rgba{R: 0x91, G: 0xa3, B: 0xb0, A: 0xff}},
+	{name: "grayish blue", colour: rgba{R: 0x53, G: 0x68, B: 0x78, A: 0xff}},
+	{name: "dark grayish blue", colour: rgba{R: 0x36, G: 0x45, B: 0x4f, A: 0xff}},
+	{name: "blackish blue", colour: rgba{R: 0x20, G: 0x28, B: 0x30, A: 0xff}},
+	{name: "bluish white", colour: rgba{R: 0xe9, G: 0xe9, B: 0xed, A: 0xff}},
+	{name: "light bluish gray", colour: rgba{R: 0xb4, G: 0xbc, B: 0xc0, A: 0xff}},
+	{name: "bluish gray", colour: rgba{R: 0x81, G: 0x87, B: 0x8b, A: 0xff}},
+	{name: "dark bluish gray", colour: rgba{R: 0x51, G: 0x58, B: 0x5e, A: 0xff}},
+	{name: "bluish black", colour: rgba{R: 0x20, G: 0x24, B: 0x28, A: 0xff}},
+	{name: "vivid purplish blue", colour: rgba{R: 0x30, G: 0x26, B: 0x7a, A: 0xff}},
+	{name: "brilliant purplish blue", colour: rgba{R: 0x6c, G: 0x79, B: 0xb8, A: 0xff}},
+	{name: "strong purplish blue", colour: rgba{R: 0x54, G: 0x5a, B: 0xa7, A: 0xff}},
+	{name: "deep purplish blue", colour: rgba{R: 0x27, G: 0x24, B: 0x58, A: 0xff}},
+	{name: "very light purplish blue", colour: rgba{R: 0xb3, G: 0xbc, B: 0xe2, A: 0xff}},
+	{name: "light purplish blue", colour: rgba{R: 0x87, G: 0x91, B: 0xbf, A: 0xff}},
+	{name: "moderate purplish blue", colour: rgba{R: 0x4e, G: 0x51, B: 0x80, A: 0xff}},
+	{name: "dark purplish blue", colour: rgba{R: 0x25, G: 0x24, B: 0x40, A: 0xff}},
+	{name: "very pale purplish blue", colour: rgba{R: 0xc0, G: 0xc8, B: 0xe1, A: 0xff}},
+	{name: "pale purplish blue", colour: rgba{R: 0x8c, G: 0x92, B: 0xac, A: 0xff}},
+	{name: "grayish purplish blue", colour: rgba{R: 0x4c, G: 0x51, B: 0x6d, A: 0xff}},
+	{name: "vivid violet", colour: rgba{R: 0x90, G: 0x65, B: 0xca, A: 0xff}},
+	{name: "brilliant violet", colour: rgba{R: 0x7e, G: 0x73, B: 0xb8, A: 0xff}},
+	{name: "strong violet", colour: rgba{R: 0x60, G: 0x4e, B: 0x97, A: 0xff}},
+	{name: "deep violet", colour: rgba{R: 0x32, G: 0x17, B: 0x4d, A: 0xff}},
+	{name: "very light violet", colour: rgba{R: 0xdc, G: 0xd0, B: 0xff, A: 0xff}},
+	{name: "light violet", colour: rgba{R: 0x8c, G: 0x82, B: 0xb6, A: 0xff}},
+	{name: "moderate violet", colour: rgba{R: 0x60, G: 0x4e, B: 0x81, A: 0xff}},
+	{name: "dark violet", colour: rgba{R: 0x2f, G: 0x21, B: 0x40, A: 0xff}},
+	{name: "very pale violet", colour: rgba{R: 0xc4, G: 0xc3, B: 0xdd, A: 0xff}},
+	{name: "pale violet", colour: rgba{R: 0x96, G: 0x90, B: 0xab, A: 0xff}},
+	{name: "grayish violet", colour: rgba{R: 0x55, G: 0x4c, B: 0x69, A: 0xff}},
+	{name: "vivid purple", colour: rgba{R: 0x9a, G: 0x4e, B: 0xae, A: 0xff}},
+	{name: "brilliant purple", colour: rgba{R: 0xd3, G: 0x99, B: 0xe6, A: 0xff}},
+	{name: "strong purple", colour: rgba{R: 0x87, G: 0x56, B: 0x92, A: 0xff}},
+	{name: "deep purple", colour: rgba{R: 0x60, G: 0x2f, B: 0x6b, A: 0xff}},
+	{name: "very deep purple", colour: rgba{R: 0x40, G: 0x1a, B: 0x4c, A: 0xff}},
+	{name: "very light purple", colour: rgba{R: 0xd5, G: 0xba, B: 0xdb, A: 0xff}},
+	{name: "light purple", colour: rgba{R: 0xb6, G: 0x87, B: 0xc1, A: 0xff}},
+	{name: "moderate purple", colour: rgba{R: 0x86, G: 0x60, B: 0x8e, A: 0xff}},
+	{name: "dark purple", colour: rgba{R: 0x56, G: 0x3c, B: 0x5c, A: 0xff}},
+	{name: "very dark purple", colour: rgba{R: 0x30, G: 0x19, B: 0x34, A: 0xff}},
+	{name: "very pale purple", colour: rgba{R: 0xd6, G: 0xca, B: 0xdd, A: 0xff}},
+	{name: "pale purple", colour: rgba{R: 0xaa, G: 0x98, B: 0xa9, A: 0xff}},
+	{name: "grayish purple", colour: rgba{R: 0x79, G: 0x68, B: 0x78, A: 0xff}},
+	{name: "dark grayish purple", colour: rgba{R: 0x50, G: 0x40, B: 0x4d, A: 0xff}},
+	{name: "blackish purple", colour: rgba{R: 0x29, G: 0x1e, B: 0x29, A: 0xff}},
+	{name: "purplish white", colour: rgba{R: 0xe8, G: 0xe3, B: 0xe5, A: 0xff}},
+	{name: "light purplish gray", colour: rgba{R: 0xbf, G: 0xb9, B: 0xbd, A: 0xff}},
+	{name: "purplish gray", colour: rgba{R: 0x8b, G: 0x85, B: 0x89, A: 0xff}},
+	{name: "dark purplish gray", colour: rgba{R: 0x5d, G: 0x55, B: 0x5b, A: 0xff}},
+	{name: "purplish black", colour: rgba{R: 0x24, G: 0x21, B: 0x24, A: 0xff}},
+	{name: "vivid reddish purple", colour: rgba{R: 0x87, G: 0x00, B: 0x74, A: 0xff}},
+	{name: "strong reddish purple", colour: rgba{R: 0x9e, G: 0x4f, B: 0x88, A: 0xff}},
+	{name: "deep reddish purple", colour: rgba{R: 0x70, G: 0x29, B: 0x63, A: 0xff}},
+	{name: "very deep reddish purple", colour: rgba{R: 0x54, G: 0x19, B: 0x4e, A: 0xff}},
+	{name: "light reddish purple", colour: rgba{R: 0xb7, G: 0x84, B: 0xa7, A: 0xff}},
+	{name: "moderate reddish purple", colour: rgba{R: 0x91, G: 0x5c, B: 0x83, A: 0xff}},
+	{name: "dark reddish purple", colour: rgba{R: 0x5d, G: 0x39, B: 0x54, A: 0xff}},
+	{name: "very dark reddish purple", colour: rgba{R: 0x34, G: 0x17, B: 0x31, A: 0xff}},
+	{name: "pale reddish purple", colour: rgba{R: 0xaa, G: 0x8a, B: 0x9e, A: 0xff}},
+	{name: "grayish reddish purple", colour: rgba{R: 0x83, G: 0x64, B: 0x79, A: 0xff}},
+	{name: "brilliant purplish pink", colour: rgba{R: 0xff, G: 0xc8, B: 0xd6, A: 0xff}},
+	{name: "strong purplish pink", colour: rgba{R: 0xe6, G: 0x8f, B: 0xac, A: 0xff}},
+	{name: "deep purplish pink", colour: rgba{R: 0xde, G: 0x6f, B: 0xa1, A: 0xff}},
+	{name: "light purplish pink", colour: rgba{R: 0xef, G: 0xbb, B: 0xcc, A: 0xff}},
+	{name: "moderate purplish pink", colour: rgba{R: 0xd5, G: 0x97, B: 0xae, A: 0xff}},
+	{name: "dark purplish pink", colour: rgba{R: 0xc1, G: 0x7e, B: 0x91, A: 0xff}},
+	{name: "pale purplish pink", colour: rgba{R: 0xe8, G: 0xcc, B: 0xd7, A: 0xff}},
+	{name: "grayish purplish pink", colour: rgba{R: 0xc3, G: 0xa6, B: 0xb1, A: 0xff}},
+	{name: "vivid purplish red", colour: rgba{R: 0xce, G: 0x46, B: 0x76, A: 0xff}},
+	{name: "strong purplish red", colour: rgba{R: 0xb3, G: 0x44, B: 0x6c, A: 0xff}},
+	{name: "deep purplish red", colour: rgba{R: 0x78, G: 0x18, B: 0x4a, A: 0xff}},
+	{name: "very deep purplish red", colour: rgba{R: 0x54, G: 0x13, B: 0x3b, A: 0xff}},
+	{name: "moderate purplish red", colour: rgba{R: 0xa8, G: 0x51, B: 0x6e, A: 0xff}},
+	{name: "dark purplish red", colour: rgba{R: 0x67, G: 0x31, B: 0x47, A: 0xff}},
+	{name: "very dark purplish red", colour: rgba{R: 0x38, G: 0x15, B: 0x2c, A: 0xff}},
+	{name: "light grayish purplish red", colour: rgba{R: 0xaf, G: 0x86, B: 0x8e, A: 0xff}},
+	{name: "grayish purplish red", colour: rgba{R: 0x91, G: 0x5f, B: 0x6d, A: 0xff}},
+	{name: "white", colour: rgba{R: 0xf2, G: 0xf3, B: 0xf4, A: 0xff}},
+	{name: "light gray", colour: rgba{R: 0xb9, G: 0xb8, B: 0xb5, A: 0xff}},
+	{name: "medium gray", colour: rgba{R: 0x84, G: 0x84, B: 0x82, A: 0xff}},
+	{name: "dark gray", colour: rgba{R: 0x55, G: 0x55, B: 0x55, A: 0xff}},
+	{name: "black", colour: rgba{R: 0x22, G: 0x22, B: 0x22, A: 0xff}},
+}
+
+// isccNBSLevel2Names holds the 29 level 2 names of the ISCC-NBS system. Each
+// level 3 name is a level 2 name preceded by modifiers (such as "light" or
+// "grayish")
+var isccNBSLevel2Names = []string{
+	"pink", "red", "yellowish pink", "reddish orange", "reddish brown",
+	"orange", "brown", "orange yellow", "yellowish brown", "yellow",
+	"olive brown", "greenish yellow", "olive", "yellow green", "olive green",
+	"yellowish green", "green", "bluish green", "greenish blue", "blue",
+	"purplish blue", "violet", "purple", "reddish purple", "purplish pink",
+	"purplish red", "white", "gray", "black",
+}
+
+// isccNBSLevel1Names maps the ISCC-NBS level 2 names to the 13 level 1 names
+var isccNBSLevel1Names = map[string]string{
+	"pink":            "pink",
+	"yellowish pink":  "pink",
+	"purplish pink":   "pink",
+	"red":             "red",
+	"purplish red":    "red",
+	"reddish orange":  "orange",
+	"orange":          "orange",
+	"reddish brown":   "brown",
+	"brown":           "brown",
+	"yellowish brown": "brown",
+	"olive brown":     "brown",
+	"orange yellow":   "yellow",
+	"yellow":          "yellow",
+	"greenish yellow": "yellow",
+	"olive":           "olive",
+	"olive green":     "olive",
+	"yellow green":    "yellow green",
+	"yellowish green": "green",
+	"green":           "green",
+	"bluish green":    "green",
+	"greenish blue":   "blue",
+	"blue":            "blue",
+	"purplish blue":   "blue",
+	"violet":          "purple",
+	"purple":          "purple",
+	"reddish purple":  "purple",
+	"white":           "white",
+	"gray":            "gray",
+	"black":           "black",
+}
+
+// These are the levels of the ISCC-NBS system. Level 1 has the 13 most
+// general names, level 2 has 29 names and level 3 has the 267 names of the
+// full dictionary.
+const (
+	ISCCNBSMinLevel = 1
+	ISCCNBSMaxLevel = 3
+)
+
+var (
+	// isccNBSColours holds the ISCC-NBS colours, named both by their
+	// number and their level 3 name
+	isccNBSColours = makeISCCNBSColours()
+
+	// isccNBSPalette is used to find the closest ISCC-NBS centroid
+	isccNBSPalette, _ = MakePerceptualPalette(isccNBSCentroidColours())
+)
+
+// makeISCCNBSColours returns the map of ISCC-NBS names to colours
+func makeISCCNBSColours() colourNameToRGBA {
+	m := colourNameToRGBA{}
+
+	for i, c := range isccNBSCentroids {
+		m[strconv.Itoa(i+1)] = c.colour
+		m[c.name] = c.colour
+	}
+
+	return withAliases(m)
+}
+
+// isccNBSCentroidColours returns the centroid colours in order
+func isccNBSCentroidColours() []rgba {
+	cs := make([]rgba, 0, len(isccNBSCentroids))
+	for _, c := range isccNBSCentroids {
+		cs = append(cs, c.colour)
+	}
+
+	return cs
+}
+
+// isccNBSLevelName converts the ISCC-NBS level 3 name to the name at the
+// given level. The level must be valid.
+func isccNBSLevelName(name string, level int) string {
+	if level == ISCCNBSMaxLevel {
+		return name
+	}
+
+	l2Name := ""
+
+	for _, n := range isccNBSLevel2Names {
+		if (name == n || strings.HasSuffix(name, " "+n)) &&
+			len(n) > len(l2Name) {
+			l2Name = n
+		}
+	}
+
+	if level == ISCCNBSMinLevel {
+		return isccNBSLevel1Names[l2Name]
+	}
+
+	return l2Name
+}
+
+// checkISCCNBSLevel returns a non-nil error if the level is not valid
+func checkISCCNBSLevel(level int) error {
+	if level < ISCCNBSMinLevel || level > ISCCNBSMaxLevel {
+		return fmt.Errorf("bad ISCC-NBS level: %d, it must be from %d to %d",
+			level, ISCCNBSMinLevel, ISCCNBSMaxLevel)
+	}
+
+	return nil
+}
+
+// DescribeISCCNBS returns the ISCC-NBS name, at the given level, of the
+// category whose centroid is closest to the colour. The closest centroid is
+// found in the Oklab colour space and the alpha value is ignored. Level 3
+// gives the most detailed name (such as "moderate reddish brown"), level 2
+// a less detailed one ("reddish brown") and level 1 the most general
+// ("brown"). A non-nil error is returned if the level is not valid.
+//
+//nolint:misspell
+func DescribeISCCNBS(c color.RGBA, level int) (string, error) {
+	if err := checkISCCNBSLevel(level); err != nil {
+		return "", err
+	}
+
+	name := isccNBSCentroids[isccNBSPalette.closest(c)].name
+
+	return isccNBSLevelName(name, level), nil
+}
+
+// ISCCNBSLevelName returns the name, at the given level, of the ISCC-NBS
+// colour with the given number or level 3 name (in either spelling of
+// "gray"). A non-nil error is returned if the level is not valid or the
+// colour is not found.
+func ISCCNBSLevelName(name string, level int) (string, error) {
+	if err := checkISCCNBSLevel(level); err != nil {
+		return "", err
+	}
+
+	name = strings.ToLower(strings.TrimSpace(name))
+
+	c, ok := isccNBSColours[name]
+	if !ok {
+		return "", badColourErr(name)
+	}
+
+	return isccNBSLevelName(
+		isccNBSCentroids[isccNBSPalette.closest(c)].name, level), nil
+}
@@ -0,0 +1,147 @@
+package colour
+
+import (
+	"testing"
+
+	"github.com/nickwells/colour.mod/v2/colourtesthelper"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestDescribeISCCNBS(t *testing.T) {
+	reddishBrown := rgba{R: 0x79, G: 0x44, B: 0x3b, A: 0xff}
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		c       rgba
+		level   int
+		expName string
+	}{
+		{
+			ID:      testhelper.MkID("level 3"),
+			c:       reddishBrown,
+			level:   3,
+			expName: "moderate reddish brown",
+		},
+		{
+			ID:      testhelper.MkID("level 2"),
+			c:       reddishBrown,
+			level:   2,
+			expName: "reddish brown",
+		},
+		{
+			ID:      testhelper.MkID("level 1"),
+			c:       reddishBrown,
+			level:   1,
+			expName: "brown",
+		},
+		{
+			ID:      testhelper.MkID("near a centroid, level 3"),
+			c:       rgba{R: 0x7a, G: 0x45, B: 0x3a, A: 0xff},
+			level:   3,
+			expName: "moderate reddish brown",
+		},
+		{
+			ID:      testhelper.MkID("neutral, level 2"),
+			c:       rgba{R: 0x58, G: 0x58, B: 0x58, A: 0xff},
+			level:   2,
+			expName: "gray",
+		},
+		{
+			ID:      testhelper.MkID("olive green, level 1"),
+			c:       rgba{R: 0x4a, G: 0x5d, B: 0x23, A: 0xff},
+			level:   1,
+			expName: "olive",
+		},
+		{
+			ID: testhelper.MkID("bad level"),
+			ExpErr: testhelper.MkExpErr(
+				"bad ISCC-NBS level: 4, it must be from 1 to 3"),
+			c:     reddishBrown,
+			level: 4,
+		},
+	}
+
+	for _, tc := range testCases {
+		name, err := DescribeISCCNBS(tc.c, tc.level)
+		if testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc) {
+			testhelper.DiffString(t, tc.IDStr(), "name", name, tc.expName)
+		}
+	}
+}
+
+func TestISCCNBSLevelName(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		name    string
+		level   int
+		expName string
+	}{
+		{
+			ID:      testhelper.MkID("by number"),
+			name:    "43",
+			level:   2,
+			expName: "reddish brown",
+		},
+		{
+			ID:      testhelper.MkID("UK spelling"),
+			name:    "Light Olive Grey",
+			level:   2,
+			expName: "gray",
+		},
+		{
+			ID:      testhelper.MkID("longest suffix"),
+			name:    "dark grayish olive green",
+			level:   2,
+			expName: "olive green",
+		},
+		{
+			ID:      testhelper.MkID("level 3"),
+			name:    "267",
+			level:   3,
+			expName: "black",
+		},
+		{
+			ID:     testhelper.MkID("bad name"),
+			ExpErr: testhelper.MkExpErr(`bad colour name: "mauve"`),
+			name:   "mauve",
+			level:  1,
+		},
+	}
+
+	for _, tc := range testCases {
+		name, err := ISCCNBSLevelName(tc.name, tc.level)
+		if testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc) {
+			testhelper.DiffString(t, tc.IDStr(), "name", name, tc.expName)
+		}
+	}
+}
+
+func TestISCCNBSColours(t *testing.T) {
+	nc, err := ParseNamedColour(nil, "iscc-nbs:moderate-reddish-brown")
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	colourtesthelper.DiffRGBA(t, "ISCC-NBS", "colour", nc.Colour(),
+		rgba{R: 0x79, G: 0x44, B: 0x3b, A: 0xff})
+
+	n, err := ISCCNBSColours.DistinctColourCount()
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	testhelper.DiffInt(t, "ISCC-NBS", "distinct colours", n, 267)
+
+	level2 := map[string]bool{}
+	level1 := map[string]bool{}
+
+	for _, c := range isccNBSCentroids {
+		level2[isccNBSLevelName(c.name, 2)] = true
+		level1[isccNBSLevelName(c.name, 1)] = true
+	}
+
+	testhelper.DiffInt(t, "ISCC-NBS", "level 2 names", len(level2), 29)
+	testhelper.DiffInt(t, "ISCC-NBS", "level 1 names", len(level1), 13)
+}
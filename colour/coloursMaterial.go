@@ -0,0 +1,312 @@
+package colour
+
+// colours from the 2014 Material Design colour palette. Each hue has ten
+// shades, from 50 (the lightest) to 900 (the darkest), and most hues also
+// have four accent shades, A100 to A700; the colours are named by the hue
+// and the shade, as in "blue 500" or "indigo a200".
+//
+//nolint:mnd
+var materialPalette = shadedPalette{
+	shades: []string{
+		"50", "100", "200", "300", "400", "500", "600",
+		"700", "800", "900", "a100", "a200", "a400", "a700",
+	},
+	hues: []shadedHue{
+		{name: "red", colours: []rgba{
+			{R: 0xff, G: 0xeb, B: 0xee, A: 0xff},
+			{R: 0xff, G: 0xcd, B: 0xd2, A: 0xff},
+			{R: 0xef, G: 0x9a, B: 0x9a, A: 0xff},
+			{R: 0xe5, G: 0x73, B: 0x73, A: 0xff},
+			{R: 0xef, G: 0x53, B: 0x50, A: 0xff},
+			{R: 0xf4, G: 0x43, B: 0x36, A: 0xff},
+			{R: 0xe5, G: 0x39, B: 0x35, A: 0xff},
+			{R: 0xd3, G: 0x2f, B: 0x2f, A: 0xff},
+			{R: 0xc6, G: 0x28, B: 0x28, A: 0xff},
+			{R: 0xb7, G: 0x1c, B: 0x1c, A: 0xff},
+			{R: 0xff, G: 0x8a, B: 0x80, A: 0xff},
+			{R: 0xff, G: 0x52, B: 0x52, A: 0xff},
+			{R: 0xff, G: 0x17, B: 0x44, A: 0xff},
+			{R: 0xd5, G: 0x00, B: 0x00, A: 0xff},
+		}},
+		{name: "pink", colours: []rgba{
+			{R: 0xfc, G: 0xe4, B: 0xec, A: 0xff},
+			{R: 0xf8, G: 0xbb, B: 0xd0, A: 0xff},
+			{R: 0xf4, G: 0x8f, B: 0xb1, A: 0xff},
+			{R: 0xf0, G: 0x62, B: 0x92, A: 0xff},
+			{R: 0xec, G: 0x40, B: 0x7a, A: 0xff},
+			{R: 0xe9, G: 0x1e, B: 0x63, A: 0xff},
+			{R: 0xd8, G: 0x1b, B: 0x60, A: 0xff},
+			{R: 0xc2, G: 0x18, B: 0x5b, A: 0xff},
+			{R: 0xad, G: 0x14, B: 0x57, A: 0xff},
+			{R: 0x88, G: 0x0e, B: 0x4f, A: 0xff},
+			{R: 0xff, G: 0x80, B: 0xab, A: 0xff},
+			{R: 0xff, G: 0x40, B: 0x81, A: 0xff},
+			{R: 0xf5, G: 0x00, B: 0x57, A: 0xff},
+			{R: 0xc5, G: 0x11, B: 0x62, A: 0xff},
+		}},
+		{name: "purple", colours: []rgba{
+			{R: 0xf3, G: 0xe5, B: 0xf5, A: 0xff},
+			{R: 0xe1, G: 0xbe, B: 0xe7, A: 0xff},
+			{R: 0xce, G: 0x93, B: 0xd8, A: 0xff},
+			{R: 0xba, G: 0x68, B: 0xc8, A: 0xff},
+			{R: 0xab, G: 0x47, B: 0xbc, A: 0xff},
+			{R: 0x9c, G: 0x27, B: 0xb0, A: 0xff},
+			{R: 0x8e, G: 0x24, B: 0xaa, A: 0xff},
+			{R: 0x7b, G: 0x1f, B: 0xa2, A: 0xff},
+			{R: 0x6a, G: 0x1b, B: 0x9a, A: 0xff},
+			{R: 0x4a, G: 0x14, B: 0x8c, A: 0xff},
+			{R: 0xea, G: 0x80, B: 0xfc, A: 0xff},
+			{R: 0xe0, G: 0x40, B: 0xfb, A: 0xff},
+			{R: 0xd5, G: 0x00, B: 0xf9, A: 0xff},
+			{R: 0xaa, G: 0x00, B: 0xff, A: 0xff},
+		}},
+		{name: "deep purple", colours: []rgba{
+			{R: 0xed, G: 0xe7, B: 0xf6, A: 0xff},
+			{R: 0xd1, G: 0xc4, B: 0xe9, A: 0xff},
+			{R: 0xb3, G: 0x9d, B: 0xdb, A: 0xff},
+			{R: 0x95, G: 0x75, B: 0xcd, A: 0xff},
+			{R: 0x7e, G: 0x57, B: 0xc2, A: 0xff},
+			{R: 0x67, G: 0x3a, B: 0xb7, A: 0xff},
+			{R: 0x5e, G: 0x35, B: 0xb1, A: 0xff},
+			{R: 0x51, G: 0x2d, B: 0xa8, A: 0xff},
+			{R: 0x45, G: 0x27, B: 0xa0, A: 0xff},
+			{R: 0x31, G: 0x1b, B: 0x92, A: 0xff},
+			{R: 0xb3, G: 0x88, B: 0xff, A: 0xff},
+			{R: 0x7c, G: 0x4d, B: 0xff, A: 0xff},
+			{R: 0x65, G: 0x1f, B: 0xff, A: 0xff},
+			{R: 0x62, G: 0x00, B: 0xea, A: 0xff},
+		}},
+		{name: "indigo", colours: []rgba{
+			{R: 0xe8, G: 0xea, B: 0xf6, A: 0xff},
+			{R: 0xc5, G: 0xca, B: 0xe9, A: 0xff},
+			{R: 0x9f, G: 0xa8, B: 0xda, A: 0xff},
+			{R: 0x79, G: 0x86, B: 0xcb, A: 0xff},
+			{R: 0x5c, G: 0x6b, B: 0xc0, A: 0xff},
+			{R: 0x3f, G: 0x51, B: 0xb5, A: 0xff},
+			{R: 0x39, G: 0x49, B: 0xab, A: 0xff},
+			{R: 0x30, G: 0x3f, B: 0x9f, A: 0xff},
+			{R: 0x28, G: 0x35, B: 0x93, A: 0xff},
+			{R: 0x1a, G: 0x23, B: 0x7e, A: 0xff},
+			{R: 0x8c, G: 0x9e, B: 0xff, A: 0xff},
+			{R: 0x53, G: 0x6d, B: 0xfe, A: 0xff},
+			{R: 0x3d, G: 0x5a, B: 0xfe, A: 0xff},
+			{R: 0x30, G: 0x4f, B: 0xfe, A: 0xff},
+		}},
+		{name: "blue", colours: []rgba{
+			{R: 0xe3, G: 0xf2, B: 0xfd, A: 0xff},
+			{R: 0xbb, G: 0xde, B: 0xfb, A: 0xff},
+			{R: 0x90, G: 0xca, B: 0xf9, A: 0xff},
+			{R: 0x64, G: 0xb5, B: 0xf6, A: 0xff},
+			{R: 0x42, G: 0xa5, B: 0xf5, A: 0xff},
+			{R: 0x21, G: 0x96, B: 0xf3, A: 0xff},
+			{R: 0x1e, G: 0x88, B: 0xe5, A: 0xff},
+			{R: 0x19, G: 0x76, B: 0xd2, A: 0xff},
+			{R: 0x15, G: 0x65, B: 0xc0, A: 0xff},
+			{R: 0x0d, G: 0x47, B: 0xa1, A: 0xff},
+			{R: 0x82, G: 0xb1, B: 0xff, A: 0xff},
+			{R: 0x44, G: 0x8a, B: 0xff, A: 0xff},
+			{R: 0x29, G: 0x79, B: 0xff, A: 0xff},
+			{R: 0x29, G: 0x62, B: 0xff, A: 0xff},
+		}},
+		{name: "light blue", colours: []rgba{
+			{R: 0xe1, G: 0xf5, B: 0xfe, A: 0xff},
+			{R: 0xb3, G: 0xe5, B: 0xfc, A: 0xff},
+			{R: 0x81, G: 0xd4, B: 0xfa, A: 0xff},
+			{R: 0x4f, G: 0xc3, B: 0xf7, A: 0xff},
+			{R: 0x29, G: 0xb6, B: 0xf6, A: 0xff},
+			{R: 0x03, G: 0xa9, B: 0xf4, A: 0xff},
+			{R: 0x03, G: 0x9b, B: 0xe5, A: 0xff},
+			{R: 0x02, G: 0x88, B: 0xd1, A: 0xff},
+			{R: 0x02, G: 0x77, B: 0xbd, A: 0xff},
+			{R: 0x01, G: 0x57, B: 0x9b, A: 0xff},
+			{R: 0x80, G: 0xd8, B: 0xff, A: 0xff},
+			{R: 0x40, G: 0xc4, B: 0xff, A: 0xff},
+			{R: 0x00, G: 0xb0, B: 0xff, A: 0xff},
+			{R: 0x00, G: 0x91, B: 0xea, A: 0xff},
+		}},
+		{name: "cyan", colours: []rgba{
+			{R: 0xe0, G: 0xf7, B: 0xfa, A: 0xff},
+			{R: 0xb2, G: 0xeb, B: 0xf2, A: 0xff},
+			{R: 0x80, G: 0xde, B: 0xea, A: 0xff},
+			{R: 0x4d, G: 0xd0, B: 0xe1, A: 0xff},
+			{R: 0x26, G: 0xc6, B: 0xda, A: 0xff},
+			{R: 0x00, G: 0xbc, B: 0xd4, A: 0xff},
+			{R: 0x00, G: 0xac, B: 0xc1, A: 0xff},
+			{R: 0x00, G: 0x97, B: 0xa7, A: 0xff},
+			{R: 0x00, G: 0x83, B: 0x8f, A: 0xff},
+			{R: 0x00, G: 0x60, B: 0x64, A: 0xff},
+			{R: 0x84, G: 0xff, B: 0xff, A: 0xff},
+			{R: 0x18, G: 0xff, B: 0xff, A: 0xff},
+			{R: 0x00, G: 0xe5, B: 0xff, A: 0xff},
+			{R: 0x00, G: 0xb8, B: 0xd4, A: 0xff},
+		}},
+		{name: "teal", colours: []rgba{
+			{R: 0xe0, G: 0xf2, B: 0xf1, A: 0xff},
+			{R: 0xb2, G: 0xdf, B: 0xdb, A: 0xff},
+			{R: 0x80, G: 0xcb, B: 0xc4, A: 0xff},
+			{R: 0x4d, G: 0xb6, B: 0xac, A: 0xff},
+			{R: 0x26, G: 0xa6, B: 0x9a, A: 0xff},
+			{R: 0x00, G: 0x96, B: 0x88, A: 0xff},
+			{R: 0x00, G: 0x89, B: 0x7b, A: 0xff},
+			{R: 0x00, G: 0x79, B: 0x6b, A: 0xff},
+			{R: 0x00, G: 0x69, B: 0x5c, A: 0xff},
+			{R: 0x00, G: 0x4d, B: 0x40, A: 0xff},
+			{R: 0xa7, G: 0xff, B: 0xeb, A: 0xff},
+			{R: 0x64, G: 0xff, B: 0xda, A: 0xff},
+			{R: 0x1d, G: 0xe9, B: 0xb6, A: 0xff},
+			{R: 0x00, G: 0xbf, B: 0xa5, A: 0xff},
+		}},
+		{name: "green", colours: []rgba{
+			{R: 0xe8, G: 0xf5, B: 0xe9, A: 0xff},
+			{R: 0xc8, G: 0xe6, B: 0xc9, A: 0xff},
+			{R: 0xa5, G: 0xd6, B: 0xa7, A: 0xff},
+			{R: 0x81, G: 0xc7, B: 0x84, A: 0xff},
+			{R: 0x66, G: 0xbb, B: 0x6a, A: 0xff},
+			{R: 0x4c, G: 0xaf, B: 0x50, A: 0xff},
+			{R: 0x43, G: 0xa0, B: 0x47, A: 0xff},
+			{R: 0x38, G: 0x8e, B: 0x3c, A: 0xff},
+			{R: 0x2e, G: 0x7d, B: 0x32, A: 0xff},
+			{R: 0x1b, G: 0x5e, B: 0x20, A: 0xff},
+			{R: 0xb9, G: 0xf6, B: 0xca, A: 0xff},
+			{R: 0x69, G: 0xf0, B: 0xae, A: 0xff},
+			{R: 0x00, G: 0xe6, B: 0x76, A: 0xff},
+			{R: 0x00, G: 0xc8, B: 0x53, A: 0xff},
+		}},
+		{name: "light green", colours: []rgba{
+			{R: 0xf1, G: 0xf8, B: 0xe9, A: 0xff},
+			{R: 0xdc, G: 0xed, B: 0xc8, A: 0xff},
+			{R: 0xc5, G: 0xe1, B: 0xa5, A: 0xff},
+			{R: 0xae, G: 0xd5, B: 0x81, A: 0xff},
+			{R: 0x9c, G: 0xcc, B: 0x65, A: 0xff},
+			{R: 0x8b, G: 0xc3, B: 0x4a, A: 0xff},
+			{R: 0x7c, G: 0xb3, B: 0x42, A: 0xff},
+			{R: 0x68, G: 0x9f, B: 0x38, A: 0xff},
+			{R: 0x55, G: 0x8b, B: 0x2f, A: 0xff},
+			{R: 0x33, G: 0x69, B: 0x1e, A: 0xff},
+			{R: 0xcc, G: 0xff, B: 0x90, A: 0xff},
+			{R: 0xb2, G: 0xff, B: 0x59, A: 0xff},
+			{R: 0x76, G: 0xff, B: 0x03, A: 0xff},
+			{R: 0x64, G: 0xdd, B: 0x17, A: 0xff},
+		}},
+		{name: "lime", colours: []rgba{
+			{R: 0xf9, G: 0xfb, B: 0xe7, A: 0xff},
+			{R: 0xf0, G: 0xf4, B: 0xc3, A: 0xff},
+			{R: 0xe6, G: 0xee, B: 0x9c, A: 0xff},
+			{R: 0xdc, G: 0xe7, B: 0x75, A: 0xff},
+			{R: 0xd4, G: 0xe1, B: 0x57, A: 0xff},
+			{R: 0xcd, G: 0xdc, B: 0x39, A: 0xff},
+			{R: 0xc0, G: 0xca, B: 0x33, A: 0xff},
+			{R: 0xaf, G: 0xb4, B: 0x2b, A: 0xff},
+			{R: 0x9e, G: 0x9d, B: 0x24, A: 0xff},
+			{R: 0x82, G: 0x77, B: 0x17, A: 0xff},
+			{R: 0xf4, G: 0xff, B: 0x81, A: 0xff},
+			{R: 0xee, G: 0xff, B: 0x41, A: 0xff},
+			{R: 0xc6, G: 0xff, B: 0x00, A: 0xff},
+			{R: 0xae, G: 0xea, B: 0x00, A: 0xff},
+		}},
+		{name: "yellow", colours: []rgba{
+			{R: 0xff, G: 0xfd, B: 0xe7, A: 0xff},
+			{R: 0xff, G: 0xf9, B: 0xc4, A: 0xff},
+			{R: 0xff, G: 0xf5, B: 0x9d, A: 0xff},
+			{R: 0xff, G: 0xf1, B: 0x76, A: 0xff},
+			{R: 0xff, G: 0xee, B: 0x58, A: 0xff},
+			{R: 0xff, G: 0xeb, B: 0x3b, A: 0xff},
+			{R: 0xfd, G: 0xd8, B: 0x35, A: 0xff},
+			{R: 0xfb, G: 0xc0, B: 0x2d, A: 0xff},
+			{R: 0xf9, G: 0xa8, B: 0x25, A: 0xff},
+			{R: 0xf5, G: 0x7f, B: 0x17, A: 0xff},
+			{R: 0xff, G: 0xff, B: 0x8d, A: 0xff},
+			{R: 0xff, G: 0xff, B: 0x00, A: 0xff},
+			{R: 0xff, G: 0xea, B: 0x00, A: 0xff},
+			{R: 0xff, G: 0xd6, B: 0x00, A: 0xff},
+		}},
+		{name: "amber", colours: []rgba{
+			{R: 0xff, G: 0xf8, B: 0xe1, A: 0xff},
+			{R: 0xff, G: 0xec, B: 0xb3, A: 0xff},
+			{R: 0xff, G: 0xe0, B: 0x82, A: 0xff},
+			{R: 0xff, G: 0xd5, B: 0x4f, A: 0xff},
+			{R: 0xff, G: 0xca, B: 0x28, A: 0xff},
+			{R: 0xff, G: 0xc1, B: 0x07, A: 0xff},
+			{R: 0xff, G: 0xb3, B: 0x00, A: 0xff},
+			{R: 0xff, G: 0xa0, B: 0x00, A: 0xff},
+			{R: 0xff, G: 0x8f, B: 0x00, A: 0xff},
+			{R: 0xff, G: 0x6f, B: 0x00, A: 0xff},
+			{R: 0xff, G: 0xe5, B: 0x7f, A: 0xff},
+			{R: 0xff, G: 0xd7, B: 0x40, A: 0xff},
+			{R: 0xff, G: 0xc4, B: 0x00, A: 0xff},
+			{R: 0xff, G: 0xab, B: 0x00, A: 0xff},
+		}},
+		{name: "orange", colours: []rgba{
+			{R: 0xff, G: 0xf3, B: 0xe0, A: 0xff},
+			{R: 0xff, G: 0xe0, B: 0xb2, A: 0xff},
+			{R: 0xff, G: 0xcc, B: 0x80, A: 0xff},
+			{R: 0xff, G: 0xb7, B: 0x4d, A: 0xff},
+			{R: 0xff, G: 0xa7, B: 0x26, A: 0xff},
+			{R: 0xff, G: 0x98, B: 0x00, A: 0xff},
+			{R: 0xfb, G: 0x8c, B: 0x00, A: 0xff},
+			{R: 0xf5, G: 0x7c, B: 0x00, A: 0xff},
+			{R: 0xef, G: 0x6c, B: 0x00, A: 0xff},
+			{R: 0xe6, G: 0x51, B: 0x00, A: 0xff},
+			{R: 0xff, G: 0xd1, B: 0x80, A: 0xff},
+			{R: 0xff, G: 0xab, B: 0x40, A: 0xff},
+			{R: 0xff, G: 0x91, B: 0x00, A: 0xff},
+			{R: 0xff, G: 0x6d, B: 0x00, A: 0xff},
+		}},
+		{name: "deep orange", colours: []rgba{
+			{R: 0xfb, G: 0xe9, B: 0xe7, A: 0xff},
+			{R: 0xff, G: 0xcc, B: 0xbc, A: 0xff},
+			{R: 0xff, G: 0xab, B: 0x91, A: 0xff},
+			{R: 0xff, G: 0x8a, B: 0x65, A: 0xff},
+			{R: 0xff, G: 0x70, B: 0x43, A: 0xff},
+			{R: 0xff, G: 0x57, B: 0x22, A: 0xff},
+			{R: 0xf4, G: 0x51, B: 0x1e, A: 0xff},
+			{R: 0xe6, G: 0x4a, B: 0x19, A: 0xff},
+			{R: 0xd8, G: 0x43, B: 0x15, A: 0xff},
+			{R: 0xbf, G: 0x36, B: 0x0c, A: 0xff},
+			{R: 0xff, G: 0x9e, B: 0x80, A: 0xff},
+			{R: 0xff, G: 0x6e, B: 0x40, A: 0xff},
+			{R: 0xff, G: 0x3d, B: 0x00, A: 0xff},
+			{R: 0xdd, G: 0x2c, B: 0x00, A: 0xff},
+		}},
+		{name: "brown", colours: []rgba{
+			{R: 0xef, G: 0xeb, B: 0xe9, A: 0xff},
+			{R: 0xd7, G: 0xcc, B: 0xc8, A: 0xff},
+			{R: 0xbc, G: 0xaa, B: 0xa4, A: 0xff},
+			{R: 0xa1, G: 0x88, B: 0x7f, A: 0xff},
+			{R: 0x8d, G: 0x6e, B: 0x63, A: 0xff},
+			{R: 0x79, G: 0x55, B: 0x48, A: 0xff},
+			{R: 0x6d, G: 0x4c, B: 0x41, A: 0xff},
+			{R: 0x5d, G: 0x40, B: 0x37, A: 0xff},
+			{R: 0x4e, G: 0x34, B: 0x2e, A: 0xff},
+			{R: 0x3e, G: 0x27, B: 0x23, A: 0xff},
+		}},
+		{name: "grey", colours: []rgba{
+			{R: 0xfa, G: 0xfa, B: 0xfa, A: 0xff},
+			{R: 0xf5, G: 0xf5, B: 0xf5, A: 0xff},
+			{R: 0xee, G: 0xee, B: 0xee, A: 0xff},
+			{R: 0xe0, G: 0xe0, B: 0xe0, A: 0xff},
+			{R: 0xbd, G: 0xbd, B: 0xbd, A: 0xff},
+			{R: 0x9e, G: 0x9e, B: 0x9e, A: 0xff},
+			{R: 0x75, G: 0x75, B: 0x75, A: 0xff},
+			{R: 0x61, G: 0x61, B: 0x61, A: 0xff},
+			{R: 0x42, G: 0x42, B: 0x42, A: 0xff},
+			{R: 0x21, G: 0x21, B: 0x21, A: 0xff},
+		}},
+		{name: "blue grey", colours: []rgba{
+			{R: 0xec, G: 0xef, B: 0xf1, A: 0xff},
+			{R: 0xcf, G: 0xd8, B: 0xdc, A: 0xff},
+			{R: 0xb0, G: 0xbe, B: 0xc5, A: 0xff},
+			{R: 0x90, G: 0xa4, B: 0xae, A: 0xff},
+			{R: 0x78, G: 0x90, B: 0x9c, A: 0xff},
+			{R: 0x60, G: 0x7d, B: 0x8b, A: 0xff},
+			{R: 0x54, G: 0x6e, B: 0x7a, A: 0xff},
+			{R: 0x45, G: 0x5a, B: 0x64, A: 0xff},
+			{R: 0x37, G: 0x47, B: 0x4f, A: 0xff},
+			{R: 0x26, G: 0x32, B: 0x38, A: 0xff},
+		}},
+	},
+	others: colourNameToRGBA{
+		"black": {R: 0x00, G: 0x00, B: 0x00, A: 0xff},
+		"white": {R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+	},
+}
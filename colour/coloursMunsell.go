@@ -0,0 +1,62 @@
+package colour
+
+import (
+	"math"
+	"strings"
+)
+
+// These constants describe the grid of Munsell chips
+const (
+	munsellChipHueStep    = 2.5
+	munsellChipMinValue   = 1
+	munsellChipMaxValue   = 9
+	munsellChipChromaStep = 2
+	munsellChipMaxChroma  = 20
+)
+
+// munsellColours holds the Munsell chips
+var munsellColours = makeMunsellColours()
+
+// makeMunsellColours returns the colours of a regular grid of Munsell chips:
+// hues at steps of 2.5 in each hue family, values from 1 to 9 and even
+// chromas, together with the neutral greys. Only those chips that lie
+// within the sRGB gamut are given. The chips are named by their Munsell
+// notation in lower case, such as "5yr 4/6" or "n 5/". The colours are
+// calculated from the notation and so they share the approximations
+// described for the Munsell type.
+func makeMunsellColours() colourNameToRGBA {
+	m := colourNameToRGBA{}
+
+	add := func(mc Munsell) bool {
+		lin := xyzToLinearSRGB.apply(mc.LCh().Lab().XYZ().vec())
+		if !inSRGBGamut(lin) {
+			return false
+		}
+
+		m[strings.ToLower(mc.String())] = linearToRGBA(lin, math.MaxUint8)
+
+		return true
+	}
+
+	for v := munsellChipMinValue; v <= munsellChipMaxValue; v++ {
+		add(Munsell{HueName: MunsellNeutral, Value: float64(v)})
+	}
+
+	for pos := munsellChipHueStep; pos <= munsellHueCircle; pos +=
+		munsellChipHueStep {
+		for v := munsellChipMinValue; v <= munsellChipMaxValue; v++ {
+			for c := munsellChipChromaStep; c <= munsellChipMaxChroma; c +=
+				munsellChipChromaStep {
+				mc := munsellFromHuePosition(pos)
+				mc.Value = float64(v)
+				mc.Chroma = float64(c)
+
+				if !add(mc) {
+					break
+				}
+			}
+		}
+	}
+
+	return m
+}
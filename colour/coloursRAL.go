@@ -0,0 +1,444 @@
+package colour
+
+// colours from the RAL Classic collection (the RAL K7 colour fan). Each
+// colour is given by its four-digit code and by its English name. The sRGB
+// values are the hexadecimal screen values shown for each colour by RAL
+// gGmbH on their website, www.ral-farben.de, as of 2024; RAL give these as
+// approximations for on-screen use, the physical colour samples remain the
+// reference.
+//
+//nolint:mnd
+var ralClassicColours = withAliases(colourNameToRGBA{
+	"1000":                   {R: 0xcd, G: 0xba, B: 0x88, A: 0xff},
+	"green beige":            {R: 0xcd, G: 0xba, B: 0x88, A: 0xff},
+	"1001":                   {R: 0xd0, G: 0xb0, B: 0x84, A: 0xff},
+	"beige":                  {R: 0xd0, G: 0xb0, B: 0x84, A: 0xff},
+	"1002":                   {R: 0xd2, G: 0xaa, B: 0x6d, A: 0xff},
+	"sand yellow":            {R: 0xd2, G: 0xaa, B: 0x6d, A: 0xff},
+	"1003":                   {R: 0xf9, G: 0xa8, B: 0x00, A: 0xff},
+	"signal yellow":          {R: 0xf9, G: 0xa8, B: 0x00, A: 0xff},
+	"1004":                   {R: 0xe4, G: 0x9e, B: 0x00, A: 0xff},
+	"golden yellow":          {R: 0xe4, G: 0x9e, B: 0x00, A: 0xff},
+	"1005":                   {R: 0xcb, G: 0x8e, B: 0x00, A: 0xff},
+	"honey yellow":           {R: 0xcb, G: 0x8e, B: 0x00, A: 0xff},
+	"1006":                   {R: 0xe2, G: 0x90, B: 0x00, A: 0xff},
+	"maize yellow":           {R: 0xe2, G: 0x90, B: 0x00, A: 0xff},
+	"1007":                   {R: 0xe8, G: 0x8c, B: 0x00, A: 0xff},
+	"daffodil yellow":        {R: 0xe8, G: 0x8c, B: 0x00, A: 0xff},
+	"1011":                   {R: 0xaf, G: 0x80, B: 0x50, A: 0xff},
+	"brown beige":            {R: 0xaf, G: 0x80, B: 0x50, A: 0xff},
+	"1012":                   {R: 0xdd, G: 0xaf, B: 0x27, A: 0xff},
+	"lemon yellow":           {R: 0xdd, G: 0xaf, B: 0x27, A: 0xff},
+	"1013":                   {R: 0xe3, G: 0xd9, B: 0xc6, A: 0xff},
+	"oyster white":           {R: 0xe3, G: 0xd9, B: 0xc6, A: 0xff},
+	"1014":                   {R: 0xdd, G: 0xc4, B: 0x9a, A: 0xff},
+	"ivory":                  {R: 0xdd, G: 0xc4, B: 0x9a, A: 0xff},
+	"1015":                   {R: 0xe6, G: 0xd2, B: 0xb5, A: 0xff},
+	"light ivory":            {R: 0xe6, G: 0xd2, B: 0xb5, A: 0xff},
+	"1016":                   {R: 0xf1, G: 0xdd, B: 0x38, A: 0xff},
+	"sulfur yellow":          {R: 0xf1, G: 0xdd, B: 0x38, A: 0xff},
+	"1017":                   {R: 0xf6, G: 0xa9, B: 0x50, A: 0xff},
+	"saffron yellow":         {R: 0xf6, G: 0xa9, B: 0x50, A: 0xff},
+	"1018":                   {R: 0xfa, G: 0xca, B: 0x30, A: 0xff},
+	"zinc yellow":            {R: 0xfa, G: 0xca, B: 0x30, A: 0xff},
+	"1019":                   {R: 0xa4, G: 0x8f, B: 0x7a, A: 0xff},
+	"grey beige":             {R: 0xa4, G: 0x8f, B: 0x7a, A: 0xff},
+	"1020":                   {R: 0xa0, G: 0x8f, B: 0x65, A: 0xff},
+	"olive yellow":           {R: 0xa0, G: 0x8f, B: 0x65, A: 0xff},
+	"1021":                   {R: 0xf6, G: 0xb6, B: 0x00, A: 0xff},
+	"colza yellow":           {R: 0xf6, G: 0xb6, B: 0x00, A: 0xff},
+	"1023":                   {R: 0xf7, G: 0xb5, B: 0x00, A: 0xff},
+	"traffic yellow":         {R: 0xf7, G: 0xb5, B: 0x00, A: 0xff},
+	"1024":                   {R: 0xba, G: 0x8f, B: 0x4c, A: 0xff},
+	"ochre yellow":           {R: 0xba, G: 0x8f, B: 0x4c, A: 0xff},
+	"1026":                   {R: 0xff, G: 0xff, B: 0x00, A: 0xff},
+	"luminous yellow":        {R: 0xff, G: 0xff, B: 0x00, A: 0xff},
+	"1027":                   {R: 0xa7, G: 0x7f, B: 0x0e, A: 0xff},
+	"curry":                  {R: 0xa7, G: 0x7f, B: 0x0e, A: 0xff},
+	"1028":                   {R: 0xff, G: 0x9b, B: 0x00, A: 0xff},
+	"melon yellow":           {R: 0xff, G: 0x9b, B: 0x00, A: 0xff},
+	"1032":                   {R: 0xe2, G: 0xa3, B: 0x00, A: 0xff},
+	"broom yellow":           {R: 0xe2, G: 0xa3, B: 0x00, A: 0xff},
+	"1033":                   {R: 0xf9, G: 0x9a, B: 0x1c, A: 0xff},
+	"dahlia yellow":          {R: 0xf9, G: 0x9a, B: 0x1c, A: 0xff},
+	"1034":                   {R: 0xeb, G: 0x9c, B: 0x52, A: 0xff},
+	"pastel yellow":          {R: 0xeb, G: 0x9c, B: 0x52, A: 0xff},
+	"1035":                   {R: 0x90, G: 0x83, B: 0x70, A: 0xff},
+	"pearl beige":            {R: 0x90, G: 0x83, B: 0x70, A: 0xff},
+	"1036":                   {R: 0x80, G: 0x64, B: 0x3f, A: 0xff},
+	"pearl gold":             {R: 0x80, G: 0x64, B: 0x3f, A: 0xff},
+	"1037":                   {R: 0xf0, G: 0x92, B: 0x00, A: 0xff},
+	"sun yellow":             {R: 0xf0, G: 0x92, B: 0x00, A: 0xff},
+	"2000":                   {R: 0xda, G: 0x6e, B: 0x00, A: 0xff},
+	"yellow orange":          {R: 0xda, G: 0x6e, B: 0x00, A: 0xff},
+	"2001":                   {R: 0xba, G: 0x48, B: 0x1b, A: 0xff},
+	"red orange":             {R: 0xba, G: 0x48, B: 0x1b, A: 0xff},
+	"2002":                   {R: 0xbf, G: 0x39, B: 0x22, A: 0xff},
+	"vermilion":              {R: 0xbf, G: 0x39, B: 0x22, A: 0xff},
+	"2003":                   {R: 0xf6, G: 0x78, B: 0x28, A: 0xff},
+	"pastel orange":          {R: 0xf6, G: 0x78, B: 0x28, A: 0xff},
+	"2004":                   {R: 0xe2, G: 0x53, B: 0x03, A: 0xff},
+	"pure orange":            {R: 0xe2, G: 0x53, B: 0x03, A: 0xff},
+	"2005":                   {R: 0xff, G: 0x4d, B: 0x06, A: 0xff},
+	"luminous orange":        {R: 0xff, G: 0x4d, B: 0x06, A: 0xff},
+	"2007":                   {R: 0xff, G: 0xb2, B: 0x00, A: 0xff},
+	"luminous bright orange": {R: 0xff, G: 0xb2, B: 0x00, A: 0xff},
+	"2008":                   {R: 0xed, G: 0x6b, B: 0x21, A: 0xff},
+	"bright red orange":      {R: 0xed, G: 0x6b, B: 0x21, A: 0xff},
+	"2009":                   {R: 0xde, G: 0x53, B: 0x07, A: 0xff},
+	"traffic orange":         {R: 0xde, G: 0x53, B: 0x07, A: 0xff},
+	"2010":                   {R: 0xd0, G: 0x5d, B: 0x28, A: 0xff},
+	"signal orange":          {R: 0xd0, G: 0x5d, B: 0x28, A: 0xff},
+	"2011":                   {R: 0xe2, G: 0x6e, B: 0x0e, A: 0xff},
+	"deep orange":            {R: 0xe2, G: 0x6e, B: 0x0e, A: 0xff},
+	"2012":                   {R: 0xd5, G: 0x65, B: 0x4d, A: 0xff},
+	"salmon orange":          {R: 0xd5, G: 0x65, B: 0x4d, A: 0xff},
+	"2013":                   {R: 0x92, G: 0x3e, B: 0x25, A: 0xff},
+	"pearl orange":           {R: 0x92, G: 0x3e, B: 0x25, A: 0xff},
+	"2017":                   {R: 0xfc, G: 0x55, B: 0x00, A: 0xff},
+	"ral orange":             {R: 0xfc, G: 0x55, B: 0x00, A: 0xff},
+	"3000":                   {R: 0xa7, G: 0x29, B: 0x20, A: 0xff},
+	"flame red":              {R: 0xa7, G: 0x29, B: 0x20, A: 0xff},
+	"3001":                   {R: 0x9b, G: 0x24, B: 0x23, A: 0xff},
+	"signal red":             {R: 0x9b, G: 0x24, B: 0x23, A: 0xff},
+	"3002":                   {R: 0x9b, G: 0x23, B: 0x21, A: 0xff},
+	"carmine red":            {R: 0x9b, G: 0x23, B: 0x21, A: 0xff},
+	"3003":                   {R: 0x86, G: 0x1a, B: 0x22, A: 0xff},
+	"ruby red":               {R: 0x86, G: 0x1a, B: 0x22, A: 0xff},
+	"3004":                   {R: 0x6b, G: 0x1c, B: 0x23, A: 0xff},
+	"purple red":             {R: 0x6b, G: 0x1c, B: 0x23, A: 0xff},
+	"3005":                   {R: 0x59, G: 0x19, B: 0x1f, A: 0xff},
+	"wine red":               {R: 0x59, G: 0x19, B: 0x1f, A: 0xff},
+	"3007":                   {R: 0x3e, G: 0x20, B: 0x22, A: 0xff},
+	"black red":              {R: 0x3e, G: 0x20, B: 0x22, A: 0xff},
+	"3009":                   {R: 0x6d, G: 0x34, B: 0x2d, A: 0xff},
+	"oxide red":              {R: 0x6d, G: 0x34, B: 0x2d, A: 0xff},
+	"3011":                   {R: 0x79, G: 0x24, B: 0x23, A: 0xff},
+	"brown red":              {R: 0x79, G: 0x24, B: 0x23, A: 0xff},
+	"3012":                   {R: 0xc6, G: 0x84, B: 0x6d, A: 0xff},
+	"beige red":              {R: 0xc6, G: 0x84, B: 0x6d, A: 0xff},
+	"3013":                   {R: 0x97, G: 0x2e, B: 0x25, A: 0xff},
+	"tomato red":             {R: 0x97, G: 0x2e, B: 0x25, A: 0xff},
+	"3014":                   {R: 0xcb, G: 0x73, B: 0x75, A: 0xff},
+	"antique pink":           {R: 0xcb, G: 0x73, B: 0x75, A: 0xff},
+	"3015":                   {R: 0xd8, G: 0xa0, B: 0xa6, A: 0xff},
+	"light pink":             {R: 0xd8, G: 0xa0, B: 0xa6, A: 0xff},
+	"3016":                   {R: 0xa6, G: 0x3d, B: 0x2f, A: 0xff},
+	"coral red":              {R: 0xa6, G: 0x3d, B: 0x2f, A: 0xff},
+	"3017":                   {R: 0xcb, G: 0x55, B: 0x5d, A: 0xff},
+	"rose":                   {R: 0xcb, G: 0x55, B: 0x5d, A: 0xff},
+	"3018":                   {R: 0xc7, G: 0x3f, B: 0x4a, A: 0xff},
+	"strawberry red":         {R: 0xc7, G: 0x3f, B: 0x4a, A: 0xff},
+	"3020":                   {R: 0xbb, G: 0x1e, B: 0x10, A: 0xff},
+	"traffic red":            {R: 0xbb, G: 0x1e, B: 0x10, A: 0xff},
+	"3022":                   {R: 0xcf, G: 0x69, B: 0x55, A: 0xff},
+	"salmon pink":            {R: 0xcf, G: 0x69, B: 0x55, A: 0xff},
+	"3024":                   {R: 0xff, G: 0x2d, B: 0x21, A: 0xff},
+	"luminous red":           {R: 0xff, G: 0x2d, B: 0x21, A: 0xff},
+	"3026":                   {R: 0xff, G: 0x2a, B: 0x1b, A: 0xff},
+	"luminous bright red":    {R: 0xff, G: 0x2a, B: 0x1b, A: 0xff},
+	"3027":                   {R: 0xab, G: 0x27, B: 0x3c, A: 0xff},
+	"raspberry red":          {R: 0xab, G: 0x27, B: 0x3c, A: 0xff},
+	"3028":                   {R: 0xcc, G: 0x2c, B: 0x24, A: 0xff},
+	"pure red":               {R: 0xcc, G: 0x2c, B: 0x24, A: 0xff},
+	"3031":                   {R: 0xa6, G: 0x34, B: 0x37, A: 0xff},
+	"orient red":             {R: 0xa6, G: 0x34, B: 0x37, A: 0xff},
+	"3032":                   {R: 0x70, G: 0x1d, B: 0x23, A: 0xff},
+	"pearl ruby red":         {R: 0x70, G: 0x1d, B: 0x23, A: 0xff},
+	"3033":                   {R: 0xa5, G: 0x3a, B: 0x2d, A: 0xff},
+	"pearl pink":             {R: 0xa5, G: 0x3a, B: 0x2d, A: 0xff},
+	"4001":                   {R: 0x81, G: 0x61, B: 0x83, A: 0xff},
+	"red lilac":              {R: 0x81, G: 0x61, B: 0x83, A: 0xff},
+	"4002":                   {R: 0x8d, G: 0x3c, B: 0x4b, A: 0xff},
+	"red violet":             {R: 0x8d, G: 0x3c, B: 0x4b, A: 0xff},
+	"4003":                   {R: 0xc4, G: 0x61, B: 0x8c, A: 0xff},
+	"heather violet":         {R: 0xc4, G: 0x61, B: 0x8c, A: 0xff},
+	"4004":                   {R: 0x65, G: 0x1e, B: 0x38, A: 0xff},
+	"claret violet":          {R: 0x65, G: 0x1e, B: 0x38, A: 0xff},
+	"4005":                   {R: 0x76, G: 0x68, B: 0x9a, A: 0xff},
+	"blue lilac":             {R: 0x76, G: 0x68, B: 0x9a, A: 0xff},
+	"4006":                   {R: 0x90, G: 0x33, B: 0x73, A: 0xff},
+	"traffic purple":         {R: 0x90, G: 0x33, B: 0x73, A: 0xff},
+	"4007":                   {R: 0x47, G: 0x24, B: 0x3c, A: 0xff},
+	"purple violet":          {R: 0x47, G: 0x24, B: 0x3c, A: 0xff},
+	"4008":                   {R: 0x84, G: 0x4c, B: 0x82, A: 0xff},
+	"signal violet":          {R: 0x84, G: 0x4c, B: 0x82, A: 0xff},
+	"4009":                   {R: 0x9d, G: 0x86, B: 0x92, A: 0xff},
+	"pastel violet":          {R: 0x9d, G: 0x86, B: 0x92, A: 0xff},
+	"4010":                   {R: 0xbc, G: 0x40, B: 0x77, A: 0xff},
+	"telemagenta":            {R: 0xbc, G: 0x40, B: 0x77, A: 0xff},
+	"4011":                   {R: 0x6e, G: 0x63, B: 0x87, A: 0xff},
+	"pearl violet":           {R: 0x6e, G: 0x63, B: 0x87, A: 0xff},
+	"4012":                   {R: 0x6b, G: 0x6b, B: 0x7f, A: 0xff},
+	"pearl blackberry":       {R: 0x6b, G: 0x6b, B: 0x7f, A: 0xff},
+	"5000":                   {R: 0x31, G: 0x4f, B: 0x6f, A: 0xff},
+	"violet blue":            {R: 0x31, G: 0x4f, B: 0x6f, A: 0xff},
+	"5001":                   {R: 0x0f, G: 0x4c, B: 0x64, A: 0xff},
+	"green blue":             {R: 0x0f, G: 0x4c, B: 0x64, A: 0xff},
+	"5002":                   {R: 0x00, G: 0x38, B: 0x7b, A: 0xff},
+	"ultramarine blue":       {R: 0x00, G: 0x38, B: 0x7b, A: 0xff},
+	"5003":                   {R: 0x1f, G: 0x38, B: 0x55, A: 0xff},
+	"sapphire blue":          {R: 0x1f, G: 0x38, B: 0x55, A: 0xff},
+	"5004":                   {R: 0x19, G: 0x1e, B: 0x28, A: 0xff},
+	"black blue":             {R: 0x19, G: 0x1e, B: 0x28, A: 0xff},
+	"5005":                   {R: 0x00, G: 0x53, B: 0x87, A: 0xff},
+	"signal blue":            {R: 0x00, G: 0x53, B: 0x87, A: 0xff},
+	"5007":                   {R: 0x37, G: 0x6b, B: 0x8c, A: 0xff},
+	"brilliant blue":         {R: 0x37, G: 0x6b, B: 0x8c, A: 0xff},
+	"5008":                   {R: 0x2b, G: 0x3a, B: 0x44, A: 0xff},
+	"grey blue":              {R: 0x2b, G: 0x3a, B: 0x44, A: 0xff},
+	"5009":                   {R: 0x22, G: 0x5f, B: 0x78, A: 0xff},
+	"azure blue":             {R: 0x22, G: 0x5f, B: 0x78, A: 0xff},
+	"5010":                   {R: 0x00, G: 0x4f, B: 0x7c, A: 0xff},
+	"gentian blue":           {R: 0x00, G: 0x4f, B: 0x7c, A: 0xff},
+	"5011":                   {R: 0x1a, G: 0x2b, B: 0x3c, A: 0xff},
+	"steel blue":             {R: 0x1a, G: 0x2b, B: 0x3c, A: 0xff},
+	"5012":                   {R: 0x00, G: 0x89, B: 0xb6, A: 0xff},
+	"light blue":             {R: 0x00, G: 0x89, B: 0xb6, A: 0xff},
+	"5013":                   {R: 0x19, G: 0x31, B: 0x53, A: 0xff},
+	"cobalt blue":            {R: 0x19, G: 0x31, B: 0x53, A: 0xff},
+	"5014":                   {R: 0x63, G: 0x7d, B: 0x96, A: 0xff},
+	"pigeon blue":            {R: 0x63, G: 0x7d, B: 0x96, A: 0xff},
+	"5015":                   {R: 0x00, G: 0x7c, B: 0xb0, A: 0xff},
+	"sky blue":               {R: 0x00, G: 0x7c, B: 0xb0, A: 0xff},
+	"5017":                   {R: 0x00, G: 0x5b, B: 0x8c, A: 0xff},
+	"traffic blue":           {R: 0x00, G: 0x5b, B: 0x8c, A: 0xff},
+	"5018":                   {R: 0x05, G: 0x8b, B: 0x8c, A: 0xff},
+	"turquoise blue":         {R: 0x05, G: 0x8b, B: 0x8c, A: 0xff},
+	"5019":                   {R: 0x00, G: 0x5e, B: 0x83, A: 0xff},
+	"capri blue":             {R: 0x00, G: 0x5e, B: 0x83, A: 0xff},
+	"5020":                   {R: 0x00, G: 0x41, B: 0x4b, A: 0xff},
+	"ocean blue":             {R: 0x00, G: 0x41, B: 0x4b, A: 0xff},
+	"5021":                   {R: 0x00, G: 0x75, B: 0x77, A: 0xff},
+	"water blue":             {R: 0x00, G: 0x75, B: 0x77, A: 0xff},
+	"5022":                   {R: 0x22, G: 0x2d, B: 0x5a, A: 0xff},
+	"night blue":             {R: 0x22, G: 0x2d, B: 0x5a, A: 0xff},
+	"5023":                   {R: 0x42, G: 0x69, B: 0x8c, A: 0xff},
+	"distant blue":           {R: 0x42, G: 0x69, B: 0x8c, A: 0xff},
+	"5024":                   {R: 0x60, G: 0x93, B: 0xac, A: 0xff},
+	"pastel blue":            {R: 0x60, G: 0x93, B: 0xac, A: 0xff},
+	"5025":                   {R: 0x21, G: 0x69, B: 0x7c, A: 0xff},
+	"pearl gentian blue":     {R: 0x21, G: 0x69, B: 0x7c, A: 0xff},
+	"5026":                   {R: 0x0f, G: 0x30, B: 0x52, A: 0xff},
+	"pearl night blue":       {R: 0x0f, G: 0x30, B: 0x52, A: 0xff},
+	"6000":                   {R: 0x3c, G: 0x74, B: 0x60, A: 0xff},
+	"patina green":           {R: 0x3c, G: 0x74, B: 0x60, A: 0xff},
+	"6001":                   {R: 0x36, G: 0x67, B: 0x35, A: 0xff},
+	"emerald green":          {R: 0x36, G: 0x67, B: 0x35, A: 0xff},
+	"6002":                   {R: 0x32, G: 0x59, B: 0x28, A: 0xff},
+	"leaf green":             {R: 0x32, G: 0x59, B: 0x28, A: 0xff},
+	"6003":                   {R: 0x50, G: 0x53, B: 0x3c, A: 0xff},
+	"olive green":            {R: 0x50, G: 0x53, B: 0x3c, A: 0xff},
+	"6004":                   {R: 0x02, G: 0x44, B: 0x42, A: 0xff},
+	"blue green":             {R: 0x02, G: 0x44, B: 0x42, A: 0xff},
+	"6005":                   {R: 0x11, G: 0x42, B: 0x32, A: 0xff},
+	"moss green":             {R: 0x11, G: 0x42, B: 0x32, A: 0xff},
+	"6006":                   {R: 0x3c, G: 0x39, B: 0x2e, A: 0xff},
+	"grey olive":             {R: 0x3c, G: 0x39, B: 0x2e, A: 0xff},
+	"6007":                   {R: 0x2c, G: 0x32, B: 0x22, A: 0xff},
+	"bottle green":           {R: 0x2c, G: 0x32, B: 0x22, A: 0xff},
+	"6008":                   {R: 0x37, G: 0x34, B: 0x2a, A: 0xff},
+	"brown green":            {R: 0x37, G: 0x34, B: 0x2a, A: 0xff},
+	"6009":                   {R: 0x27, G: 0x35, B: 0x2a, A: 0xff},
+	"fir green":              {R: 0x27, G: 0x35, B: 0x2a, A: 0xff},
+	"6010":                   {R: 0x4d, G: 0x6f, B: 0x39, A: 0xff},
+	"grass green":            {R: 0x4d, G: 0x6f, B: 0x39, A: 0xff},
+	"6011":                   {R: 0x6c, G: 0x7c, B: 0x59, A: 0xff},
+	"reseda green":           {R: 0x6c, G: 0x7c, B: 0x59, A: 0xff},
+	"6012":                   {R: 0x30, G: 0x3d, B: 0x3a, A: 0xff},
+	"black green":            {R: 0x30, G: 0x3d, B: 0x3a, A: 0xff},
+	"6013":                   {R: 0x7d, G: 0x76, B: 0x5a, A: 0xff},
+	"reed green":             {R: 0x7d, G: 0x76, B: 0x5a, A: 0xff},
+	"6014":                   {R: 0x47, G: 0x41, B: 0x35, A: 0xff},
+	"yellow olive":           {R: 0x47, G: 0x41, B: 0x35, A: 0xff},
+	"6015":                   {R: 0x3d, G: 0x3d, B: 0x36, A: 0xff},
+	"black olive":            {R: 0x3d, G: 0x3d, B: 0x36, A: 0xff},
+	"6016":                   {R: 0x00, G: 0x69, B: 0x4c, A: 0xff},
+	"turquoise green":        {R: 0x00, G: 0x69, B: 0x4c, A: 0xff},
+	"6017":                   {R: 0x58, G: 0x7f, B: 0x40, A: 0xff},
+	"may green":              {R: 0x58, G: 0x7f, B: 0x40, A: 0xff},
+	"6018":                   {R: 0x61, G: 0x99, B: 0x3b, A: 0xff},
+	"yellow green":           {R: 0x61, G: 0x99, B: 0x3b, A: 0xff},
+	"6019":                   {R: 0xb9, G: 0xce, B: 0xac, A: 0xff},
+	"pastel green":           {R: 0xb9, G: 0xce, B: 0xac, A: 0xff},
+	"6020":                   {R: 0x37, G: 0x42, B: 0x2f, A: 0xff},
+	"chrome green":           {R: 0x37, G: 0x42, B: 0x2f, A: 0xff},
+	"6021":                   {R: 0x8a, G: 0x99, B: 0x77, A: 0xff},
+	"pale green":             {R: 0x8a, G: 0x99, B: 0x77, A: 0xff},
+	"6022":                   {R: 0x3a, G: 0x33, B: 0x27, A: 0xff},
+	"olive drab":             {R: 0x3a, G: 0x33, B: 0x27, A: 0xff},
+	"6024":                   {R: 0x00, G: 0x83, B: 0x51, A: 0xff},
+	"traffic green":          {R: 0x00, G: 0x83, B: 0x51, A: 0xff},
+	"6025":                   {R: 0x5e, G: 0x6e, B: 0x3b, A: 0xff},
+	"fern green":             {R: 0x5e, G: 0x6e, B: 0x3b, A: 0xff},
+	"6026":                   {R: 0x00, G: 0x5f, B: 0x4e, A: 0xff},
+	"opal green":             {R: 0x00, G: 0x5f, B: 0x4e, A: 0xff},
+	"6027":                   {R: 0x7e, G: 0xba, B: 0xb5, A: 0xff},
+	"light green":            {R: 0x7e, G: 0xba, B: 0xb5, A: 0xff},
+	"6028":                   {R: 0x31, G: 0x54, B: 0x42, A: 0xff},
+	"pine green":             {R: 0x31, G: 0x54, B: 0x42, A: 0xff},
+	"6029":                   {R: 0x00, G: 0x6f, B: 0x3d, A: 0xff},
+	"mint green":             {R: 0x00, G: 0x6f, B: 0x3d, A: 0xff},
+	"6032":                   {R: 0x23, G: 0x7f, B: 0x52, A: 0xff},
+	"signal green":           {R: 0x23, G: 0x7f, B: 0x52, A: 0xff},
+	"6033":                   {R: 0x46, G: 0x87, B: 0x7f, A: 0xff},
+	"mint turquoise":         {R: 0x46, G: 0x87, B: 0x7f, A: 0xff},
+	"6034":                   {R: 0x7a, G: 0xac, B: 0xac, A: 0xff},
+	"pastel turquoise":       {R: 0x7a, G: 0xac, B: 0xac, A: 0xff},
+	"6035":                   {R: 0x19, G: 0x4d, B: 0x25, A: 0xff},
+	"pearl dark green":       {R: 0x19, G: 0x4d, B: 0x25, A: 0xff},
+	"6036":                   {R: 0x04, G: 0x57, B: 0x4b, A: 0xff},
+	"pearl opal green":       {R: 0x04, G: 0x57, B: 0x4b, A: 0xff},
+	"6037":                   {R: 0x00, G: 0x8b, B: 0x29, A: 0xff},
+	"pure green":             {R: 0x00, G: 0x8b, B: 0x29, A: 0xff},
+	"6038":                   {R: 0x00, G: 0xb5, B: 0x1a, A: 0xff},
+	"luminous green":         {R: 0x00, G: 0xb5, B: 0x1a, A: 0xff},
+	"6039":                   {R: 0xb3, G: 0xc4, B: 0x3e, A: 0xff},
+	"fibrous green":          {R: 0xb3, G: 0xc4, B: 0x3e, A: 0xff},
+	"7000":                   {R: 0x7a, G: 0x88, B: 0x8e, A: 0xff},
+	"squirrel grey":          {R: 0x7a, G: 0x88, B: 0x8e, A: 0xff},
+	"7001":                   {R: 0x8c, G: 0x96, B: 0x9d, A: 0xff},
+	"silver grey":            {R: 0x8c, G: 0x96, B: 0x9d, A: 0xff},
+	"7002":                   {R: 0x81, G: 0x78, B: 0x63, A: 0xff},
+	"olive grey":             {R: 0x81, G: 0x78, B: 0x63, A: 0xff},
+	"7003":                   {R: 0x7a, G: 0x76, B: 0x69, A: 0xff},
+	"moss grey":              {R: 0x7a, G: 0x76, B: 0x69, A: 0xff},
+	"7004":                   {R: 0x9b, G: 0x9b, B: 0x9b, A: 0xff},
+	"signal grey":            {R: 0x9b, G: 0x9b, B: 0x9b, A: 0xff},
+	"7005":                   {R: 0x6c, G: 0x6e, B: 0x6b, A: 0xff},
+	"mouse grey":             {R: 0x6c, G: 0x6e, B: 0x6b, A: 0xff},
+	"7006":                   {R: 0x76, G: 0x6a, B: 0x5e, A: 0xff},
+	"beige grey":             {R: 0x76, G: 0x6a, B: 0x5e, A: 0xff},
+	"7008":                   {R: 0x74, G: 0x5e, B: 0x3d, A: 0xff},
+	"khaki grey":             {R: 0x74, G: 0x5e, B: 0x3d, A: 0xff},
+	"7009":                   {R: 0x5d, G: 0x60, B: 0x58, A: 0xff},
+	"green grey":             {R: 0x5d, G: 0x60, B: 0x58, A: 0xff},
+	"7010":                   {R: 0x58, G: 0x5c, B: 0x56, A: 0xff},
+	"tarpaulin grey":         {R: 0x58, G: 0x5c, B: 0x56, A: 0xff},
+	"7011":                   {R: 0x52, G: 0x59, B: 0x5d, A: 0xff},
+	"iron grey":              {R: 0x52, G: 0x59, B: 0x5d, A: 0xff},
+	"7012":                   {R: 0x57, G: 0x5d, B: 0x5e, A: 0xff},
+	"basalt grey":            {R: 0x57, G: 0x5d, B: 0x5e, A: 0xff},
+	"7013":                   {R: 0x57, G: 0x50, B: 0x44, A: 0xff},
+	"brown grey":             {R: 0x57, G: 0x50, B: 0x44, A: 0xff},
+	"7015":                   {R: 0x4f, G: 0x53, B: 0x58, A: 0xff},
+	"slate grey":             {R: 0x4f, G: 0x53, B: 0x58, A: 0xff},
+	"7016":                   {R: 0x38, G: 0x3e, B: 0x42, A: 0xff},
+	"anthracite grey":        {R: 0x38, G: 0x3e, B: 0x42, A: 0xff},
+	"7021":                   {R: 0x2f, G: 0x32, B: 0x34, A: 0xff},
+	"black grey":             {R: 0x2f, G: 0x32, B: 0x34, A: 0xff},
+	"7022":                   {R: 0x4c, G: 0x4a, B: 0x44, A: 0xff},
+	"umbra grey":             {R: 0x4c, G: 0x4a, B: 0x44, A: 0xff},
+	"7023":                   {R: 0x80, G: 0x80, B: 0x76, A: 0xff},
+	"concrete grey":          {R: 0x80, G: 0x80, B: 0x76, A: 0xff},
+	"7024":                   {R: 0x45, G: 0x49, B: 0x4e, A: 0xff},
+	"graphite grey":          {R: 0x45, G: 0x49, B: 0x4e, A: 0xff},
+	"7026":                   {R: 0x37, G: 0x43, B: 0x45, A: 0xff},
+	"granite grey":           {R: 0x37, G: 0x43, B: 0x45, A: 0xff},
+	"7030":                   {R: 0x92, G: 0x8e, B: 0x85, A: 0xff},
+	"stone grey":             {R: 0x92, G: 0x8e, B: 0x85, A: 0xff},
+	"7031":                   {R: 0x5b, G: 0x68, B: 0x6d, A: 0xff},
+	"blue grey":              {R: 0x5b, G: 0x68, B: 0x6d, A: 0xff},
+	"7032":                   {R: 0xb5, G: 0xb0, B: 0xa1, A: 0xff},
+	"pebble grey":            {R: 0xb5, G: 0xb0, B: 0xa1, A: 0xff},
+	"7033":                   {R: 0x7f, G: 0x82, B: 0x74, A: 0xff},
+	"cement grey":            {R: 0x7f, G: 0x82, B: 0x74, A: 0xff},
+	"7034":                   {R: 0x92, G: 0x88, B: 0x6f, A: 0xff},
+	"yellow grey":            {R: 0x92, G: 0x88, B: 0x6f, A: 0xff},
+	"7035":                   {R: 0xc5, G: 0xc7, B: 0xc4, A: 0xff},
+	"light grey":             {R: 0xc5, G: 0xc7, B: 0xc4, A: 0xff},
+	"7036":                   {R: 0x97, G: 0x93, B: 0x92, A: 0xff},
+	"platinum grey":          {R: 0x97, G: 0x93, B: 0x92, A: 0xff},
+	"7037":                   {R: 0x7a, G: 0x7b, B: 0x7a, A: 0xff},
+	"dusty grey":             {R: 0x7a, G: 0x7b, B: 0x7a, A: 0xff},
+	"7038":                   {R: 0xb0, G: 0xb0, B: 0xa9, A: 0xff},
+	"agate grey":             {R: 0xb0, G: 0xb0, B: 0xa9, A: 0xff},
+	"7039":                   {R: 0x6b, G: 0x66, B: 0x5e, A: 0xff},
+	"quartz grey":            {R: 0x6b, G: 0x66, B: 0x5e, A: 0xff},
+	"7040":                   {R: 0x98, G: 0x9e, B: 0xa1, A: 0xff},
+	"window grey":            {R: 0x98, G: 0x9e, B: 0xa1, A: 0xff},
+	"7042":                   {R: 0x8e, G: 0x92, B: 0x91, A: 0xff},
+	"traffic grey a":         {R: 0x8e, G: 0x92, B: 0x91, A: 0xff},
+	"7043":                   {R: 0x4f, G: 0x52, B: 0x50, A: 0xff},
+	"traffic grey b":         {R: 0x4f, G: 0x52, B: 0x50, A: 0xff},
+	"7044":                   {R: 0xb7, G: 0xb3, B: 0xa8, A: 0xff},
+	"silk grey":              {R: 0xb7, G: 0xb3, B: 0xa8, A: 0xff},
+	"7045":                   {R: 0x8d, G: 0x92, B: 0x95, A: 0xff},
+	"telegrey 1":             {R: 0x8d, G: 0x92, B: 0x95, A: 0xff},
+	"7046":                   {R: 0x7f, G: 0x86, B: 0x8a, A: 0xff},
+	"telegrey 2":             {R: 0x7f, G: 0x86, B: 0x8a, A: 0xff},
+	"7047":                   {R: 0xc8, G: 0xc8, B: 0xc7, A: 0xff},
+	"telegrey 4":             {R: 0xc8, G: 0xc8, B: 0xc7, A: 0xff},
+	"7048":                   {R: 0x81, G: 0x7b, B: 0x73, A: 0xff},
+	"pearl mouse grey":       {R: 0x81, G: 0x7b, B: 0x73, A: 0xff},
+	"8000":                   {R: 0x89, G: 0x69, B: 0x3e, A: 0xff},
+	"green brown":            {R: 0x89, G: 0x69, B: 0x3e, A: 0xff},
+	"8001":                   {R: 0x9d, G: 0x62, B: 0x2b, A: 0xff},
+	"ochre brown":            {R: 0x9d, G: 0x62, B: 0x2b, A: 0xff},
+	"8002":                   {R: 0x79, G: 0x4d, B: 0x3e, A: 0xff},
+	"signal brown":           {R: 0x79, G: 0x4d, B: 0x3e, A: 0xff},
+	"8003":                   {R: 0x7e, G: 0x4b, B: 0x26, A: 0xff},
+	"clay brown":             {R: 0x7e, G: 0x4b, B: 0x26, A: 0xff},
+	"8004":                   {R: 0x8d, G: 0x49, B: 0x31, A: 0xff},
+	"copper brown":           {R: 0x8d, G: 0x49, B: 0x31, A: 0xff},
+	"8007":                   {R: 0x70, G: 0x45, B: 0x2a, A: 0xff},
+	"fawn brown":             {R: 0x70, G: 0x45, B: 0x2a, A: 0xff},
+	"8008":                   {R: 0x72, G: 0x4a, B: 0x25, A: 0xff},
+	"olive brown":            {R: 0x72, G: 0x4a, B: 0x25, A: 0xff},
+	"8011":                   {R: 0x5a, G: 0x38, B: 0x26, A: 0xff},
+	"nut brown":              {R: 0x5a, G: 0x38, B: 0x26, A: 0xff},
+	"8012":                   {R: 0x66, G: 0x33, B: 0x2b, A: 0xff},
+	"red brown":              {R: 0x66, G: 0x33, B: 0x2b, A: 0xff},
+	"8014":                   {R: 0x4a, G: 0x35, B: 0x26, A: 0xff},
+	"sepia brown":            {R: 0x4a, G: 0x35, B: 0x26, A: 0xff},
+	"8015":                   {R: 0x5e, G: 0x2f, B: 0x26, A: 0xff},
+	"chestnut brown":         {R: 0x5e, G: 0x2f, B: 0x26, A: 0xff},
+	"8016":                   {R: 0x4c, G: 0x2b, B: 0x20, A: 0xff},
+	"mahogany brown":         {R: 0x4c, G: 0x2b, B: 0x20, A: 0xff},
+	"8017":                   {R: 0x44, G: 0x2f, B: 0x29, A: 0xff},
+	"chocolate brown":        {R: 0x44, G: 0x2f, B: 0x29, A: 0xff},
+	"8019":                   {R: 0x3d, G: 0x36, B: 0x35, A: 0xff},
+	"grey brown":             {R: 0x3d, G: 0x36, B: 0x35, A: 0xff},
+	"8022":                   {R: 0x1a, G: 0x17, B: 0x18, A: 0xff},
+	"black brown":            {R: 0x1a, G: 0x17, B: 0x18, A: 0xff},
+	"8023":                   {R: 0xa4, G: 0x57, B: 0x29, A: 0xff},
+	"orange brown":           {R: 0xa4, G: 0x57, B: 0x29, A: 0xff},
+	"8024":                   {R: 0x79, G: 0x50, B: 0x38, A: 0xff},
+	"beige brown":            {R: 0x79, G: 0x50, B: 0x38, A: 0xff},
+	"8025":                   {R: 0x75, G: 0x58, B: 0x47, A: 0xff},
+	"pale brown":             {R: 0x75, G: 0x58, B: 0x47, A: 0xff},
+	"8028":                   {R: 0x51, G: 0x3a, B: 0x2a, A: 0xff},
+	"terra brown":            {R: 0x51, G: 0x3a, B: 0x2a, A: 0xff},
+	"8029":                   {R: 0x7f, G: 0x40, B: 0x31, A: 0xff},
+	"pearl copper":           {R: 0x7f, G: 0x40, B: 0x31, A: 0xff},
+	"9001":                   {R: 0xe9, G: 0xe0, B: 0xd2, A: 0xff},
+	"cream":                  {R: 0xe9, G: 0xe0, B: 0xd2, A: 0xff},
+	"9002":                   {R: 0xd7, G: 0xd5, B: 0xcb, A: 0xff},
+	"grey white":             {R: 0xd7, G: 0xd5, B: 0xcb, A: 0xff},
+	"9003":                   {R: 0xec, G: 0xec, B: 0xe7, A: 0xff},
+	"signal white":           {R: 0xec, G: 0xec, B: 0xe7, A: 0xff},
+	"9004":                   {R: 0x2b, G: 0x2b, B: 0x2c, A: 0xff},
+	"signal black":           {R: 0x2b, G: 0x2b, B: 0x2c, A: 0xff},
+	"9005":                   {R: 0x0e, G: 0x0e, B: 0x10, A: 0xff},
+	"jet black":              {R: 0x0e, G: 0x0e, B: 0x10, A: 0xff},
+	"9006":                   {R: 0xa1, G: 0xa1, B: 0xa0, A: 0xff},
+	"white aluminium":        {R: 0xa1, G: 0xa1, B: 0xa0, A: 0xff},
+	"9007":                   {R: 0x87, G: 0x85, B: 0x81, A: 0xff},
+	"grey aluminium":         {R: 0x87, G: 0x85, B: 0x81, A: 0xff},
+	"9010":                   {R: 0xf1, G: 0xec, B: 0xe1, A: 0xff},
+	"pure white":             {R: 0xf1, G: 0xec, B: 0xe1, A: 0xff},
+	"9011":                   {R: 0x27, G: 0x29, B: 0x2b, A: 0xff},
+	"graphite black":         {R: 0x27, G: 0x29, B: 0x2b, A: 0xff},
+	"9012":                   {R: 0xf8, G: 0xf2, B: 0xe1, A: 0xff},
+	"cleanroom white":        {R: 0xf8, G: 0xf2, B: 0xe1, A: 0xff},
+	"9016":                   {R: 0xf1, G: 0xf0, B: 0xea, A: 0xff},
+	"traffic white":          {R: 0xf1, G: 0xf0, B: 0xea, A: 0xff},
+	"9017":                   {R: 0x2a, G: 0x29, B: 0x2a, A: 0xff},
+	"traffic black":          {R: 0x2a, G: 0x29, B: 0x2a, A: 0xff},
+	"9018":                   {R: 0xc8, G: 0xcb, B: 0xc4, A: 0xff},
+	"papyrus white":          {R: 0xc8, G: 0xcb, B: 0xc4, A: 0xff},
+	"9022":                   {R: 0x85, G: 0x85, B: 0x83, A: 0xff},
+	"pearl light grey":       {R: 0x85, G: 0x85, B: 0x83, A: 0xff},
+	"9023":                   {R: 0x79, G: 0x7b, B: 0x7a, A: 0xff},
+	"pearl dark grey":        {R: 0x79, G: 0x7b, B: 0x7a, A: 0xff},
+})
@@ -0,0 +1,67 @@
+package colour
+
+import (
+	"fmt"
+	"math"
+)
+
+// These constants describe the grid of RAL Design colours. The colours
+// are named by their CIELCh hue angle, lightness and chroma.
+const (
+	ralDesignHueStep       = 10
+	ralDesignMaxHue        = 350
+	ralDesignLightnessStep = 10
+	ralDesignMinLightness  = 20
+	ralDesignMaxLightness  = 90
+	ralDesignChromaStep    = 5
+	ralDesignMaxChroma     = 60
+	ralDesignGreyStep      = 5
+	ralDesignMinGreyLevel  = 15
+	ralDesignMaxGreyLevel  = 90
+)
+
+// ralDesignColours holds the colours of the RAL Design System plus
+var ralDesignColours = makeRALDesignColours()
+
+// makeRALDesignColours returns the colours of the RAL Design System plus, a
+// collection from RAL gGmbH (www.ral-farben.de). Each RAL Design code gives
+// the CIELCh hue, lightness and chroma of the colour (so "210 50 15" has a
+// hue of 210 degrees, a lightness of 50 and a chroma of 15) and so, unlike
+// the RAL Classic colours, the colours are calculated from their codes
+// rather than taken from a table. The codes are those of the regular grid
+// on which the system is built: hues at steps of 10 degrees, lightnesses at
+// steps of 10 and chromas at steps of 5, together with the neutral greys
+// ("000 15 00" to "000 90 00"). Only those colours that lie within the sRGB
+// gamut are given.
+func makeRALDesignColours() colourNameToRGBA {
+	m := colourNameToRGBA{}
+
+	add := func(h, l, c int) {
+		lch := LCh{L: float64(l), C: float64(c), H: float64(h)}
+
+		lin := xyzToLinearSRGB.apply(lch.Lab().XYZ().vec())
+		if !inSRGBGamut(lin) {
+			return
+		}
+
+		m[fmt.Sprintf("%03d %02d %02d", h, l, c)] =
+			linearToRGBA(lin, math.MaxUint8)
+	}
+
+	for l := ralDesignMinGreyLevel; l <= ralDesignMaxGreyLevel; l +=
+		ralDesignGreyStep {
+		add(0, l, 0)
+	}
+
+	for h := 0; h <= ralDesignMaxHue; h += ralDesignHueStep {
+		for l := ralDesignMinLightness; l <= ralDesignMaxLightness; l +=
+			ralDesignLightnessStep {
+			for c := ralDesignChromaStep; c <= ralDesignMaxChroma; c +=
+				ralDesignChromaStep {
+				add(h, l, c)
+			}
+		}
+	}
+
+	return m
+}
@@ -0,0 +1,109 @@
+package colour
+
+import (
+	"testing"
+
+	"github.com/nickwells/colour.mod/v2/colourtesthelper"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestRALColours(t *testing.T) {
+	skyBlue := rgba{R: 0x00, G: 0x7c, B: 0xb0, A: 0xff}
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		name      string
+		expColour rgba
+	}{
+		{
+			ID:        testhelper.MkID("RAL Classic by code"),
+			name:      "ral:5015",
+			expColour: skyBlue,
+		},
+		{
+			ID:        testhelper.MkID("RAL Classic by name"),
+			name:      "ral:sky blue",
+			expColour: skyBlue,
+		},
+		{
+			ID:        testhelper.MkID("RAL Classic by name, US spelling"),
+			name:      "RAL:traffic gray a",
+			expColour: rgba{R: 0x8e, G: 0x92, B: 0x91, A: 0xff},
+		},
+		{
+			ID:     testhelper.MkID("RAL Classic, bad code"),
+			ExpErr: testhelper.MkExpErr(`bad colour name: "5016"`),
+			name:   "ral:5016",
+		},
+		{
+			ID:        testhelper.MkID("RAL Design, neutral grey"),
+			name:      "raldesign:000 50 00",
+			expColour: rgba{R: 0x77, G: 0x77, B: 0x77, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("RAL Design, chromatic"),
+			name:      "RALDesign:210 50 15",
+			expColour: rgba{R: 0x57, G: 0x7e, B: 0x83, A: 0xff},
+		},
+		{
+			ID:     testhelper.MkID("RAL Design, out of gamut"),
+			ExpErr: testhelper.MkExpErr(`bad colour name: "270 90 60"`),
+			name:   "raldesign:270 90 60",
+		},
+		{
+			ID:     testhelper.MkID("RAL Design, off the grid"),
+			ExpErr: testhelper.MkExpErr(`bad colour name: "215 50 15"`),
+			name:   "raldesign:215 50 15",
+		},
+	}
+
+	for _, tc := range testCases {
+		nc, err := ParseNamedColour(nil, tc.name)
+		if testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc) &&
+			err == nil {
+			colourtesthelper.DiffRGBA(t, tc.IDStr(), "colour",
+				nc.Colour(), tc.expColour)
+		}
+	}
+
+	c, err := RALClassicColours.Colour("sky blue")
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	colourtesthelper.DiffRGBA(t, "Family.Colour", "colour", c, skyBlue)
+}
+
+func TestRALClosest(t *testing.T) {
+	fcs, err := Families{RALClassicColours}.ClosestN(
+		rgba{R: 0x00, G: 0x80, B: 0xb0, A: 0xff}, 1)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if len(fcs) != 1 {
+		t.Fatalf("expected 1 colour, got %d", len(fcs))
+	}
+
+	testhelper.DiffSlice(t, "closest RAL", "names",
+		fcs[0].CNames, []string{"5015", "sky blue", "sky-blue"})
+}
+
+func TestRALColourCounts(t *testing.T) {
+	for _, tc := range []struct {
+		f        Family
+		expCount int
+	}{
+		{f: RALClassicColours, expCount: 216},
+		{f: RALDesignColours, expCount: 2541},
+	} {
+		n, err := tc.f.DistinctColourCount()
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		testhelper.DiffInt(t, tc.f.String(), "distinct colours",
+			n, tc.expCount)
+	}
+}
@@ -0,0 +1,226 @@
+package colour
+
+import (
+	"fmt"
+	"image/color" //nolint:misspell
+	"slices"
+	"strings"
+)
+
+// shadedHue records the name of a hue in a shadedPalette and its colours,
+// one for each of the palette's shades in order. Some hues do not have all
+// the shades; their colours are for the leading shades only.
+type shadedHue struct {
+	name    string
+	colours []rgba
+}
+
+// shadedPalette records the colours of a family where each colour is named
+// by a hue and a shade, such as "blue 500". The shades are given in order
+// from the lightest to the darkest. The others are any colours in the family
+// that are not part of the hue and shade structure.
+type shadedPalette struct {
+	shades []string
+	hues   []shadedHue
+	others colourNameToRGBA
+}
+
+// colourNames returns the colour names of the shadedPalette. Each colour is
+// named by its hue and shade, separated by a space. A hue of more than one
+// word may also be given with the words joined by hyphens, so "blue grey
+// 500" may also be given as "blue-grey 500" (as well as "blue-grey-500").
+func (sp shadedPalette) colourNames() colourNameToRGBA {
+	m := colourNameToRGBA{}
+
+	for name, c := range sp.others {
+		m[name] = c
+	}
+
+	for _, h := range sp.hues {
+		hyphenated := strings.ReplaceAll(h.name, " ", "-")
+
+		for i, c := range h.colours {
+			m[h.name+" "+sp.shades[i]] = c
+
+			if hyphenated != h.name {
+				m[hyphenated+" "+sp.shades[i]] = c
+			}
+		}
+	}
+
+	return withAliases(m)
+}
+
+// hue returns the shadedHue with the given name, which may be given with
+// any of the usual aliases ("blue-grey" or "blue gray" for "blue grey"). It
+// returns false if there is no such hue.
+func (sp shadedPalette) hue(name string) (shadedHue, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+
+	for _, h := range sp.hues {
+		if _, ok := IsAColourAlias(name, h.name); ok {
+			return h, true
+		}
+	}
+
+	return shadedHue{}, false
+}
+
+var (
+	materialColours = materialPalette.colourNames()
+	tailwindColours = tailwindPalette.colourNames()
+)
+
+// shadedPalettes maps each Family whose colours are named by hue and shade
+// to its shadedPalette
+var shadedPalettes = map[Family]shadedPalette{
+	MaterialColours: materialPalette,
+	TailwindColours: tailwindPalette,
+}
+
+// ShadedColour records a colour from a Family whose colours are named by a
+// hue and a shade.
+type ShadedColour struct {
+	Family Family
+	Hue    string
+	Shade  string
+	Colour color.RGBA //nolint:misspell
+}
+
+// Name returns the name of the colour in its Family, the hue and shade
+// separated by a space
+func (sc ShadedColour) Name() string {
+	return sc.Hue + " " + sc.Shade
+}
+
+// NamedColour returns the ShadedColour as a NamedColour. The name is the
+// Family name and the colour name separated by a colon and so it can be
+// given to ParseNamedColour.
+func (sc ShadedColour) NamedColour() NamedColour {
+	return MakeNamedColour(sc.Family.Name()+":"+sc.Name(), sc.Colour)
+}
+
+// ShadedFamilies returns the colour families whose colours are named by a
+// hue and a shade, sorted by name.
+func ShadedFamilies() Families {
+	fl := Families{}
+	for f := range shadedPalettes {
+		fl = append(fl, f)
+	}
+
+	slices.Sort(fl)
+
+	return fl
+}
+
+// shadedPalette returns the canonical Family and its shadedPalette. The
+// error is non-nil if the Family is not valid or its colours are not named
+// by a hue and a shade.
+func (f Family) shadedPalette() (Family, shadedPalette, error) {
+	fi, ok := f.info()
+	if !ok {
+		return f, shadedPalette{}, badFamilyErr(f)
+	}
+
+	sp, ok := shadedPalettes[fi.id]
+	if !ok {
+		return f, shadedPalette{},
+			fmt.Errorf("colour family %q does not have hues and shades",
+				f.Name())
+	}
+
+	return fi.id, sp, nil
+}
+
+// Hues returns the names of the hues in the Family in the order given by
+// the designers of the palette. The error is non-nil if the Family is not
+// valid or its colours are not named by a hue and a shade.
+func (f Family) Hues() ([]string, error) {
+	_, sp, err := f.shadedPalette()
+	if err != nil {
+		return nil, err
+	}
+
+	hues := make([]string, 0, len(sp.hues))
+	for _, h := range sp.hues {
+		hues = append(hues, h.name)
+	}
+
+	return hues, nil
+}
+
+// Shades returns the names of the shades in the Family, from the lightest
+// to the darkest. Not every hue need have every shade. The error is non-nil
+// if the Family is not valid or its colours are not named by a hue and a
+// shade.
+func (f Family) Shades() ([]string, error) {
+	_, sp, err := f.shadedPalette()
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.Clone(sp.shades), nil
+}
+
+// HueShades returns all the shades of the named hue, from the lightest to
+// the darkest. The error is non-nil if the Family is not valid, if its
+// colours are not named by a hue and a shade or if the hue is not known.
+func (f Family) HueShades(hue string) ([]ShadedColour, error) {
+	f, sp, err := f.shadedPalette()
+	if err != nil {
+		return nil, err
+	}
+
+	h, ok := sp.hue(hue)
+	if !ok {
+		return nil,
+			fmt.Errorf("bad hue: %q (colour family: %q)", hue, f.Name())
+	}
+
+	scs := make([]ShadedColour, 0, len(h.colours))
+	for i, c := range h.colours {
+		scs = append(scs, ShadedColour{
+			Family: f,
+			Hue:    h.name,
+			Shade:  sp.shades[i],
+			Colour: c,
+		})
+	}
+
+	return scs, nil
+}
+
+// ShadeHues returns the named shade of every hue that has it, in the order
+// of the hues. The error is non-nil if the Family is not valid, if its
+// colours are not named by a hue and a shade or if the shade is not known.
+func (f Family) ShadeHues(shade string) ([]ShadedColour, error) {
+	f, sp, err := f.shadedPalette()
+	if err != nil {
+		return nil, err
+	}
+
+	shade = strings.ToLower(strings.TrimSpace(shade))
+
+	idx := slices.Index(sp.shades, shade)
+	if idx < 0 {
+		return nil,
+			fmt.Errorf("bad shade: %q (colour family: %q)", shade, f.Name())
+	}
+
+	scs := []ShadedColour{}
+
+	for _, h := range sp.hues {
+		if idx >= len(h.colours) {
+			continue
+		}
+
+		scs = append(scs, ShadedColour{
+			Family: f,
+			Hue:    h.name,
+			Shade:  shade,
+			Colour: h.colours[idx],
+		})
+	}
+
+	return scs, nil
+}
@@ -0,0 +1,225 @@
+package colour
+
+import (
+	"testing"
+
+	"github.com/nickwells/colour.mod/v2/colourtesthelper"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestShadedColourNames(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		name      string
+		expColour rgba
+	}{
+		{
+			ID:        testhelper.MkID("material, hyphenated"),
+			name:      "material:blue-500",
+			expColour: rgba{R: 0x21, G: 0x96, B: 0xf3, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("material, accent"),
+			name:      "Material:indigo A200",
+			expColour: rgba{R: 0x53, G: 0x6d, B: 0xfe, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("material, US spelling"),
+			name:      "material:blue-gray-900",
+			expColour: rgba{R: 0x26, G: 0x32, B: 0x38, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("material, hyphenated hue"),
+			name:      "material:blue-grey 500",
+			expColour: rgba{R: 0x60, G: 0x7d, B: 0x8b, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("material, hyphenated hue, US spelling"),
+			name:      "material:blue-gray 500",
+			expColour: rgba{R: 0x60, G: 0x7d, B: 0x8b, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("material, hyphenated hue, accent"),
+			name:      "material:light-blue A200",
+			expColour: rgba{R: 0x40, G: 0xc4, B: 0xff, A: 0xff},
+		},
+		{
+			ID:     testhelper.MkID("material, brown has no accents"),
+			ExpErr: testhelper.MkExpErr(`bad colour name: "brown a100"`),
+			name:   "material:brown a100",
+		},
+		{
+			ID:        testhelper.MkID("tailwind"),
+			name:      "tailwind:sky-950",
+			expColour: rgba{R: 0x08, G: 0x2f, B: 0x49, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("tailwind, not shaded"),
+			name:      "tailwind:white",
+			expColour: rgba{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+		},
+	}
+
+	for _, tc := range testCases {
+		nc, err := ParseNamedColour(nil, tc.name)
+		if testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc) &&
+			err == nil {
+			colourtesthelper.DiffRGBA(t, tc.IDStr(), "colour",
+				nc.Colour(), tc.expColour)
+		}
+	}
+}
+
+func TestHueShades(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		f         Family
+		hue       string
+		expShades []string
+	}{
+		{
+			ID:  testhelper.MkID("material, with accents"),
+			f:   MaterialColours,
+			hue: "deep-purple",
+			expShades: []string{
+				"50", "100", "200", "300", "400", "500", "600",
+				"700", "800", "900", "a100", "a200", "a400", "a700",
+			},
+		},
+		{
+			ID:  testhelper.MkID("material, without accents"),
+			f:   MaterialColours,
+			hue: "Blue Gray",
+			expShades: []string{
+				"50", "100", "200", "300", "400", "500", "600",
+				"700", "800", "900",
+			},
+		},
+		{
+			ID:  testhelper.MkID("tailwind"),
+			f:   TailwindColours,
+			hue: "slate",
+			expShades: []string{
+				"50", "100", "200", "300", "400", "500", "600",
+				"700", "800", "900", "950",
+			},
+		},
+		{
+			ID: testhelper.MkID("bad hue"),
+			ExpErr: testhelper.MkExpErr(
+				`bad hue: "mauve" (colour family: "tailwind")`),
+			f:   TailwindColours,
+			hue: "mauve",
+		},
+		{
+			ID: testhelper.MkID("not shaded"),
+			ExpErr: testhelper.MkExpErr(
+				`colour family "web" does not have hues and shades`),
+			f:   WebColours,
+			hue: "blue",
+		},
+		{
+			ID:     testhelper.MkID("bad family"),
+			ExpErr: testhelper.MkExpErr(`bad colour family: "nonesuch"`),
+			f:      Family("nonesuch"),
+			hue:    "blue",
+		},
+	}
+
+	for _, tc := range testCases {
+		scs, err := tc.f.HueShades(tc.hue)
+		if !testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc) ||
+			err != nil {
+			continue
+		}
+
+		shades := []string{}
+
+		for _, sc := range scs {
+			shades = append(shades, sc.Shade)
+
+			nc, err := ParseNamedColour(nil, sc.NamedColour().Name())
+			if err != nil {
+				t.Errorf("%s: cannot parse %q: %s",
+					tc.IDStr(), sc.NamedColour().Name(), err)
+				continue
+			}
+
+			colourtesthelper.DiffRGBA(t, tc.IDStr(), sc.Name(),
+				nc.Colour(), sc.Colour)
+		}
+
+		testhelper.DiffSlice(t, tc.IDStr(), "shades", shades, tc.expShades)
+	}
+}
+
+func TestShadeHues(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		f        Family
+		shade    string
+		expCount int
+		expFirst string
+	}{
+		{
+			ID:       testhelper.MkID("material, all hues"),
+			f:        MaterialColours,
+			shade:    "500",
+			expCount: 19,
+			expFirst: "red 500",
+		},
+		{
+			ID:       testhelper.MkID("material, accents only"),
+			f:        MaterialColours,
+			shade:    "A200",
+			expCount: 16,
+			expFirst: "red a200",
+		},
+		{
+			ID:       testhelper.MkID("tailwind"),
+			f:        TailwindColours,
+			shade:    "950",
+			expCount: 22,
+			expFirst: "slate 950",
+		},
+		{
+			ID: testhelper.MkID("bad shade"),
+			ExpErr: testhelper.MkExpErr(
+				`bad shade: "950" (colour family: "material")`),
+			f:     MaterialColours,
+			shade: "950",
+		},
+	}
+
+	for _, tc := range testCases {
+		scs, err := tc.f.ShadeHues(tc.shade)
+		if !testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc) ||
+			err != nil {
+			continue
+		}
+
+		testhelper.DiffInt(t, tc.IDStr(), "count", len(scs), tc.expCount)
+
+		if len(scs) > 0 {
+			testhelper.DiffString(t, tc.IDStr(), "first",
+				scs[0].Name(), tc.expFirst)
+		}
+	}
+}
+
+func TestShadedFamilies(t *testing.T) {
+	testhelper.DiffSlice(t, "shaded families", "families",
+		ShadedFamilies(), Families{MaterialColours, TailwindColours})
+
+	hues, err := MaterialColours.Hues()
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	testhelper.DiffInt(t, "material", "hues", len(hues), 19)
+	testhelper.DiffString(t, "material", "last hue",
+		hues[len(hues)-1], "blue grey")
+}
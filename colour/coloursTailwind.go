@@ -0,0 +1,305 @@
+package colour
+
+// colours from the Tailwind CSS default colour palette (version 3.4). Each
+// hue has eleven shades, from 50 (the lightest) to 950 (the darkest), and
+// the colours are named by the hue and the shade, as in "blue 500".
+//
+//nolint:mnd
+var tailwindPalette = shadedPalette{
+	shades: []string{
+		"50", "100", "200", "300", "400", "500",
+		"600", "700", "800", "900", "950",
+	},
+	hues: []shadedHue{
+		{name: "slate", colours: []rgba{
+			{R: 0xf8, G: 0xfa, B: 0xfc, A: 0xff},
+			{R: 0xf1, G: 0xf5, B: 0xf9, A: 0xff},
+			{R: 0xe2, G: 0xe8, B: 0xf0, A: 0xff},
+			{R: 0xcb, G: 0xd5, B: 0xe1, A: 0xff},
+			{R: 0x94, G: 0xa3, B: 0xb8, A: 0xff},
+			{R: 0x64, G: 0x74, B: 0x8b, A: 0xff},
+			{R: 0x47, G: 0x55, B: 0x69, A: 0xff},
+			{R: 0x33, G: 0x41, B: 0x55, A: 0xff},
+			{R: 0x1e, G: 0x29, B: 0x3b, A: 0xff},
+			{R: 0x0f, G: 0x17, B: 0x2a, A: 0xff},
+			{R: 0x02, G: 0x06, B: 0x17, A: 0xff},
+		}},
+		{name: "gray", colours: []rgba{
+			{R: 0xf9, G: 0xfa, B: 0xfb, A: 0xff},
+			{R: 0xf3, G: 0xf4, B: 0xf6, A: 0xff},
+			{R: 0xe5, G: 0xe7, B: 0xeb, A: 0xff},
+			{R: 0xd1, G: 0xd5, B: 0xdb, A: 0xff},
+			{R: 0x9c, G: 0xa3, B: 0xaf, A: 0xff},
+			{R: 0x6b, G: 0x72, B: 0x80, A: 0xff},
+			{R: 0x4b, G: 0x55, B: 0x63, A: 0xff},
+			{R: 0x37, G: 0x41, B: 0x51, A: 0xff},
+			{R: 0x1f, G: 0x29, B: 0x37, A: 0xff},
+			{R: 0x11, G: 0x18, B: 0x27, A: 0xff},
+			{R: 0x03, G: 0x07, B: 0x12, A: 0xff},
+		}},
+		{name: "zinc", colours: []rgba{
+			{R: 0xfa, G: 0xfa, B: 0xfa, A: 0xff},
+			{R: 0xf4, G: 0xf4, B: 0xf5, A: 0xff},
+			{R: 0xe4, G: 0xe4, B: 0xe7, A: 0xff},
+			{R: 0xd4, G: 0xd4, B: 0xd8, A: 0xff},
+			{R: 0xa1, G: 0xa1, B: 0xaa, A: 0xff},
+			{R: 0x71, G: 0x71, B: 0x7a, A: 0xff},
+			{R: 0x52, G: 0x52, B: 0x5b, A: 0xff},
+			{R: 0x3f, G: 0x3f, B: 0x46, A: 0xff},
+			{R: 0x27, G: 0x27, B: 0x2a, A: 0xff},
+			{R: 0x18, G: 0x18, B: 0x1b, A: 0xff},
+			{R: 0x09, G: 0x09, B: 0x0b, A: 0xff},
+		}},
+		{name: "neutral", colours: []rgba{
+			{R: 0xfa, G: 0xfa, B: 0xfa, A: 0xff},
+			{R: 0xf5, G: 0xf5, B: 0xf5, A: 0xff},
+			{R: 0xe5, G: 0xe5, B: 0xe5, A: 0xff},
+			{R: 0xd4, G: 0xd4, B: 0xd4, A: 0xff},
+			{R: 0xa3, G: 0xa3, B: 0xa3, A: 0xff},
+			{R: 0x73, G: 0x73, B: 0x73, A: 0xff},
+			{R: 0x52, G: 0x52, B: 0x52, A: 0xff},
+			{R: 0x40, G: 0x40, B: 0x40, A: 0xff},
+			{R: 0x26, G: 0x26, B: 0x26, A: 0xff},
+			{R: 0x17, G: 0x17, B: 0x17, A: 0xff},
+			{R: 0x0a, G: 0x0a, B: 0x0a, A: 0xff},
+		}},
+		{name: "stone", colours: []rgba{
+			{R: 0xfa, G: 0xfa, B: 0xf9, A: 0xff},
+			{R: 0xf5, G: 0xf5, B: 0xf4, A: 0xff},
+			{R: 0xe7, G: 0xe5, B: 0xe4, A: 0xff},
+			{R: 0xd6, G: 0xd3, B: 0xd1, A: 0xff},
+			{R: 0xa8, G: 0xa2, B: 0x9e, A: 0xff},
+			{R: 0x78, G: 0x71, B: 0x6c, A: 0xff},
+			{R: 0x57, G: 0x53, B: 0x4e, A: 0xff},
+			{R: 0x44, G: 0x40, B: 0x3c, A: 0xff},
+			{R: 0x29, G: 0x25, B: 0x24, A: 0xff},
+			{R: 0x1c, G: 0x19, B: 0x17, A: 0xff},
+			{R: 0x0c, G: 0x0a, B: 0x09, A: 0xff},
+		}},
+		{name: "red", colours: []rgba{
+			{R: 0xfe, G: 0xf2, B: 0xf2, A: 0xff},
+			{R: 0xfe, G: 0xe2, B: 0xe2, A: 0xff},
+			{R: 0xfe, G: 0xca, B: 0xca, A: 0xff},
+			{R: 0xfc, G: 0xa5, B: 0xa5, A: 0xff},
+			{R: 0xf8, G: 0x71, B: 0x71, A: 0xff},
+			{R: 0xef, G: 0x44, B: 0x44, A: 0xff},
+			{R: 0xdc, G: 0x26, B: 0x26, A: 0xff},
+			{R: 0xb9, G: 0x1c, B: 0x1c, A: 0xff},
+			{R: 0x99, G: 0x1b, B: 0x1b, A: 0xff},
+			{R: 0x7f, G: 0x1d, B: 0x1d, A: 0xff},
+			{R: 0x45, G: 0x0a, B: 0x0a, A: 0xff},
+		}},
+		{name: "orange", colours: []rgba{
+			{R: 0xff, G: 0xf7, B: 0xed, A: 0xff},
+			{R: 0xff, G: 0xed, B: 0xd5, A: 0xff},
+			{R: 0xfe, G: 0xd7, B: 0xaa, A: 0xff},
+			{R: 0xfd, G: 0xba, B: 0x74, A: 0xff},
+			{R: 0xfb, G: 0x92, B: 0x3c, A: 0xff},
+			{R: 0xf9, G: 0x73, B: 0x16, A: 0xff},
+			{R: 0xea, G: 0x58, B: 0x0c, A: 0xff},
+			{R: 0xc2, G: 0x41, B: 0x0c, A: 0xff},
+			{R: 0x9a, G: 0x34, B: 0x12, A: 0xff},
+			{R: 0x7c, G: 0x2d, B: 0x12, A: 0xff},
+			{R: 0x43, G: 0x14, B: 0x07, A: 0xff},
+		}},
+		{name: "amber", colours: []rgba{
+			{R: 0xff, G: 0xfb, B: 0xeb, A: 0xff},
+			{R: 0xfe, G: 0xf3, B: 0xc7, A: 0xff},
+			{R: 0xfd, G: 0xe6, B: 0x8a, A: 0xff},
+			{R: 0xfc, G: 0xd3, B: 0x4d, A: 0xff},
+			{R: 0xfb, G: 0xbf, B: 0x24, A: 0xff},
+			{R: 0xf5, G: 0x9e, B: 0x0b, A: 0xff},
+			{R: 0xd9, G: 0x77, B: 0x06, A: 0xff},
+			{R: 0xb4, G: 0x53, B: 0x09, A: 0xff},
+			{R: 0x92, G: 0x40, B: 0x0e, A: 0xff},
+			{R: 0x78, G: 0x35, B: 0x0f, A: 0xff},
+			{R: 0x45, G: 0x1a, B: 0x03, A: 0xff},
+		}},
+		{name: "yellow", colours: []rgba{
+			{R: 0xfe, G: 0xfc, B: 0xe8, A: 0xff},
+			{R: 0xfe, G: 0xf9, B: 0xc3, A: 0xff},
+			{R: 0xfe, G: 0xf0, B: 0x8a, A: 0xff},
+			{R: 0xfd, G: 0xe0, B: 0x47, A: 0xff},
+			{R: 0xfa, G: 0xcc, B: 0x15, A: 0xff},
+			{R: 0xea, G: 0xb3, B: 0x08, A: 0xff},
+			{R: 0xca, G: 0x8a, B: 0x04, A: 0xff},
+			{R: 0xa1, G: 0x62, B: 0x07, A: 0xff},
+			{R: 0x85, G: 0x4d, B: 0x0e, A: 0xff},
+			{R: 0x71, G: 0x3f, B: 0x12, A: 0xff},
+			{R: 0x42, G: 0x20, B: 0x06, A: 0xff},
+		}},
+		{name: "lime", colours: []rgba{
+			{R: 0xf7, G: 0xfe, B: 0xe7, A: 0xff},
+			{R: 0xec, G: 0xfc, B: 0xcb, A: 0xff},
+			{R: 0xd9, G: 0xf9, B: 0x9d, A: 0xff},
+			{R: 0xbe, G: 0xf2, B: 0x64, A: 0xff},
+			{R: 0xa3, G: 0xe6, B: 0x35, A: 0xff},
+			{R: 0x84, G: 0xcc, B: 0x16, A: 0xff},
+			{R: 0x65, G: 0xa3, B: 0x0d, A: 0xff},
+			{R: 0x4d, G: 0x7c, B: 0x0f, A: 0xff},
+			{R: 0x3f, G: 0x62, B: 0x12, A: 0xff},
+			{R: 0x36, G: 0x53, B: 0x14, A: 0xff},
+			{R: 0x1a, G: 0x2e, B: 0x05, A: 0xff},
+		}},
+		{name: "green", colours: []rgba{
+			{R: 0xf0, G: 0xfd, B: 0xf4, A: 0xff},
+			{R: 0xdc, G: 0xfc, B: 0xe7, A: 0xff},
+			{R: 0xbb, G: 0xf7, B: 0xd0, A: 0xff},
+			{R: 0x86, G: 0xef, B: 0xac, A: 0xff},
+			{R: 0x4a, G: 0xde, B: 0x80, A: 0xff},
+			{R: 0x22, G: 0xc5, B: 0x5e, A: 0xff},
+			{R: 0x16, G: 0xa3, B: 0x4a, A: 0xff},
+			{R: 0x15, G: 0x80, B: 0x3d, A: 0xff},
+			{R: 0x16, G: 0x65, B: 0x34, A: 0xff},
+			{R: 0x14, G: 0x53, B: 0x2d, A: 0xff},
+			{R: 0x05, G: 0x2e, B: 0x16, A: 0xff},
+		}},
+		{name: "emerald", colours: []rgba{
+			{R: 0xec, G: 0xfd, B: 0xf5, A: 0xff},
+			{R: 0xd1, G: 0xfa, B: 0xe5, A: 0xff},
+			{R: 0xa7, G: 0xf3, B: 0xd0, A: 0xff},
+			{R: 0x6e, G: 0xe7, B: 0xb7, A: 0xff},
+			{R: 0x34, G: 0xd3, B: 0x99, A: 0xff},
+			{R: 0x10, G: 0xb9, B: 0x81, A: 0xff},
+			{R: 0x05, G: 0x96, B: 0x69, A: 0xff},
+			{R: 0x04, G: 0x78, B: 0x57, A: 0xff},
+			{R: 0x06, G: 0x5f, B: 0x46, A: 0xff},
+			{R: 0x06, G: 0x4e, B: 0x3b, A: 0xff},
+			{R: 0x02, G: 0x2c, B: 0x22, A: 0xff},
+		}},
+		{name: "teal", colours: []rgba{
+			{R: 0xf0, G: 0xfd, B: 0xfa, A: 0xff},
+			{R: 0xcc, G: 0xfb, B: 0xf1, A: 0xff},
+			{R: 0x99, G: 0xf6, B: 0xe4, A: 0xff},
+			{R: 0x5e, G: 0xea, B: 0xd4, A: 0xff},
+			{R: 0x2d, G: 0xd4, B: 0xbf, A: 0xff},
+			{R: 0x14, G: 0xb8, B: 0xa6, A: 0xff},
+			{R: 0x0d, G: 0x94, B: 0x88, A: 0xff},
+			{R: 0x0f, G: 0x76, B: 0x6e, A: 0xff},
+			{R: 0x11, G: 0x5e, B: 0x59, A: 0xff},
+			{R: 0x13, G: 0x4e, B: 0x4a, A: 0xff},
+			{R: 0x04, G: 0x2f, B: 0x2e, A: 0xff},
+		}},
+		{name: "cyan", colours: []rgba{
+			{R: 0xec, G: 0xfe, B: 0xff, A: 0xff},
+			{R: 0xcf, G: 0xfa, B: 0xfe, A: 0xff},
+			{R: 0xa5, G: 0xf3, B: 0xfc, A: 0xff},
+			{R: 0x67, G: 0xe8, B: 0xf9, A: 0xff},
+			{R: 0x22, G: 0xd3, B: 0xee, A: 0xff},
+			{R: 0x06, G: 0xb6, B: 0xd4, A: 0xff},
+			{R: 0x08, G: 0x91, B: 0xb2, A: 0xff},
+			{R: 0x0e, G: 0x74, B: 0x90, A: 0xff},
+			{R: 0x15, G: 0x5e, B: 0x75, A: 0xff},
+			{R: 0x16, G: 0x4e, B: 0x63, A: 0xff},
+			{R: 0x08, G: 0x33, B: 0x44, A: 0xff},
+		}},
+		{name: "sky", colours: []rgba{
+			{R: 0xf0, G: 0xf9, B: 0xff, A: 0xff},
+			{R: 0xe0, G: 0xf2, B: 0xfe, A: 0xff},
+			{R: 0xba, G: 0xe6, B: 0xfd, A: 0xff},
+			{R: 0x7d, G: 0xd3, B: 0xfc, A: 0xff},
+			{R: 0x38, G: 0xbd, B: 0xf8, A: 0xff},
+			{R: 0x0e, G: 0xa5, B: 0xe9, A: 0xff},
+			{R: 0x02, G: 0x84, B: 0xc7, A: 0xff},
+			{R: 0x03, G: 0x69, B: 0xa1, A: 0xff},
+			{R: 0x07, G: 0x59, B: 0x85, A: 0xff},
+			{R: 0x0c, G: 0x4a, B: 0x6e, A: 0xff},
+			{R: 0x08, G: 0x2f, B: 0x49, A: 0xff},
+		}},
+		{name: "blue", colours: []rgba{
+			{R: 0xef, G: 0xf6, B: 0xff, A: 0xff},
+			{R: 0xdb, G: 0xea, B: 0xfe, A: 0xff},
+			{R: 0xbf, G: 0xdb, B: 0xfe, A: 0xff},
+			{R: 0x93, G: 0xc5, B: 0xfd, A: 0xff},
+			{R: 0x60, G: 0xa5, B: 0xfa, A: 0xff},
+			{R: 0x3b, G: 0x82, B: 0xf6, A: 0xff},
+			{R: 0x25, G: 0x63, B: 0xeb, A: 0xff},
+			{R: 0x1d, G: 0x4e, B: 0xd8, A: 0xff},
+			{R: 0x1e, G: 0x40, B: 0xaf, A: 0xff},
+			{R: 0x1e, G: 0x3a, B: 0x8a, A: 0xff},
+			{R: 0x17, G: 0x25, B: 0x54, A: 0xff},
+		}},
+		{name: "indigo", colours: []rgba{
+			{R: 0xee, G: 0xf2, B: 0xff, A: 0xff},
+			{R: 0xe0, G: 0xe7, B: 0xff, A: 0xff},
+			{R: 0xc7, G: 0xd2, B: 0xfe, A: 0xff},
+			{R: 0xa5, G: 0xb4, B: 0xfc, A: 0xff},
+			{R: 0x81, G: 0x8c, B: 0xf8, A: 0xff},
+			{R: 0x63, G: 0x66, B: 0xf1, A: 0xff},
+			{R: 0x4f, G: 0x46, B: 0xe5, A: 0xff},
+			{R: 0x43, G: 0x38, B: 0xca, A: 0xff},
+			{R: 0x37, G: 0x30, B: 0xa3, A: 0xff},
+			{R: 0x31, G: 0x2e, B: 0x81, A: 0xff},
+			{R: 0x1e, G: 0x1b, B: 0x4b, A: 0xff},
+		}},
+		{name: "violet", colours: []rgba{
+			{R: 0xf5, G: 0xf3, B: 0xff, A: 0xff},
+			{R: 0xed, G: 0xe9, B: 0xfe, A: 0xff},
+			{R: 0xdd, G: 0xd6, B: 0xfe, A: 0xff},
+			{R: 0xc4, G: 0xb5, B: 0xfd, A: 0xff},
+			{R: 0xa7, G: 0x8b, B: 0xfa, A: 0xff},
+			{R: 0x8b, G: 0x5c, B: 0xf6, A: 0xff},
+			{R: 0x7c, G: 0x3a, B: 0xed, A: 0xff},
+			{R: 0x6d, G: 0x28, B: 0xd9, A: 0xff},
+			{R: 0x5b, G: 0x21, B: 0xb6, A: 0xff},
+			{R: 0x4c, G: 0x1d, B: 0x95, A: 0xff},
+			{R: 0x2e, G: 0x10, B: 0x65, A: 0xff},
+		}},
+		{name: "purple", colours: []rgba{
+			{R: 0xfa, G: 0xf5, B: 0xff, A: 0xff},
+			{R: 0xf3, G: 0xe8, B: 0xff, A: 0xff},
+			{R: 0xe9, G: 0xd5, B: 0xff, A: 0xff},
+			{R: 0xd8, G: 0xb4, B: 0xfe, A: 0xff},
+			{R: 0xc0, G: 0x84, B: 0xfc, A: 0xff},
+			{R: 0xa8, G: 0x55, B: 0xf7, A: 0xff},
+			{R: 0x93, G: 0x33, B: 0xea, A: 0xff},
+			{R: 0x7e, G: 0x22, B: 0xce, A: 0xff},
+			{R: 0x6b, G: 0x21, B: 0xa8, A: 0xff},
+			{R: 0x58, G: 0x1c, B: 0x87, A: 0xff},
+			{R: 0x3b, G: 0x07, B: 0x64, A: 0xff},
+		}},
+		{name: "fuchsia", colours: []rgba{
+			{R: 0xfd, G: 0xf4, B: 0xff, A: 0xff},
+			{R: 0xfa, G: 0xe8, B: 0xff, A: 0xff},
+			{R: 0xf5, G: 0xd0, B: 0xfe, A: 0xff},
+			{R: 0xf0, G: 0xab, B: 0xfc, A: 0xff},
+			{R: 0xe8, G: 0x79, B: 0xf9, A: 0xff},
+			{R: 0xd9, G: 0x46, B: 0xef, A: 0xff},
+			{R: 0xc0, G: 0x26, B: 0xd3, A: 0xff},
+			{R: 0xa2, G: 0x1c, B: 0xaf, A: 0xff},
+			{R: 0x86, G: 0x19, B: 0x8f, A: 0xff},
+			{R: 0x70, G: 0x1a, B: 0x75, A: 0xff},
+			{R: 0x4a, G: 0x04, B: 0x4e, A: 0xff},
+		}},
+		{name: "pink", colours: []rgba{
+			{R: 0xfd, G: 0xf2, B: 0xf8, A: 0xff},
+			{R: 0xfc, G: 0xe7, B: 0xf3, A: 0xff},
+			{R: 0xfb, G: 0xcf, B: 0xe8, A: 0xff},
+			{R: 0xf9, G: 0xa8, B: 0xd4, A: 0xff},
+			{R: 0xf4, G: 0x72, B: 0xb6, A: 0xff},
+			{R: 0xec, G: 0x48, B: 0x99, A: 0xff},
+			{R: 0xdb, G: 0x27, B: 0x77, A: 0xff},
+			{R: 0xbe, G: 0x18, B: 0x5d, A: 0xff},
+			{R: 0x9d, G: 0x17, B: 0x4d, A: 0xff},
+			{R: 0x83, G: 0x18, B: 0x43, A: 0xff},
+			{R: 0x50, G: 0x07, B: 0x24, A: 0xff},
+		}},
+		{name: "rose", colours: []rgba{
+			{R: 0xff, G: 0xf1, B: 0xf2, A: 0xff},
+			{R: 0xff, G: 0xe4, B: 0xe6, A: 0xff},
+			{R: 0xfe, G: 0xcd, B: 0xd3, A: 0xff},
+			{R: 0xfd, G: 0xa4, B: 0xaf, A: 0xff},
+			{R: 0xfb, G: 0x71, B: 0x85, A: 0xff},
+			{R: 0xf4, G: 0x3f, B: 0x5e, A: 0xff},
+			{R: 0xe1, G: 0x1d, B: 0x48, A: 0xff},
+			{R: 0xbe, G: 0x12, B: 0x3c, A: 0xff},
+			{R: 0x9f, G: 0x12, B: 0x39, A: 0xff},
+			{R: 0x88, G: 0x13, B: 0x37, A: 0xff},
+			{R: 0x4c, G: 0x05, B: 0x19, A: 0xff},
+		}},
+	},
+	others: colourNameToRGBA{
+		"black": {R: 0x00, G: 0x00, B: 0x00, A: 0xff},
+		"white": {R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+	},
+}
@@ -0,0 +1,339 @@
+package colour
+
+import (
+	"fmt"
+	"image/color" //nolint:misspell
+)
+
+var (
+	solarizedColours = withAliases(colourNameToRGBA{
+		"base03":  {R: 0x00, G: 0x2B, B: 0x36, A: 0xFF},
+		"base02":  {R: 0x07, G: 0x36, B: 0x42, A: 0xFF},
+		"base01":  {R: 0x58, G: 0x6E, B: 0x75, A: 0xFF},
+		"base00":  {R: 0x65, G: 0x7B, B: 0x83, A: 0xFF},
+		"base0":   {R: 0x83, G: 0x94, B: 0x96, A: 0xFF},
+		"base1":   {R: 0x93, G: 0xA1, B: 0xA1, A: 0xFF},
+		"base2":   {R: 0xEE, G: 0xE8, B: 0xD5, A: 0xFF},
+		"base3":   {R: 0xFD, G: 0xF6, B: 0xE3, A: 0xFF},
+		"yellow":  {R: 0xB5, G: 0x89, B: 0x00, A: 0xFF},
+		"orange":  {R: 0xCB, G: 0x4B, B: 0x16, A: 0xFF},
+		"red":     {R: 0xDC, G: 0x32, B: 0x2F, A: 0xFF},
+		"magenta": {R: 0xD3, G: 0x36, B: 0x82, A: 0xFF},
+		"violet":  {R: 0x6C, G: 0x71, B: 0xC4, A: 0xFF},
+		"blue":    {R: 0x26, G: 0x8B, B: 0xD2, A: 0xFF},
+		"cyan":    {R: 0x2A, G: 0xA1, B: 0x98, A: 0xFF},
+		"green":   {R: 0x85, G: 0x99, B: 0x00, A: 0xFF},
+	})
+
+	draculaColours = withAliases(colourNameToRGBA{
+		"background":     {R: 0x28, G: 0x2A, B: 0x36, A: 0xFF},
+		"current line":   {R: 0x44, G: 0x47, B: 0x5A, A: 0xFF},
+		"foreground":     {R: 0xF8, G: 0xF8, B: 0xF2, A: 0xFF},
+		"comment":        {R: 0x62, G: 0x72, B: 0xA4, A: 0xFF},
+		"cyan":           {R: 0x8B, G: 0xE9, B: 0xFD, A: 0xFF},
+		"green":          {R: 0x50, G: 0xFA, B: 0x7B, A: 0xFF},
+		"orange":         {R: 0xFF, G: 0xB8, B: 0x6C, A: 0xFF},
+		"pink":           {R: 0xFF, G: 0x79, B: 0xC6, A: 0xFF},
+		"purple":         {R: 0xBD, G: 0x93, B: 0xF9, A: 0xFF},
+		"red":            {R: 0xFF, G: 0x55, B: 0x55, A: 0xFF},
+		"yellow":         {R: 0xF1, G: 0xFA, B: 0x8C, A: 0xFF},
+		"black":          {R: 0x21, G: 0x22, B: 0x2C, A: 0xFF},
+		"bright red":     {R: 0xFF, G: 0x6E, B: 0x6E, A: 0xFF},
+		"bright green":   {R: 0x69, G: 0xFF, B: 0x94, A: 0xFF},
+		"bright yellow":  {R: 0xFF, G: 0xFF, B: 0xA5, A: 0xFF},
+		"bright blue":    {R: 0xD6, G: 0xAC, B: 0xFF, A: 0xFF},
+		"bright magenta": {R: 0xFF, G: 0x92, B: 0xDF, A: 0xFF},
+		"bright cyan":    {R: 0xA4, G: 0xFF, B: 0xFF, A: 0xFF},
+		"bright white":   {R: 0xFF, G: 0xFF, B: 0xFF, A: 0xFF},
+	})
+
+	gruvboxColours = withAliases(colourNameToRGBA{
+		"bg0 hard":      {R: 0x1D, G: 0x20, B: 0x21, A: 0xFF},
+		"bg0":           {R: 0x28, G: 0x28, B: 0x28, A: 0xFF},
+		"bg0 soft":      {R: 0x32, G: 0x30, B: 0x2F, A: 0xFF},
+		"bg1":           {R: 0x3C, G: 0x38, B: 0x36, A: 0xFF},
+		"bg2":           {R: 0x50, G: 0x49, B: 0x45, A: 0xFF},
+		"bg3":           {R: 0x66, G: 0x5C, B: 0x54, A: 0xFF},
+		"bg4":           {R: 0x7C, G: 0x6F, B: 0x64, A: 0xFF},
+		"grey":          {R: 0x92, G: 0x83, B: 0x74, A: 0xFF},
+		"fg0":           {R: 0xFB, G: 0xF1, B: 0xC7, A: 0xFF},
+		"fg1":           {R: 0xEB, G: 0xDB, B: 0xB2, A: 0xFF},
+		"fg2":           {R: 0xD5, G: 0xC4, B: 0xA1, A: 0xFF},
+		"fg3":           {R: 0xBD, G: 0xAE, B: 0x93, A: 0xFF},
+		"fg4":           {R: 0xA8, G: 0x99, B: 0x84, A: 0xFF},
+		"red":           {R: 0xCC, G: 0x24, B: 0x1D, A: 0xFF},
+		"green":         {R: 0x98, G: 0x97, B: 0x1A, A: 0xFF},
+		"yellow":        {R: 0xD7, G: 0x99, B: 0x21, A: 0xFF},
+		"blue":          {R: 0x45, G: 0x85, B: 0x88, A: 0xFF},
+		"purple":        {R: 0xB1, G: 0x62, B: 0x86, A: 0xFF},
+		"aqua":          {R: 0x68, G: 0x9D, B: 0x6A, A: 0xFF},
+		"orange":        {R: 0xD6, G: 0x5D, B: 0x0E, A: 0xFF},
+		"bright red":    {R: 0xFB, G: 0x49, B: 0x34, A: 0xFF},
+		"bright green":  {R: 0xB8, G: 0xBB, B: 0x26, A: 0xFF},
+		"bright yellow": {R: 0xFA, G: 0xBD, B: 0x2F, A: 0xFF},
+		"bright blue":   {R: 0x83, G: 0xA5, B: 0x98, A: 0xFF},
+		"bright purple": {R: 0xD3, G: 0x86, B: 0x9B, A: 0xFF},
+		"bright aqua":   {R: 0x8E, G: 0xC0, B: 0x7C, A: 0xFF},
+		"bright orange": {R: 0xFE, G: 0x80, B: 0x19, A: 0xFF},
+	})
+
+	nordColours = withAliases(colourNameToRGBA{
+		"nord0":  {R: 0x2E, G: 0x34, B: 0x40, A: 0xFF},
+		"nord1":  {R: 0x3B, G: 0x42, B: 0x52, A: 0xFF},
+		"nord2":  {R: 0x43, G: 0x4C, B: 0x5E, A: 0xFF},
+		"nord3":  {R: 0x4C, G: 0x56, B: 0x6A, A: 0xFF},
+		"nord4":  {R: 0xD8, G: 0xDE, B: 0xE9, A: 0xFF},
+		"nord5":  {R: 0xE5, G: 0xE9, B: 0xF0, A: 0xFF},
+		"nord6":  {R: 0xEC, G: 0xEF, B: 0xF4, A: 0xFF},
+		"nord7":  {R: 0x8F, G: 0xBC, B: 0xBB, A: 0xFF},
+		"nord8":  {R: 0x88, G: 0xC0, B: 0xD0, A: 0xFF},
+		"nord9":  {R: 0x81, G: 0xA1, B: 0xC1, A: 0xFF},
+		"nord10": {R: 0x5E, G: 0x81, B: 0xAC, A: 0xFF},
+		"nord11": {R: 0xBF, G: 0x61, B: 0x6A, A: 0xFF},
+		"nord12": {R: 0xD0, G: 0x87, B: 0x70, A: 0xFF},
+		"nord13": {R: 0xEB, G: 0xCB, B: 0x8B, A: 0xFF},
+		"nord14": {R: 0xA3, G: 0xBE, B: 0x8C, A: 0xFF},
+		"nord15": {R: 0xB4, G: 0x8E, B: 0xAD, A: 0xFF},
+	})
+
+	catppuccinColours = withAliases(colourNameToRGBA{
+		"rosewater": {R: 0xF5, G: 0xE0, B: 0xDC, A: 0xFF},
+		"flamingo":  {R: 0xF2, G: 0xCD, B: 0xCD, A: 0xFF},
+		"pink":      {R: 0xF5, G: 0xC2, B: 0xE7, A: 0xFF},
+		"mauve":     {R: 0xCB, G: 0xA6, B: 0xF7, A: 0xFF},
+		"red":       {R: 0xF3, G: 0x8B, B: 0xA8, A: 0xFF},
+		"maroon":    {R: 0xEB, G: 0xA0, B: 0xAC, A: 0xFF},
+		"peach":     {R: 0xFA, G: 0xB3, B: 0x87, A: 0xFF},
+		"yellow":    {R: 0xF9, G: 0xE2, B: 0xAF, A: 0xFF},
+		"green":     {R: 0xA6, G: 0xE3, B: 0xA1, A: 0xFF},
+		"teal":      {R: 0x94, G: 0xE2, B: 0xD5, A: 0xFF},
+		"sky":       {R: 0x89, G: 0xDC, B: 0xEB, A: 0xFF},
+		"sapphire":  {R: 0x74, G: 0xC7, B: 0xEC, A: 0xFF},
+		"blue":      {R: 0x89, G: 0xB4, B: 0xFA, A: 0xFF},
+		"lavender":  {R: 0xB4, G: 0xBE, B: 0xFE, A: 0xFF},
+		"text":      {R: 0xCD, G: 0xD6, B: 0xF4, A: 0xFF},
+		"subtext1":  {R: 0xBA, G: 0xC2, B: 0xDE, A: 0xFF},
+		"subtext0":  {R: 0xA6, G: 0xAD, B: 0xC8, A: 0xFF},
+		"overlay2":  {R: 0x93, G: 0x99, B: 0xB2, A: 0xFF},
+		"overlay1":  {R: 0x7F, G: 0x84, B: 0x9C, A: 0xFF},
+		"overlay0":  {R: 0x6C, G: 0x70, B: 0x86, A: 0xFF},
+		"surface2":  {R: 0x58, G: 0x5B, B: 0x70, A: 0xFF},
+		"surface1":  {R: 0x45, G: 0x47, B: 0x5A, A: 0xFF},
+		"surface0":  {R: 0x31, G: 0x32, B: 0x44, A: 0xFF},
+		"base":      {R: 0x1E, G: 0x1E, B: 0x2E, A: 0xFF},
+		"mantle":    {R: 0x18, G: 0x18, B: 0x25, A: 0xFF},
+		"crust":     {R: 0x11, G: 0x11, B: 0x1B, A: 0xFF},
+	})
+)
+
+// TerminalScheme describes a terminal or editor colour scheme: the colours
+// it gives to each of the roles that a terminal needs together with
+// details of where the scheme comes from. The colours are all taken from
+// the scheme's Family.
+type TerminalScheme struct {
+	// Family is the colour Family holding the scheme's colours
+	Family Family
+	// Name is the full name of the scheme, including any variant
+	Name string
+	// Author is the author of the scheme
+	Author string
+	// URL is the home page of the scheme
+	URL string
+	// Dark is true if the scheme has a dark background
+	Dark bool
+
+	Foreground color.RGBA //nolint:misspell
+	Background color.RGBA //nolint:misspell
+	Cursor     color.RGBA //nolint:misspell
+	Selection  color.RGBA //nolint:misspell
+
+	// ANSI holds the colours for the 16 ANSI colours in index order:
+	// black, red, green, yellow, blue, magenta, cyan, white and then the
+	// bright forms of each
+	ANSI [ansiColourCount]color.RGBA //nolint:misspell
+}
+
+// schemeRoles names the colours in a Family used for a TerminalScheme
+type schemeRoles struct {
+	foreground, background, cursor, selection string
+	ansi                                      [ansiColourCount]string
+}
+
+// makeTerminalScheme returns the TerminalScheme with its colours taken from
+// the map. It panics if any name is missing as this indicates an error in
+// the package's data.
+func makeTerminalScheme(
+	ts TerminalScheme, m colourNameToRGBA, roles schemeRoles,
+) TerminalScheme {
+	get := func(name string) rgba {
+		c, ok := m[name]
+		if !ok {
+			panic(fmt.Errorf("%s: bad colour name: %q", ts.Family, name))
+		}
+
+		return c
+	}
+
+	ts.Foreground = get(roles.foreground)
+	ts.Background = get(roles.background)
+	ts.Cursor = get(roles.cursor)
+	ts.Selection = get(roles.selection)
+
+	for i, name := range roles.ansi {
+		ts.ANSI[i] = get(name)
+	}
+
+	return ts
+}
+
+// terminalSchemes maps each terminal scheme Family to its TerminalScheme
+var terminalSchemes = map[Family]TerminalScheme{
+	SolarizedColours: makeTerminalScheme(
+		TerminalScheme{
+			Family: SolarizedColours,
+			Name:   "Solarized Dark",
+			Author: "Ethan Schoonover",
+			URL:    "https://ethanschoonover.com/solarized/",
+			Dark:   true,
+		},
+		solarizedColours,
+		schemeRoles{
+			foreground: "base0",
+			background: "base03",
+			cursor:     "base1",
+			selection:  "base02",
+			ansi: [ansiColourCount]string{
+				"base02", "red", "green", "yellow",
+				"blue", "magenta", "cyan", "base2",
+				"base03", "orange", "base01", "base00",
+				"base0", "violet", "base1", "base3",
+			},
+		}),
+	DraculaColours: makeTerminalScheme(
+		TerminalScheme{
+			Family: DraculaColours,
+			Name:   "Dracula",
+			Author: "Zeno Rocha",
+			URL:    "https://draculatheme.com/",
+			Dark:   true,
+		},
+		draculaColours,
+		schemeRoles{
+			foreground: "foreground",
+			background: "background",
+			cursor:     "foreground",
+			selection:  "current line",
+			ansi: [ansiColourCount]string{
+				"black", "red", "green", "yellow",
+				"purple", "pink", "cyan", "foreground",
+				"comment", "bright red", "bright green", "bright yellow",
+				"bright blue", "bright magenta", "bright cyan",
+				"bright white",
+			},
+		}),
+	GruvboxColours: makeTerminalScheme(
+		TerminalScheme{
+			Family: GruvboxColours,
+			Name:   "Gruvbox Dark",
+			Author: "Pavel Pertsev",
+			URL:    "https://github.com/morhetz/gruvbox",
+			Dark:   true,
+		},
+		gruvboxColours,
+		schemeRoles{
+			foreground: "fg1",
+			background: "bg0",
+			cursor:     "fg1",
+			selection:  "bg2",
+			ansi: [ansiColourCount]string{
+				"bg0", "red", "green", "yellow",
+				"blue", "purple", "aqua", "fg4",
+				"grey", "bright red", "bright green", "bright yellow",
+				"bright blue", "bright purple", "bright aqua", "fg1",
+			},
+		}),
+	NordColours: makeTerminalScheme(
+		TerminalScheme{
+			Family: NordColours,
+			Name:   "Nord",
+			Author: "Arctic Ice Studio",
+			URL:    "https://www.nordtheme.com/",
+			Dark:   true,
+		},
+		nordColours,
+		schemeRoles{
+			foreground: "nord4",
+			background: "nord0",
+			cursor:     "nord4",
+			selection:  "nord2",
+			ansi: [ansiColourCount]string{
+				"nord1", "nord11", "nord14", "nord13",
+				"nord9", "nord15", "nord8", "nord5",
+				"nord3", "nord11", "nord14", "nord13",
+				"nord9", "nord15", "nord7", "nord6",
+			},
+		}),
+	CatppuccinColours: makeTerminalScheme(
+		TerminalScheme{
+			Family: CatppuccinColours,
+			Name:   "Catppuccin Mocha",
+			Author: "Catppuccin",
+			URL:    "https://catppuccin.com/palette/",
+			Dark:   true,
+		},
+		catppuccinColours,
+		schemeRoles{
+			foreground: "text",
+			background: "base",
+			cursor:     "rosewater",
+			selection:  "surface2",
+			ansi: [ansiColourCount]string{
+				"surface1", "red", "green", "yellow",
+				"blue", "pink", "teal", "subtext1",
+				"surface2", "red", "green", "yellow",
+				"blue", "pink", "teal", "subtext0",
+			},
+		}),
+}
+
+// TerminalSchemeFamilies returns the Families which have an associated
+// TerminalScheme, sorted by name
+func TerminalSchemeFamilies() Families {
+	return Families{
+		CatppuccinColours,
+		DraculaColours,
+		GruvboxColours,
+		NordColours,
+		SolarizedColours,
+	}
+}
+
+// TerminalScheme returns the TerminalScheme for the Family. A non-nil error
+// is returned if the Family is not recognised or if it is not a terminal
+// colour scheme.
+func (f Family) TerminalScheme() (TerminalScheme, error) {
+	fi, ok := f.info()
+	if !ok {
+		return TerminalScheme{}, badFamilyErr(f)
+	}
+
+	ts, ok := terminalSchemes[fi.id]
+	if !ok {
+		return TerminalScheme{},
+			fmt.Errorf("colour family %q is not a terminal colour scheme",
+				f.Name())
+	}
+
+	return ts, nil
+}
+
+// Palette returns the default terminal palette with the first 16 colours
+// replaced by the scheme's ANSI colours. This can be used with an
+// SGRParser to find the colours that a terminal using the scheme would
+// show.
+func (ts TerminalScheme) Palette() TerminalPalette {
+	tp := DefaultTerminalPalette()
+	copy(tp[:], ts.ANSI[:])
+
+	return tp
+}
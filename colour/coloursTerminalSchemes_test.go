@@ -0,0 +1,140 @@
+package colour
+
+import (
+	"testing"
+
+	"github.com/nickwells/colour.mod/v2/colourtesthelper"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestTerminalSchemeColours(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		name      string
+		expColour rgba
+	}{
+		{
+			ID:        testhelper.MkID("solarized"),
+			name:      "solarized:base03",
+			expColour: rgba{R: 0x00, G: 0x2b, B: 0x36, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("dracula, with alias"),
+			name:      "dracula:current-line",
+			expColour: rgba{R: 0x44, G: 0x47, B: 0x5a, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("gruvbox, US spelling"),
+			name:      "gruvbox:gray",
+			expColour: rgba{R: 0x92, G: 0x83, B: 0x74, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("nord"),
+			name:      "nord:nord8",
+			expColour: rgba{R: 0x88, G: 0xc0, B: 0xd0, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("catppuccin"),
+			name:      "catppuccin:mauve",
+			expColour: rgba{R: 0xcb, G: 0xa6, B: 0xf7, A: 0xff},
+		},
+	}
+
+	for _, tc := range testCases {
+		nc, err := ParseNamedColour(nil, tc.name)
+		if err != nil {
+			t.Log(tc.IDStr())
+			t.Errorf("\t: unexpected error: %s", err)
+
+			continue
+		}
+
+		colourtesthelper.DiffRGBA(t, tc.IDStr(), "colour",
+			nc.Colour(), tc.expColour)
+	}
+}
+
+func TestFamilyTerminalScheme(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		f       Family
+		expName string
+		expFG   rgba
+		expBG   rgba
+		expRed  rgba
+	}{
+		{
+			ID:      testhelper.MkID("solarized"),
+			f:       SolarizedColours,
+			expName: "Solarized Dark",
+			expFG:   rgba{R: 0x83, G: 0x94, B: 0x96, A: 0xff},
+			expBG:   rgba{R: 0x00, G: 0x2b, B: 0x36, A: 0xff},
+			expRed:  rgba{R: 0xdc, G: 0x32, B: 0x2f, A: 0xff},
+		},
+		{
+			ID:      testhelper.MkID("dracula, by lower-case name"),
+			f:       Family("dracula"),
+			expName: "Dracula",
+			expFG:   rgba{R: 0xf8, G: 0xf8, B: 0xf2, A: 0xff},
+			expBG:   rgba{R: 0x28, G: 0x2a, B: 0x36, A: 0xff},
+			expRed:  rgba{R: 0xff, G: 0x55, B: 0x55, A: 0xff},
+		},
+		{
+			ID: testhelper.MkID("not a scheme"),
+			ExpErr: testhelper.MkExpErr(
+				`colour family "web" is not a terminal colour scheme`),
+			f: WebColours,
+		},
+		{
+			ID:     testhelper.MkID("bad family"),
+			ExpErr: testhelper.MkExpErr(`bad colour family: "nonesuch"`),
+			f:      Family("nonesuch"),
+		},
+	}
+
+	for _, tc := range testCases {
+		ts, err := tc.f.TerminalScheme()
+		if !testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc) ||
+			err != nil {
+			continue
+		}
+
+		testhelper.DiffString(t, tc.IDStr(), "name", ts.Name, tc.expName)
+		colourtesthelper.DiffRGBA(t, tc.IDStr(), "foreground",
+			ts.Foreground, tc.expFG)
+		colourtesthelper.DiffRGBA(t, tc.IDStr(), "background",
+			ts.Background, tc.expBG)
+		colourtesthelper.DiffRGBA(t, tc.IDStr(), "ANSI red",
+			ts.ANSI[1], tc.expRed)
+
+		tp := ts.Palette()
+		colourtesthelper.DiffRGBA(t, tc.IDStr(), "palette red",
+			tp[1], tc.expRed)
+		colourtesthelper.DiffRGBA(t, tc.IDStr(), "palette 196",
+			tp[196], rgba{R: 0xff, A: 0xff})
+	}
+}
+
+func TestTerminalSchemeFamilies(t *testing.T) {
+	fl := TerminalSchemeFamilies()
+
+	testhelper.DiffInt(t, "scheme families", "count",
+		len(fl), len(terminalSchemes))
+
+	if err := fl.Check(); err != nil {
+		t.Error("unexpected error:", err)
+	}
+
+	for _, f := range fl {
+		ts, err := f.TerminalScheme()
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", f, err)
+			continue
+		}
+
+		if ts.Family != f {
+			t.Errorf("%s: the scheme has the wrong family: %s", f, ts.Family)
+		}
+	}
+}
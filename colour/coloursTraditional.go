@@ -0,0 +1,274 @@
+package colour
+
+import "fmt"
+
+// nativeColourName records a traditional colour name in its native script,
+// the romanisation of the name and the colour
+type nativeColourName struct {
+	native    string
+	romanised string
+	colour    rgba
+}
+
+// withRomanisations returns a map of colour names to RGBA values where each
+// colour is named both in its native script and by its romanisation. The
+// usual aliases are then generated by withAliases so, for instance, the
+// romanisation "kōbai" also gives "kobai". It panics if any name is
+// repeated.
+func withRomanisations(ncs []nativeColourName) colourNameToRGBA {
+	m := colourNameToRGBA{}
+
+	add := func(name string, c rgba) {
+		if _, ok := m[name]; ok {
+			panic(fmt.Errorf("duplicate traditional colour name: %q", name))
+		}
+
+		m[name] = c
+	}
+
+	for _, nc := range ncs {
+		add(nc.native, nc.colour)
+		add(nc.romanised, nc.colour)
+	}
+
+	return withAliases(m)
+}
+
+// colours from the traditional Japanese colours as given by the NIPPON
+// COLORS collection (nipponcolors.com). This is a selection of 64 of the
+// 250 colours of the collection. Each colour is named in Japanese script
+// and by its Hepburn romanisation.
+//
+//nolint:mnd
+var japaneseColours = withRomanisations([]nativeColourName{
+	{native: "撫子", romanised: "nadeshiko",
+		colour: rgba{R: 0xdc, G: 0x9f, B: 0xb4, A: 0xff}},
+	{native: "紅梅", romanised: "kōbai",
+		colour: rgba{R: 0xe1, G: 0x6b, B: 0x8c, A: 0xff}},
+	{native: "蘇芳", romanised: "suō",
+		colour: rgba{R: 0x8e, G: 0x35, B: 0x4a, A: 0xff}},
+	{native: "退紅", romanised: "taikō",
+		colour: rgba{R: 0xf8, G: 0xc3, B: 0xcd, A: 0xff}},
+	{native: "桃", romanised: "momo",
+		colour: rgba{R: 0xf5, G: 0x96, B: 0xaa, A: 0xff}},
+	{native: "苺", romanised: "ichigo",
+		colour: rgba{R: 0xb5, G: 0x49, B: 0x5b, A: 0xff}},
+	{native: "薄紅", romanised: "usubeni",
+		colour: rgba{R: 0xe8, G: 0x7a, B: 0x90, A: 0xff}},
+	{native: "今様", romanised: "imayō",
+		colour: rgba{R: 0xd0, G: 0x5a, B: 0x6e, A: 0xff}},
+	{native: "中紅", romanised: "nakabeni",
+		colour: rgba{R: 0xdb, G: 0x4d, B: 0x6d, A: 0xff}},
+	{native: "桜", romanised: "sakura",
+		colour: rgba{R: 0xfe, G: 0xdf, B: 0xe1, A: 0xff}},
+	{native: "梅鼠", romanised: "umenezumi",
+		colour: rgba{R: 0x9e, G: 0x7a, B: 0x7a, A: 0xff}},
+	{native: "韓紅花", romanised: "karakurenai",
+		colour: rgba{R: 0xd0, G: 0x10, B: 0x4c, A: 0xff}},
+	{native: "臙脂", romanised: "enji",
+		colour: rgba{R: 0x9f, G: 0x35, B: 0x3a, A: 0xff}},
+	{native: "紅", romanised: "kurenai",
+		colour: rgba{R: 0xcb, G: 0x1b, B: 0x45, A: 0xff}},
+	{native: "鴇", romanised: "toki",
+		colour: rgba{R: 0xee, G: 0xa9, B: 0xa9, A: 0xff}},
+	{native: "長春", romanised: "chōshun",
+		colour: rgba{R: 0xbf, G: 0x67, B: 0x66, A: 0xff}},
+	{native: "桜鼠", romanised: "sakuranezumi",
+		colour: rgba{R: 0xb1, G: 0x96, B: 0x93, A: 0xff}},
+	{native: "小豆", romanised: "azuki",
+		colour: rgba{R: 0x95, G: 0x4a, B: 0x45, A: 0xff}},
+	{native: "赤紅", romanised: "akabeni",
+		colour: rgba{R: 0xcb, G: 0x40, B: 0x42, A: 0xff}},
+	{native: "真朱", romanised: "shinshu",
+		colour: rgba{R: 0xab, G: 0x3b, B: 0x3a, A: 0xff}},
+	{native: "灰桜", romanised: "haizakura",
+		colour: rgba{R: 0xd7, G: 0xc4, B: 0xbb, A: 0xff}},
+	{native: "海老茶", romanised: "ebicha",
+		colour: rgba{R: 0x73, G: 0x43, B: 0x38, A: 0xff}},
+	{native: "銀朱", romanised: "ginshu",
+		colour: rgba{R: 0xc7, G: 0x3e, B: 0x3a, A: 0xff}},
+	{native: "曙", romanised: "akebono",
+		colour: rgba{R: 0xf1, G: 0x94, B: 0x83, A: 0xff}},
+	{native: "珊瑚朱", romanised: "sangoshu",
+		colour: rgba{R: 0xf1, G: 0x7c, B: 0x67, A: 0xff}},
+	{native: "猩猩緋", romanised: "shōjōhi",
+		colour: rgba{R: 0xe8, G: 0x30, B: 0x15, A: 0xff}},
+	{native: "鳶", romanised: "tobi",
+		colour: rgba{R: 0x72, G: 0x48, B: 0x32, A: 0xff}},
+	{native: "弁柄", romanised: "bengara",
+		colour: rgba{R: 0x9a, G: 0x50, B: 0x34, A: 0xff}},
+	{native: "照柿", romanised: "terigaki",
+		colour: rgba{R: 0xc4, G: 0x62, B: 0x43, A: 0xff}},
+	{native: "洗朱", romanised: "araishu",
+		colour: rgba{R: 0xfb, G: 0x96, B: 0x6e, A: 0xff}},
+	{native: "萱草", romanised: "kanzō",
+		colour: rgba{R: 0xfc, G: 0x9f, B: 0x4d, A: 0xff}},
+	{native: "朽葉", romanised: "kuchiba",
+		colour: rgba{R: 0xe2, G: 0x94, B: 0x3b, A: 0xff}},
+	{native: "琥珀", romanised: "kohaku",
+		colour: rgba{R: 0xca, G: 0x7a, B: 0x2c, A: 0xff}},
+	{native: "山吹", romanised: "yamabuki",
+		colour: rgba{R: 0xff, G: 0xb1, B: 0x1b, A: 0xff}},
+	{native: "鬱金", romanised: "ukon",
+		colour: rgba{R: 0xef, G: 0xbb, B: 0x24, A: 0xff}},
+	{native: "支子", romanised: "kuchinashi",
+		colour: rgba{R: 0xf6, G: 0xc5, B: 0x55, A: 0xff}},
+	{native: "黄蘗", romanised: "kihada",
+		colour: rgba{R: 0xfb, G: 0xe2, B: 0x51, A: 0xff}},
+	{native: "鶯", romanised: "uguisu",
+		colour: rgba{R: 0x6c, G: 0x6a, B: 0x2d, A: 0xff}},
+	{native: "萌黄", romanised: "moegi",
+		colour: rgba{R: 0x7b, G: 0xa2, B: 0x3f, A: 0xff}},
+	{native: "柳染", romanised: "yanagizome",
+		colour: rgba{R: 0x91, G: 0xad, B: 0x70, A: 0xff}},
+	{native: "若竹", romanised: "wakatake",
+		colour: rgba{R: 0x5d, G: 0xac, B: 0x81, A: 0xff}},
+	{native: "常磐", romanised: "tokiwa",
+		colour: rgba{R: 0x1b, G: 0x81, B: 0x3e, A: 0xff}},
+	{native: "千歳緑", romanised: "chitosemidori",
+		colour: rgba{R: 0x36, G: 0x56, B: 0x3c, A: 0xff}},
+	{native: "青磁", romanised: "seiji",
+		colour: rgba{R: 0x69, G: 0xb0, B: 0xac, A: 0xff}},
+	{native: "新橋", romanised: "shinbashi",
+		colour: rgba{R: 0x00, G: 0x89, B: 0xa7, A: 0xff}},
+	{native: "浅葱", romanised: "asagi",
+		colour: rgba{R: 0x33, G: 0xa6, B: 0xb8, A: 0xff}},
+	{native: "露草", romanised: "tsuyukusa",
+		colour: rgba{R: 0x2e, G: 0xa9, B: 0xdf, A: 0xff}},
+	{native: "空", romanised: "sora",
+		colour: rgba{R: 0x58, G: 0xb2, B: 0xdc, A: 0xff}},
+	{native: "勿忘草", romanised: "wasurenagusa",
+		colour: rgba{R: 0x7d, G: 0xb9, B: 0xde, A: 0xff}},
+	{native: "群青", romanised: "gunjō",
+		colour: rgba{R: 0x51, G: 0xa8, B: 0xdd, A: 0xff}},
+	{native: "瑠璃", romanised: "ruri",
+		colour: rgba{R: 0x00, G: 0x5c, B: 0xaf, A: 0xff}},
+	{native: "紺", romanised: "kon",
+		colour: rgba{R: 0x0f, G: 0x25, B: 0x40, A: 0xff}},
+	{native: "藤", romanised: "fuji",
+		colour: rgba{R: 0x8b, G: 0x81, B: 0xc3, A: 0xff}},
+	{native: "桔梗", romanised: "kikyō",
+		colour: rgba{R: 0x6a, G: 0x4c, B: 0x9c, A: 0xff}},
+	{native: "江戸紫", romanised: "edomurasaki",
+		colour: rgba{R: 0x77, G: 0x42, B: 0x8d, A: 0xff}},
+	{native: "菖蒲", romanised: "shōbu",
+		colour: rgba{R: 0x6f, G: 0x33, B: 0x81, A: 0xff}},
+	{native: "紫", romanised: "murasaki",
+		colour: rgba{R: 0x8f, G: 0x77, B: 0xb5, A: 0xff}},
+	{native: "利休鼠", romanised: "rikyūnezumi",
+		colour: rgba{R: 0x70, G: 0x7c, B: 0x74, A: 0xff}},
+	{native: "銀鼠", romanised: "ginnezumi",
+		colour: rgba{R: 0x91, G: 0x98, B: 0x9f, A: 0xff}},
+	{native: "鈍", romanised: "nibi",
+		colour: rgba{R: 0x65, G: 0x67, B: 0x65, A: 0xff}},
+	{native: "白練", romanised: "shironeri",
+		colour: rgba{R: 0xfc, G: 0xfa, B: 0xf2, A: 0xff}},
+	{native: "胡粉", romanised: "gofun",
+		colour: rgba{R: 0xff, G: 0xff, B: 0xfb, A: 0xff}},
+	{native: "墨", romanised: "sumi",
+		colour: rgba{R: 0x1c, G: 0x1c, B: 0x1c, A: 0xff}},
+	{native: "黒", romanised: "kuro",
+		colour: rgba{R: 0x08, G: 0x08, B: 0x08, A: 0xff}},
+})
+
+// colours from the traditional Chinese colours as given in the table of
+// traditional Chinese colours (中国传统色彩) which is widely reproduced
+// online; the table cites no primary source and there is no standard for
+// these colours, so other sources give different values for some of the
+// names. This is a selection of 45 of the colours of the table. Each colour
+// is named in simplified Chinese characters and by its pinyin romanisation
+// (with tone marks).
+//
+//nolint:mnd
+var chineseColours = withRomanisations([]nativeColourName{
+	{native: "朱砂", romanised: "zhūshā",
+		colour: rgba{R: 0xff, G: 0x46, B: 0x1f, A: 0xff}},
+	{native: "朱红", romanised: "zhūhóng",
+		colour: rgba{R: 0xff, G: 0x4c, B: 0x00, A: 0xff}},
+	{native: "丹", romanised: "dān",
+		colour: rgba{R: 0xff, G: 0x4e, B: 0x20, A: 0xff}},
+	{native: "胭脂", romanised: "yānzhī",
+		colour: rgba{R: 0x9d, G: 0x29, B: 0x33, A: 0xff}},
+	{native: "绯红", romanised: "fēihóng",
+		colour: rgba{R: 0xc8, G: 0x3c, B: 0x23, A: 0xff}},
+	{native: "银红", romanised: "yínhóng",
+		colour: rgba{R: 0xf0, G: 0x56, B: 0x54, A: 0xff}},
+	{native: "嫣红", romanised: "yānhóng",
+		colour: rgba{R: 0xef, G: 0x7a, B: 0x82, A: 0xff}},
+	{native: "桃红", romanised: "táohóng",
+		colour: rgba{R: 0xf4, G: 0x79, B: 0x83, A: 0xff}},
+	{native: "海棠红", romanised: "hǎitánghóng",
+		colour: rgba{R: 0xdb, G: 0x5a, B: 0x6b, A: 0xff}},
+	{native: "石榴红", romanised: "shíliúhóng",
+		colour: rgba{R: 0xf2, G: 0x0c, B: 0x00, A: 0xff}},
+	{native: "枣红", romanised: "zǎohóng",
+		colour: rgba{R: 0xc3, G: 0x21, B: 0x36, A: 0xff}},
+	{native: "洋红", romanised: "yánghóng",
+		colour: rgba{R: 0xff, G: 0x00, B: 0x97, A: 0xff}},
+	{native: "品红", romanised: "pǐnhóng",
+		colour: rgba{R: 0xf0, G: 0x00, B: 0x56, A: 0xff}},
+	{native: "酡红", romanised: "tuóhóng",
+		colour: rgba{R: 0xdc, G: 0x30, B: 0x23, A: 0xff}},
+	{native: "绛紫", romanised: "jiàngzǐ",
+		colour: rgba{R: 0x8c, G: 0x43, B: 0x56, A: 0xff}},
+	{native: "鹅黄", romanised: "éhuáng",
+		colour: rgba{R: 0xff, G: 0xf1, B: 0x43, A: 0xff}},
+	{native: "鸭黄", romanised: "yāhuáng",
+		colour: rgba{R: 0xfa, G: 0xff, B: 0x72, A: 0xff}},
+	{native: "杏黄", romanised: "xìnghuáng",
+		colour: rgba{R: 0xff, G: 0xa6, B: 0x31, A: 0xff}},
+	{native: "橙黄", romanised: "chénghuáng",
+		colour: rgba{R: 0xff, G: 0xa4, B: 0x00, A: 0xff}},
+	{native: "橘黄", romanised: "júhuáng",
+		colour: rgba{R: 0xff, G: 0x89, B: 0x36, A: 0xff}},
+	{native: "姜黄", romanised: "jiānghuáng",
+		colour: rgba{R: 0xff, G: 0xc7, B: 0x73, A: 0xff}},
+	{native: "秋香色", romanised: "qiūxiāngsè",
+		colour: rgba{R: 0xd9, G: 0xb6, B: 0x11, A: 0xff}},
+	{native: "琥珀", romanised: "hǔpò",
+		colour: rgba{R: 0xca, G: 0x69, B: 0x24, A: 0xff}},
+	{native: "赭", romanised: "zhě",
+		colour: rgba{R: 0x9c, G: 0x53, B: 0x33, A: 0xff}},
+	{native: "葱绿", romanised: "cōnglǜ",
+		colour: rgba{R: 0x9e, G: 0xd9, B: 0x00, A: 0xff}},
+	{native: "柳绿", romanised: "liǔlǜ",
+		colour: rgba{R: 0xaf, G: 0xdd, B: 0x22, A: 0xff}},
+	{native: "松花色", romanised: "sōnghuāsè",
+		colour: rgba{R: 0xbc, G: 0xe6, B: 0x72, A: 0xff}},
+	{native: "竹青", romanised: "zhúqīng",
+		colour: rgba{R: 0x78, G: 0x92, B: 0x62, A: 0xff}},
+	{native: "青", romanised: "qīng",
+		colour: rgba{R: 0x00, G: 0xe0, B: 0x9e, A: 0xff}},
+	{native: "碧蓝", romanised: "bìlán",
+		colour: rgba{R: 0x3e, G: 0xed, B: 0xe7, A: 0xff}},
+	{native: "蔚蓝", romanised: "wèilán",
+		colour: rgba{R: 0x70, G: 0xf3, B: 0xff, A: 0xff}},
+	{native: "靛青", romanised: "diànqīng",
+		colour: rgba{R: 0x17, G: 0x7c, B: 0xb0, A: 0xff}},
+	{native: "靛蓝", romanised: "diànlán",
+		colour: rgba{R: 0x06, G: 0x52, B: 0x79, A: 0xff}},
+	{native: "宝蓝", romanised: "bǎolán",
+		colour: rgba{R: 0x4b, G: 0x5c, B: 0xc4, A: 0xff}},
+	{native: "藏青", romanised: "zàngqīng",
+		colour: rgba{R: 0x2e, G: 0x4e, B: 0x7e, A: 0xff}},
+	{native: "玄青", romanised: "xuánqīng",
+		colour: rgba{R: 0x3d, G: 0x3b, B: 0x4f, A: 0xff}},
+	{native: "黛", romanised: "dài",
+		colour: rgba{R: 0x4a, G: 0x42, B: 0x66, A: 0xff}},
+	{native: "丁香色", romanised: "dīngxiāngsè",
+		colour: rgba{R: 0xcc, G: 0xa4, B: 0xe3, A: 0xff}},
+	{native: "青莲", romanised: "qīnglián",
+		colour: rgba{R: 0x80, G: 0x1d, B: 0xae, A: 0xff}},
+	{native: "紫檀", romanised: "zǐtán",
+		colour: rgba{R: 0x4c, G: 0x22, B: 0x1b, A: 0xff}},
+	{native: "雪白", romanised: "xuěbái",
+		colour: rgba{R: 0xf0, G: 0xfc, B: 0xff, A: 0xff}},
+	{native: "月白", romanised: "yuèbái",
+		colour: rgba{R: 0xd6, G: 0xec, B: 0xf0, A: 0xff}},
+	{native: "象牙白", romanised: "xiàngyábái",
+		colour: rgba{R: 0xff, G: 0xfb, B: 0xf0, A: 0xff}},
+	{native: "乌黑", romanised: "wūhēi",
+		colour: rgba{R: 0x39, G: 0x2f, B: 0x41, A: 0xff}},
+	{native: "漆黑", romanised: "qīhēi",
+		colour: rgba{R: 0x16, G: 0x18, B: 0x23, A: 0xff}},
+})
@@ -0,0 +1,98 @@
+package colour
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/nickwells/colour.mod/v2/colourtesthelper"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestTraditionalColours(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		name      string
+		expColour rgba
+	}{
+		{
+			ID:        testhelper.MkID("Japanese, native script"),
+			name:      "japanese:紅梅",
+			expColour: rgba{R: 0xe1, G: 0x6b, B: 0x8c, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("Japanese, romanised"),
+			name:      "Japanese:kōbai",
+			expColour: rgba{R: 0xe1, G: 0x6b, B: 0x8c, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("Japanese, romanised without macron"),
+			name:      "japanese:kobai",
+			expColour: rgba{R: 0xe1, G: 0x6b, B: 0x8c, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("Japanese, romanised with capitals"),
+			name:      "japanese:Rikyunezumi",
+			expColour: rgba{R: 0x70, G: 0x7c, B: 0x74, A: 0xff},
+		},
+		{
+			ID:     testhelper.MkID("Japanese, bad name"),
+			ExpErr: testhelper.MkExpErr(`bad colour name: "nosuchiro"`),
+			name:   "japanese:nosuchiro",
+		},
+		{
+			ID:        testhelper.MkID("Chinese, native script"),
+			name:      "chinese:葱绿",
+			expColour: rgba{R: 0x9e, G: 0xd9, B: 0x00, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("Chinese, pinyin"),
+			name:      "chinese:cōnglǜ",
+			expColour: rgba{R: 0x9e, G: 0xd9, B: 0x00, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("Chinese, pinyin without tone marks"),
+			name:      "chinese:conglu",
+			expColour: rgba{R: 0x9e, G: 0xd9, B: 0x00, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("Encycolorpedia, without macron"),
+			name:      "encycolorpedia:kobai-iro",
+			expColour: rgba{R: 0xdb, G: 0x5a, B: 0x6b, A: 0xff},
+		},
+	}
+
+	for _, tc := range testCases {
+		nc, err := ParseNamedColour(nil, tc.name)
+		if testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc) &&
+			err == nil {
+			colourtesthelper.DiffRGBA(t, tc.IDStr(), "colour",
+				nc.Colour(), tc.expColour)
+		}
+	}
+}
+
+func TestWithRomanisations(t *testing.T) {
+	m := withRomanisations([]nativeColourName{
+		{
+			native:    "紅梅",
+			romanised: "kōbai",
+			colour:    rgba{R: 0xe1, G: 0x6b, B: 0x8c, A: 0xff},
+		},
+	})
+
+	testhelper.DiffStringSlice(t, "withRomanisations", "names",
+		slices.Sorted(maps.Keys(m)), []string{"kobai", "kōbai", "紅梅"})
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("withRomanisations: a duplicate name should panic")
+		}
+	}()
+
+	withRomanisations([]nativeColourName{
+		{native: "紅", romanised: "kurenai"},
+		{native: "紅", romanised: "beni"},
+	})
+}
@@ -0,0 +1,173 @@
+package colour
+
+import (
+	"fmt"
+	"image/color" //nolint:misspell
+	"strconv"
+)
+
+const (
+	// xtermCubeStart is the index of the first colour in the xterm
+	// 6x6x6 colour cube
+	xtermCubeStart = 16
+	// xtermGreyStart is the index of the first colour in the xterm
+	// greyscale ramp
+	xtermGreyStart = 232
+	// xtermColourCount is the number of colours in the xterm palette
+	xtermColourCount = 256
+	// ansiColourCount is the number of ANSI colours
+	ansiColourCount = 16
+)
+
+// xtermCubeLevels gives the values of the red, green and blue channels used
+// by the xterm 6x6x6 colour cube
+var xtermCubeLevels = [6]uint8{0x00, 0x5f, 0x87, 0xaf, 0xd7, 0xff}
+
+// ansiColourNames gives the names of the ANSI colours in index order
+var ansiColourNames = [ansiColourCount]string{
+	"black",
+	"red",
+	"green",
+	"yellow",
+	"blue",
+	"magenta",
+	"cyan",
+	"white",
+	"bright black",
+	"bright red",
+	"bright green",
+	"bright yellow",
+	"bright blue",
+	"bright magenta",
+	"bright cyan",
+	"bright white",
+}
+
+var (
+	// xterm256ColoursByNum holds the colours of the xterm 256-colour
+	// palette in index order
+	xterm256ColoursByNum = makeXTerm256Colours()
+
+	// ansi16Palette is used to find the closest ANSI colour. The colours
+	// are all distinct and so the palette index is the ANSI index.
+	ansi16Palette, _ = MakePerceptualPalette(
+		xterm256ColoursByNum[:ansiColourCount])
+
+	// xtermFixedPalette is used to find the closest colour in the colour
+	// cube and greyscale ramp of the xterm palette. The colours are all
+	// distinct and so the palette index is the xterm index less
+	// xtermCubeStart.
+	xtermFixedPalette, _ = MakePerceptualPalette(
+		xterm256ColoursByNum[xtermCubeStart:])
+
+	xterm256Colours = makeXTerm256ColourNames()
+	ansi16Colours   = makeANSI16ColourNames()
+)
+
+// cgaToANSI converts between a CGA colour index and the corresponding ANSI
+// colour index. The CGA index has blue in the lowest bit and red in the
+// third whereas the ANSI index has them the other way round; swapping them
+// converts in either direction.
+func cgaToANSI(i int) int {
+	const (
+		redBit  = 4
+		blueBit = 1
+	)
+
+	rval := i &^ (redBit | blueBit)
+	if i&redBit != 0 {
+		rval |= blueBit
+	}
+
+	if i&blueBit != 0 {
+		rval |= redBit
+	}
+
+	return rval
+}
+
+// makeXTerm256Colours returns the colours of the xterm 256-colour
+// palette. The first 16 are the ANSI colours, taken from the CGA colours,
+// followed by the 6x6x6 colour cube and then a 24-step greyscale ramp.
+func makeXTerm256Colours() []rgba {
+	cs := make([]rgba, 0, xtermColourCount)
+
+	for i := range ansiColourCount {
+		cs = append(cs, cgaColoursByNum[cgaToANSI(i)])
+	}
+
+	for _, r := range xtermCubeLevels {
+		for _, g := range xtermCubeLevels {
+			for _, b := range xtermCubeLevels {
+				cs = append(cs, rgba{R: r, G: g, B: b, A: 0xff})
+			}
+		}
+	}
+
+	for i := range xtermColourCount - xtermGreyStart {
+		g := uint8(8 + 10*i) //nolint:mnd,gosec
+		cs = append(cs, rgba{R: g, G: g, B: g, A: 0xff})
+	}
+
+	return cs
+}
+
+// makeXTerm256ColourNames returns the map of names to colours for the
+// XTerm256Colours family. The colours are named by their index.
+func makeXTerm256ColourNames() colourNameToRGBA {
+	m := colourNameToRGBA{}
+
+	for i, c := range xterm256ColoursByNum {
+		m[strconv.Itoa(i)] = c
+	}
+
+	return m
+}
+
+// makeANSI16ColourNames returns the map of names to colours for the
+// ANSI16Colours family
+func makeANSI16ColourNames() colourNameToRGBA {
+	m := colourNameToRGBA{}
+
+	for i, name := range ansiColourNames {
+		m[name] = xterm256ColoursByNum[i]
+	}
+
+	return withAliases(m)
+}
+
+// XTerm256Colour returns the xterm 256-colour palette colour corresponding
+// to the given int. If the colour is not found then a non-nil error is
+// returned.
+func XTerm256Colour(i int) (color.RGBA, error) { //nolint:misspell
+	if i < 0 || i >= xtermColourCount {
+		return rgba{}, fmt.Errorf("bad xterm colour index: %d", i)
+	}
+
+	return xterm256ColoursByNum[i], nil
+}
+
+// XTerm256Index returns the index of the xterm 256-colour palette colour
+// closest to the given colour, found in the Oklab colour space. Only the
+// colour cube and the greyscale ramp (indexes 16 to 255) are searched as
+// terminals often change the first 16 colours. The alpha value is ignored.
+func XTerm256Index(c color.RGBA) int { //nolint:misspell
+	return xtermCubeStart + xtermFixedPalette.closest(c)
+}
+
+// ANSI16Colour returns the ANSI colour corresponding to the given int. The
+// colours are those of the CGA but in the ANSI order, so 1 is red rather
+// than blue. If the colour is not found then a non-nil error is returned.
+func ANSI16Colour(i int) (color.RGBA, error) { //nolint:misspell
+	if i < 0 || i >= ansiColourCount {
+		return rgba{}, fmt.Errorf("bad ANSI colour index: %d", i)
+	}
+
+	return xterm256ColoursByNum[i], nil
+}
+
+// ANSI16Index returns the index of the ANSI colour closest to the given
+// colour, found in the Oklab colour space. The alpha value is ignored.
+func ANSI16Index(c color.RGBA) int { //nolint:misspell
+	return ansi16Palette.closest(c)
+}
@@ -0,0 +1,209 @@
+package colour
+
+import (
+	"testing"
+
+	"github.com/nickwells/colour.mod/v2/colourtesthelper"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestXTerm256Colour(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		i         int
+		expColour rgba
+	}{
+		{
+			ID:        testhelper.MkID("1 (ANSI red)"),
+			i:         1,
+			expColour: rgba{R: 0x80, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("16 (cube black)"),
+			i:         16,
+			expColour: rgba{A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("208 (cube orange)"),
+			i:         208,
+			expColour: rgba{R: 0xff, G: 0x87, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("232 (first grey)"),
+			i:         232,
+			expColour: rgba{R: 8, G: 8, B: 8, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("255 (last grey)"),
+			i:         255,
+			expColour: rgba{R: 0xee, G: 0xee, B: 0xee, A: 0xff},
+		},
+		{
+			ID:     testhelper.MkID("bad index:256"),
+			ExpErr: testhelper.MkExpErr("bad xterm colour index: 256"),
+			i:      256,
+		},
+		{
+			ID:     testhelper.MkID("bad index:-1"),
+			ExpErr: testhelper.MkExpErr("bad xterm colour index: -1"),
+			i:      -1,
+		},
+	}
+
+	for _, tc := range testCases {
+		c, err := XTerm256Colour(tc.i)
+		testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc)
+		colourtesthelper.DiffRGBA(t, tc.IDStr(), "xterm by number",
+			c, tc.expColour)
+	}
+}
+
+func TestANSI16Colour(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		i         int
+		expColour rgba
+	}{
+		{
+			ID:        testhelper.MkID("1 (red)"),
+			i:         1,
+			expColour: rgba{R: 0x80, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("12 (bright blue)"),
+			i:         12,
+			expColour: rgba{B: 0xff, A: 0xff},
+		},
+		{
+			ID:     testhelper.MkID("bad index:16"),
+			ExpErr: testhelper.MkExpErr("bad ANSI colour index: 16"),
+			i:      16,
+		},
+	}
+
+	for _, tc := range testCases {
+		c, err := ANSI16Colour(tc.i)
+		testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc)
+		colourtesthelper.DiffRGBA(t, tc.IDStr(), "ANSI by number",
+			c, tc.expColour)
+	}
+}
+
+func TestTerminalIndex(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		c            rgba
+		expXTermIdx  int
+		expANSI16Idx int
+	}{
+		{
+			ID:           testhelper.MkID("black"),
+			c:            rgba{A: 0xff},
+			expXTermIdx:  16,
+			expANSI16Idx: 0,
+		},
+		{
+			ID:           testhelper.MkID("pure red"),
+			c:            rgba{R: 0xff, A: 0xff},
+			expXTermIdx:  196,
+			expANSI16Idx: 9,
+		},
+		{
+			ID:           testhelper.MkID("dark red, exact ANSI match"),
+			c:            rgba{R: 0x80, A: 0xff},
+			expXTermIdx:  88,
+			expANSI16Idx: 1,
+		},
+		{
+			ID:           testhelper.MkID("mid grey"),
+			c:            rgba{R: 0x80, G: 0x80, B: 0x80, A: 0xff},
+			expXTermIdx:  244,
+			expANSI16Idx: 8,
+		},
+		{
+			ID:           testhelper.MkID("orange, transparent"),
+			c:            rgba{R: 0xff, G: 0x87},
+			expXTermIdx:  208,
+			expANSI16Idx: 9,
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.DiffInt(t, tc.IDStr(), "xterm index",
+			XTerm256Index(tc.c), tc.expXTermIdx)
+		testhelper.DiffInt(t, tc.IDStr(), "ANSI index",
+			ANSI16Index(tc.c), tc.expANSI16Idx)
+	}
+}
+
+func TestTerminalFamilies(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		name      string
+		expColour rgba
+	}{
+		{
+			ID:        testhelper.MkID("xterm by index"),
+			name:      "xterm:196",
+			expColour: rgba{R: 0xff, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("ANSI by name"),
+			name:      "ansi:bright blue",
+			expColour: rgba{B: 0xff, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("ANSI by alias"),
+			name:      "ansi:bright-blue",
+			expColour: rgba{B: 0xff, A: 0xff},
+		},
+		{
+			ID:     testhelper.MkID("xterm bad index"),
+			ExpErr: testhelper.MkExpErr(`bad colour name: "256"`),
+			name:   "xterm:256",
+		},
+	}
+
+	for _, tc := range testCases {
+		nc, err := ParseNamedColour(nil, tc.name)
+		testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc)
+
+		if err == nil {
+			colourtesthelper.DiffRGBA(t, tc.IDStr(), "colour",
+				nc.Colour(), tc.expColour)
+		}
+	}
+
+	for _, tc := range []struct {
+		f        Family
+		expCount int
+	}{
+		{f: XTerm256Colours, expCount: 247},
+		{f: ANSI16Colours, expCount: 16},
+	} {
+		n, err := tc.f.DistinctColourCount()
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		testhelper.DiffInt(t, tc.f.String(), "distinct colours",
+			n, tc.expCount)
+	}
+}
+
+func TestXTerm256Tables(t *testing.T) {
+	testhelper.DiffInt(t, "xterm", "colour count",
+		len(xterm256ColoursByNum), xtermColourCount)
+	testhelper.DiffInt(t, "xterm", "ANSI palette size",
+		len(ansi16Palette.colours), ansiColourCount)
+	testhelper.DiffInt(t, "xterm", "fixed palette size",
+		len(xtermFixedPalette.colours), xtermColourCount-xtermCubeStart)
+
+	for i := range ansiColourCount {
+		testhelper.DiffInt(t, "cgaToANSI", "round trip",
+			cgaToANSI(cgaToANSI(i)), i)
+	}
+}
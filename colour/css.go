@@ -0,0 +1,1391 @@
+package colour
+
+import (
+	"errors"
+	"fmt"
+	"image/color" //nolint:misspell
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// CSSColourEvaluator evaluates CSS colour values. As well as the hex
+// ("#rgb", "#rgba", "#rrggbb" and "#rrggbbaa") and functional ("rgb()",
+// "hsl()", "hwb()", "lab()", "lch()", "oklab()", "oklch()" and "color()")
+// notations it supports colour mixing with "color-mix()", relative colour
+// syntax (such as "oklch(from #3366ff calc(l + 0.1) c h)") with "calc()",
+// "min()", "max()" and "clamp()" expressions and custom properties (such as
+// "var(--brand)"). Any other colour is found using ParseNamedColour with
+// the Families so, for instance, "x11:teal" can be used as a colour.
+//
+// The result is mapped into the sRGB gamut using the CSS gamut mapping
+// algorithm, which reduces the chroma in the Oklch colour space until the
+// colour is in gamut.
+type CSSColourEvaluator struct {
+	// Families gives the colour families used to find named colours. If it
+	// is empty the standard families are used.
+	Families Families
+	// Vars maps the names of custom properties (such as "--brand") to
+	// their values. It is used to resolve "var()" references.
+	Vars map[string]string
+	// SystemTheme gives the colours of the CSS system colours (such as
+	// "Canvas" and "CanvasText"). If it is nil the light theme is used.
+	SystemTheme CSSSystemTheme
+}
+
+// EvalCSSColour evaluates the CSS colour value using a CSSColourEvaluator
+// with the given families and no custom properties.
+//
+//nolint:misspell
+func EvalCSSColour(fl Families, s string) (color.RGBA, error) {
+	return CSSColourEvaluator{Families: fl}.Eval(s)
+}
+
+// Eval evaluates the CSS colour value and returns the resulting colour. It
+// returns a non-nil error if the value cannot be evaluated.
+//
+//nolint:misspell
+func (e CSSColourEvaluator) Eval(s string) (color.RGBA, error) {
+	cc, err := e.eval(s)
+	if err != nil {
+		return rgba{}, fmt.Errorf("bad CSS colour %q: %w", s, err)
+	}
+
+	return cc.toRGBA(), nil
+}
+
+// eval evaluates the CSS colour value
+func (e CSSColourEvaluator) eval(s string) (cssColour, error) {
+	s, err := e.substituteVars(s, 0)
+	if err != nil {
+		return cssColour{}, err
+	}
+
+	toks, err := cssTokenise(s)
+	if err != nil {
+		return cssColour{}, err
+	}
+
+	p := &cssParser{e: e, src: s, toks: toks}
+
+	cc, err := p.parseColour()
+	if err != nil {
+		return cssColour{}, err
+	}
+
+	if t := p.peek(); t.kind != cssEOF {
+		return cssColour{}, fmt.Errorf("unexpected %q after the colour",
+			s[t.start:])
+	}
+
+	return cc, nil
+}
+
+// maxVarDepth is the greatest depth to which var() references will be
+// resolved
+const maxVarDepth = 20
+
+// substituteVars replaces any "var()" references in the string with the
+// value of the custom property (or the fallback value if the property is
+// not set).
+func (e CSSColourEvaluator) substituteVars(s string, depth int) (
+	string, error,
+) {
+	const varIntro = "var("
+
+	for {
+		idx := strings.Index(strings.ToLower(s), varIntro)
+		if idx < 0 {
+			return s, nil
+		}
+
+		if depth >= maxVarDepth {
+			return "",
+				errors.New("too many nested var() references, " +
+					"there may be a reference loop")
+		}
+
+		argStart := idx + len(varIntro)
+
+		argEnd, err := matchingParen(s, argStart)
+		if err != nil {
+			return "", err
+		}
+
+		name, fallback, hasFallback := strings.Cut(s[argStart:argEnd], ",")
+		name = strings.TrimSpace(name)
+
+		if !strings.HasPrefix(name, "--") {
+			return "", fmt.Errorf("bad var() name %q, it must start with --",
+				name)
+		}
+
+		val, ok := e.Vars[name]
+		if !ok {
+			if !hasFallback {
+				return "", fmt.Errorf("the custom property %q is not set", name)
+			}
+
+			val = fallback
+		}
+
+		val, err = e.substituteVars(val, depth+1)
+		if err != nil {
+			return "", err
+		}
+
+		s = s[:idx] + strings.TrimSpace(val) + s[argEnd+1:]
+	}
+}
+
+// matchingParen returns the index of the closing parenthesis matching an
+// opening parenthesis just before the start position
+func matchingParen(s string, start int) (int, error) {
+	depth := 1
+
+	for i := start; i < len(s); i++ {
+		switch s[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i, nil
+			}
+		}
+	}
+
+	return 0, errors.New("missing closing parenthesis")
+}
+
+// cssTokenKind records the type of a cssToken
+type cssTokenKind int
+
+// These are the kinds of token
+const (
+	cssEOF cssTokenKind = iota
+	cssIdent
+	cssFunction
+	cssNumber
+	cssPercentage
+	cssDimension
+	cssHash
+	cssComma
+	cssSlash
+	cssOpenParen
+	cssCloseParen
+	cssDelim
+)
+
+// cssToken is a token from a CSS colour value
+type cssToken struct {
+	kind cssTokenKind
+	// text is the (lower-cased) identifier, function name, hash value,
+	// dimension unit or delimiter character
+	text string
+	// num is the value of a number, percentage or dimension
+	num float64
+	// start and end give the position of the token in the source
+	start, end int
+}
+
+// isCSSNameRune returns true if the rune can be part of an identifier. As
+// well as the characters allowed in CSS identifiers this allows the
+// characters used in colour names and family-qualified colour names.
+func isCSSNameRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) ||
+		strings.ContainsRune("-_:'&", r) || r >= utf8.RuneSelf
+}
+
+// startsCSSNumber returns true if a number starts at the given position
+func startsCSSNumber(s string, i int) bool {
+	isDigit := func(j int) bool {
+		return j < len(s) && s[j] >= '0' && s[j] <= '9'
+	}
+
+	if s[i] == '+' || s[i] == '-' {
+		i++
+	}
+
+	if isDigit(i) {
+		return true
+	}
+
+	return i < len(s) && s[i] == '.' && isDigit(i+1)
+}
+
+// startsCSSName returns true if an identifier starts at the given position
+func startsCSSName(s string, i int) bool {
+	r, _ := utf8.DecodeRuneInString(s[i:])
+	if r == '-' {
+		if i+1 >= len(s) {
+			return false
+		}
+
+		r, _ = utf8.DecodeRuneInString(s[i+1:])
+
+		return r == '-' || unicode.IsLetter(r) || r == '_'
+	}
+
+	return unicode.IsLetter(r) || r == '_' || r >= utf8.RuneSelf
+}
+
+// scanCSSName returns the end of the identifier starting at the given
+// position
+func scanCSSName(s string, i int) int {
+	for i < len(s) {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if !isCSSNameRune(r) {
+			break
+		}
+
+		i += size
+	}
+
+	return i
+}
+
+// scanCSSNumber returns the end of the number starting at the given
+// position
+func scanCSSNumber(s string, i int) int {
+	digits := func() {
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+	}
+
+	if s[i] == '+' || s[i] == '-' {
+		i++
+	}
+
+	digits()
+
+	if i+1 < len(s) && s[i] == '.' && s[i+1] >= '0' && s[i+1] <= '9' {
+		i++
+		digits()
+	}
+
+	if i+1 < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		j := i + 1
+		if j < len(s) && (s[j] == '+' || s[j] == '-') {
+			j++
+		}
+
+		if j < len(s) && s[j] >= '0' && s[j] <= '9' {
+			i = j
+			digits()
+		}
+	}
+
+	return i
+}
+
+// cssTokenise splits the CSS colour value into tokens
+func cssTokenise(s string) ([]cssToken, error) {
+	toks := []cssToken{}
+	i := 0
+
+	for i < len(s) {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if unicode.IsSpace(r) {
+			i += size
+			continue
+		}
+
+		tok := cssToken{start: i}
+
+		switch {
+		case startsCSSNumber(s, i):
+			end := scanCSSNumber(s, i)
+
+			v, err := strconv.ParseFloat(s[i:end], 64)
+			if err != nil {
+				return nil, fmt.Errorf("bad number %q: %w", s[i:end], err)
+			}
+
+			tok.kind, tok.num = cssNumber, v
+
+			switch {
+			case end < len(s) && s[end] == '%':
+				tok.kind = cssPercentage
+				end++
+			case end < len(s) && startsCSSName(s, end):
+				unitEnd := scanCSSName(s, end)
+				tok.kind = cssDimension
+				tok.text = strings.ToLower(s[end:unitEnd])
+				end = unitEnd
+			}
+
+			i = end
+		case startsCSSName(s, i):
+			end := scanCSSName(s, i)
+			tok.kind, tok.text = cssIdent, strings.ToLower(s[i:end])
+
+			if end < len(s) && s[end] == '(' {
+				tok.kind = cssFunction
+				end++
+			}
+
+			i = end
+		case r == '#':
+			end := scanCSSName(s, i+1)
+			tok.kind, tok.text = cssHash, s[i+1:end]
+			i = end
+		default:
+			switch r {
+			case ',':
+				tok.kind = cssComma
+			case '/':
+				tok.kind = cssSlash
+			case '(':
+				tok.kind = cssOpenParen
+			case ')':
+				tok.kind = cssCloseParen
+			case '+', '-', '*':
+				tok.kind, tok.text = cssDelim, string(r)
+			default:
+				return nil, fmt.Errorf("unexpected character %q", r)
+			}
+
+			i += size
+		}
+
+		tok.end = i
+		toks = append(toks, tok)
+	}
+
+	return toks, nil
+}
+
+// cssColour is a colour in one of the CSS colour spaces
+type cssColour struct {
+	space *cssSpace
+	// vals holds the components in the units of the colour space
+	vals [3]float64
+	// missing records the components with the value "none"
+	missing [3]bool
+	alpha   float64
+	// alphaMissing records whether the alpha value is "none"
+	alphaMissing bool
+}
+
+// resolved returns the components with any missing values set to zero
+func (cc cssColour) resolved() [3]float64 {
+	v := cc.vals
+
+	for i, m := range cc.missing {
+		if m {
+			v[i] = 0
+		}
+	}
+
+	return v
+}
+
+// resolvedAlpha returns the alpha value, clamped to the range [0, 1], or
+// zero if it is missing
+func (cc cssColour) resolvedAlpha() float64 {
+	if cc.alphaMissing {
+		return 0
+	}
+
+	return min(max(cc.alpha, 0), 1)
+}
+
+// to returns the colour converted into the given colour space. If the
+// space has a hue and the colour is neutral the hue is marked as missing.
+func (cc cssColour) to(space *cssSpace) cssColour {
+	if cc.space == space {
+		return cc
+	}
+
+	out := cssColour{
+		space:        space,
+		vals:         space.fromXYZ(cc.space.toXYZ(cc.resolved())),
+		alpha:        cc.alpha,
+		alphaMissing: cc.alphaMissing,
+	}
+
+	if space.polar() {
+		out.missing[space.hue] = space.isAchromatic(out.vals) ||
+			(cc.space.polar() && cc.missing[cc.space.hue])
+	}
+
+	return out
+}
+
+// These constants are used by the CSS gamut mapping algorithm
+const (
+	// gamutJND is the just noticeable difference in Oklab below which a
+	// clipped colour is accepted
+	gamutJND = 0.02
+	// gamutEpsilon gives the precision of the chroma search
+	gamutEpsilon = 0.0001
+	// inGamutTolerance allows for rounding errors when checking whether a
+	// colour is in the sRGB gamut
+	inGamutTolerance = 1e-6
+)
+
+// inSRGBGamut returns true if the sRGB values are all in the range [0, 1]
+func inSRGBGamut(v [3]float64) bool {
+	for _, x := range v {
+		if x < -inGamutTolerance || x > 1+inGamutTolerance {
+			return false
+		}
+	}
+
+	return true
+}
+
+// clipSRGB returns the sRGB values clipped to the range [0, 1]
+func clipSRGB(v [3]float64) [3]float64 {
+	for i, x := range v {
+		v[i] = min(max(x, 0), 1)
+	}
+
+	return v
+}
+
+// deltaEOK returns the Euclidean distance between the two colours (given
+// as XYZ values) in the Oklab colour space
+func deltaEOK(xyz1, xyz2 [3]float64) float64 {
+	ok1, ok2 := makeXYZ(xyz1).Oklab(), makeXYZ(xyz2).Oklab()
+
+	return math.Sqrt((ok1.L-ok2.L)*(ok1.L-ok2.L) +
+		(ok1.A-ok2.A)*(ok1.A-ok2.A) +
+		(ok1.B-ok2.B)*(ok1.B-ok2.B))
+}
+
+// gamutMapSRGB returns the gamma-encoded sRGB values of the colour (given
+// as an XYZ value), mapped into the sRGB gamut using the CSS Color 4 gamut
+// mapping algorithm
+func gamutMapSRGB(xyz [3]float64) [3]float64 {
+	srgb := cssSRGB.fromXYZ(xyz)
+	if inSRGBGamut(srgb) {
+		return clipSRGB(srgb)
+	}
+
+	lch := cssOklch.fromXYZ(xyz)
+	if lch[0] >= 1 {
+		return [3]float64{1, 1, 1}
+	}
+
+	if lch[0] <= 0 {
+		return [3]float64{}
+	}
+
+	clipped := clipSRGB(srgb)
+	if deltaEOK(cssSRGB.toXYZ(clipped), xyz) < gamutJND {
+		return clipped
+	}
+
+	lo, hi := 0.0, lch[1]
+	loInGamut := true
+
+	for hi-lo > gamutEpsilon {
+		lch[1] = (lo + hi) / 2 //nolint:mnd
+		current := cssOklch.toXYZ(lch)
+		srgb = cssSRGB.fromXYZ(current)
+
+		if loInGamut && inSRGBGamut(srgb) {
+			lo = lch[1]
+			continue
+		}
+
+		clipped = clipSRGB(srgb)
+
+		e := deltaEOK(cssSRGB.toXYZ(clipped), current)
+		if e >= gamutJND {
+			hi = lch[1]
+			continue
+		}
+
+		if gamutJND-e < gamutEpsilon {
+			return clipped
+		}
+
+		loInGamut = false
+		lo = lch[1]
+	}
+
+	return clipSRGB(cssSRGB.fromXYZ(cssOklch.toXYZ(lch)))
+}
+
+// toRGBA returns the colour as an sRGB colour, gamut mapped if necessary
+func (cc cssColour) toRGBA() rgba {
+	var srgb [3]float64
+	if cc.space == cssSRGB {
+		srgb = clipSRGB(cc.resolved())
+	} else {
+		srgb = gamutMapSRGB(cc.space.toXYZ(cc.resolved()))
+	}
+
+	return rgba{
+		R: toUint8(srgb[0] * math.MaxUint8),
+		G: toUint8(srgb[1] * math.MaxUint8),
+		B: toUint8(srgb[2] * math.MaxUint8),
+		A: toUint8(cc.resolvedAlpha() * math.MaxUint8),
+	}
+}
+
+// rgbaToCSSColour returns the RGBA colour as a colour in the sRGB space
+func rgbaToCSSColour(c color.RGBA) cssColour { //nolint:misspell
+	r, g, b := rgbNormalised(c)
+
+	return cssColour{
+		space: cssSRGB,
+		vals:  [3]float64{r, g, b},
+		alpha: float64(c.A) / math.MaxUint8,
+	}
+}
+
+// cssParser holds the state of the parsing of a CSS colour value
+type cssParser struct {
+	e    CSSColourEvaluator
+	src  string
+	toks []cssToken
+	pos  int
+}
+
+// peek returns the next token without consuming it
+func (p *cssParser) peek() cssToken {
+	if p.pos >= len(p.toks) {
+		return cssToken{kind: cssEOF, start: len(p.src), end: len(p.src)}
+	}
+
+	return p.toks[p.pos]
+}
+
+// next consumes and returns the next token
+func (p *cssParser) next() cssToken {
+	t := p.peek()
+	if t.kind != cssEOF {
+		p.pos++
+	}
+
+	return t
+}
+
+// describe returns a description of the token for use in error messages
+func (p *cssParser) describe(t cssToken) string {
+	if t.kind == cssEOF {
+		return "the end of the value"
+	}
+
+	return fmt.Sprintf("%q", p.src[t.start:t.end])
+}
+
+// expect consumes the next token, returning an error if it is not of the
+// given kind
+func (p *cssParser) expect(kind cssTokenKind, what string) error {
+	if t := p.next(); t.kind != kind {
+		return fmt.Errorf("expected %s, found %s", what, p.describe(t))
+	}
+
+	return nil
+}
+
+// expectClose consumes a closing parenthesis, returning an error if the
+// next token is anything else
+func (p *cssParser) expectClose(fn string) error {
+	return p.expect(cssCloseParen, "\")\" to end "+fn+"()")
+}
+
+// parseColour parses a colour
+func (p *cssParser) parseColour() (cssColour, error) {
+	t := p.next()
+
+	switch t.kind {
+	case cssHash:
+		return parseCSSHex(t.text)
+	case cssFunction:
+		return p.parseFunction(t.text)
+	case cssIdent:
+		if t.text == "transparent" {
+			return cssColour{space: cssSRGB}, nil
+		}
+
+		if c, ok := p.systemColour(t.text); ok {
+			return rgbaToCSSColour(c), nil
+		}
+
+		return p.namedColour(p.src[t.start:t.end])
+	}
+
+	return cssColour{}, fmt.Errorf("expected a colour, found %s", p.describe(t))
+}
+
+// systemColour returns the colour of the named CSS system colour and true
+// or false if the name (which must be in lower case) is not that of a
+// system colour
+func (p *cssParser) systemColour(name string) (rgba, bool) {
+	return p.e.SystemTheme.colour(name)
+}
+
+// namedColour returns the named colour
+func (p *cssParser) namedColour(name string) (cssColour, error) {
+	nc, err := NamedColourParser{
+		Families:    p.e.Families,
+		SystemTheme: p.e.SystemTheme,
+	}.Parse(name)
+	if err != nil {
+		return cssColour{}, err
+	}
+
+	return rgbaToCSSColour(nc.Colour()), nil
+}
+
+// parseCSSHex parses the hexadecimal digits of a CSS hex colour
+func parseCSSHex(digits string) (cssColour, error) {
+	var vals []float64
+
+	switch len(digits) {
+	case 3, 4: //nolint:mnd
+		for i := range len(digits) {
+			v, err := strconv.ParseUint(digits[i:i+1], 16, 8)
+			if err != nil {
+				return cssColour{}, fmt.Errorf("bad hex colour #%s", digits)
+			}
+
+			vals = append(vals, float64(v*0x11)/math.MaxUint8)
+		}
+	case 6, 8: //nolint:mnd
+		for i := 0; i < len(digits); i += 2 {
+			v, err := strconv.ParseUint(digits[i:i+2], 16, 8)
+			if err != nil {
+				return cssColour{}, fmt.Errorf("bad hex colour #%s", digits)
+			}
+
+			vals = append(vals, float64(v)/math.MaxUint8)
+		}
+	default:
+		return cssColour{},
+			fmt.Errorf("bad hex colour #%s, it must have 3, 4, 6 or 8 digits",
+				digits)
+	}
+
+	cc := cssColour{
+		space: cssSRGB,
+		vals:  [3]float64{vals[0], vals[1], vals[2]},
+		alpha: 1,
+	}
+
+	if len(vals) == 4 { //nolint:mnd
+		cc.alpha = vals[3]
+	}
+
+	return cc, nil
+}
+
+// cssFunc describes one of the CSS colour functions
+type cssFunc struct {
+	space *cssSpace
+	// scale converts the components from the units of the colour space to
+	// the units used by the function
+	scale float64
+	// legacy is true if the function allows the comma-separated syntax
+	legacy bool
+}
+
+// cssFuncs maps the names of the CSS colour functions (other than color()
+// and color-mix()) to their descriptions
+var cssFuncs = map[string]cssFunc{
+	"rgb":   {space: cssSRGB, scale: math.MaxUint8, legacy: true},
+	"rgba":  {space: cssSRGB, scale: math.MaxUint8, legacy: true},
+	"hsl":   {space: cssHSL, scale: 1, legacy: true},
+	"hsla":  {space: cssHSL, scale: 1, legacy: true},
+	"hwb":   {space: cssHWB, scale: 1},
+	"lab":   {space: cssLab, scale: 1},
+	"lch":   {space: cssLCh, scale: 1},
+	"oklab": {space: cssOklab, scale: 1},
+	"oklch": {space: cssOklch, scale: 1},
+}
+
+// parseFunction parses the arguments of a colour function
+func (p *cssParser) parseFunction(name string) (cssColour, error) {
+	switch name {
+	case "color-mix":
+		return p.parseColorMix()
+	case "color":
+		return p.parseColorFunc()
+	}
+
+	fn, ok := cssFuncs[name]
+	if !ok {
+		return cssColour{}, fmt.Errorf("unknown colour function %q", name+"()")
+	}
+
+	origin, relative, err := p.parseFrom(fn.space)
+	if err != nil {
+		return cssColour{}, err
+	}
+
+	return p.parseComponents(name, fn, origin, relative)
+}
+
+// parseColorFunc parses the arguments of the color() function
+func (p *cssParser) parseColorFunc() (cssColour, error) {
+	origin, relative, err := p.parseFrom(nil)
+	if err != nil {
+		return cssColour{}, err
+	}
+
+	t := p.next()
+	if t.kind != cssIdent {
+		return cssColour{},
+			fmt.Errorf("expected a colour space name, found %s", p.describe(t))
+	}
+
+	space, ok := cssSpaces[t.text]
+	if !ok || !space.predefined() {
+		return cssColour{},
+			fmt.Errorf("unknown color() colour space %q, it should be one of: %s",
+				t.text, cssSpaceNames(cssPredefinedSpaces))
+	}
+
+	if relative {
+		origin = origin.to(space)
+	}
+
+	return p.parseComponents("color", cssFunc{space: space, scale: 1},
+		origin, relative)
+}
+
+// predefined returns true if the space can be used in the color() function
+func (cs *cssSpace) predefined() bool {
+	for _, name := range cssPredefinedSpaces {
+		if cssSpaces[name] == cs {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseFrom parses the "from <colour>" which introduces a relative colour,
+// if present. If a space is given the origin colour is converted into it.
+func (p *cssParser) parseFrom(space *cssSpace) (cssColour, bool, error) {
+	if t := p.peek(); t.kind != cssIdent || t.text != "from" {
+		return cssColour{}, false, nil
+	}
+
+	p.next()
+
+	origin, err := p.parseColour()
+	if err != nil {
+		return cssColour{}, false, err
+	}
+
+	if space != nil {
+		origin = origin.to(space)
+	}
+
+	return origin, true, nil
+}
+
+// cssContext holds the values of the channel keywords of a relative colour
+// and the reference value of the component being parsed
+type cssContext struct {
+	keywords map[string]float64
+	// ref is the value (in the function's units) corresponding to 100%
+	ref float64
+	// isHue is true if the component is a hue angle
+	isHue bool
+}
+
+// parseComponents parses the components and the optional alpha value of a
+// colour function and the closing parenthesis
+func (p *cssParser) parseComponents(
+	name string, fn cssFunc, origin cssColour, relative bool,
+) (
+	cssColour, error,
+) {
+	space := fn.space
+	cc := cssColour{space: space, alpha: 1}
+
+	keywords := map[string]float64{}
+
+	if relative {
+		vals := origin.resolved()
+		for i, ch := range space.channels {
+			if i == space.hue {
+				keywords[ch] = vals[i]
+			} else {
+				keywords[ch] = vals[i] * fn.scale
+			}
+		}
+
+		keywords["alpha"] = origin.resolvedAlpha()
+	}
+
+	commas := false
+
+	for i := range space.channels {
+		if i > 0 && p.peek().kind == cssComma {
+			if !fn.legacy || relative || (i > 1 && !commas) {
+				return cssColour{},
+					fmt.Errorf("unexpected comma in %s()", name)
+			}
+
+			commas = true
+
+			p.next()
+		} else if i > 1 && commas {
+			return cssColour{},
+				fmt.Errorf("expected a comma between the %s() components", name)
+		}
+
+		ctx := cssContext{
+			keywords: keywords,
+			ref:      space.ref[i] * fn.scale,
+			isHue:    i == space.hue,
+		}
+
+		v, missing, err := p.parseComponent(ctx)
+		if err != nil {
+			return cssColour{}, fmt.Errorf("bad %s() component %q: %w",
+				name, space.channels[i], err)
+		}
+
+		cc.missing[i] = missing
+		if i == space.hue {
+			cc.vals[i] = normaliseHue(v)
+		} else {
+			cc.vals[i] = v / fn.scale
+		}
+	}
+
+	if err := p.parseAlpha(name, &cc, keywords, commas); err != nil {
+		return cssColour{}, err
+	}
+
+	if err := p.expectClose(name); err != nil {
+		return cssColour{}, err
+	}
+
+	return cc, nil
+}
+
+// parseAlpha parses the optional alpha value of a colour function
+func (p *cssParser) parseAlpha(
+	name string, cc *cssColour, keywords map[string]float64, commas bool,
+) error {
+	sep := p.peek().kind
+	if (sep == cssComma && !commas) || (sep == cssSlash && commas) {
+		return fmt.Errorf("unexpected %s in %s()", p.describe(p.peek()), name)
+	}
+
+	if sep != cssComma && sep != cssSlash {
+		if alpha, ok := keywords["alpha"]; ok {
+			cc.alpha = alpha
+		}
+
+		return nil
+	}
+
+	p.next()
+
+	v, missing, err := p.parseComponent(
+		cssContext{keywords: keywords, ref: 1})
+	if err != nil {
+		return fmt.Errorf("bad %s() alpha value: %w", name, err)
+	}
+
+	cc.alpha, cc.alphaMissing = v, missing
+
+	return nil
+}
+
+// parseComponent parses a single component value: a number, a percentage,
+// an angle, "none", a channel keyword or a calc() expression. It returns
+// the value (in the function's units, with angles in degrees) and whether
+// the value is missing ("none").
+func (p *cssParser) parseComponent(ctx cssContext) (float64, bool, error) {
+	if t := p.peek(); t.kind == cssIdent && t.text == "none" {
+		p.next()
+		return 0, true, nil
+	}
+
+	v, err := p.parseValue(ctx)
+
+	return v, false, err
+}
+
+// angleUnits maps the CSS angle units to the number of degrees in one unit
+var angleUnits = map[string]float64{
+	"deg":  1,
+	"grad": 0.9,           //nolint:mnd
+	"rad":  180 / math.Pi, //nolint:mnd
+	"turn": 360,           //nolint:mnd
+}
+
+// parseValue parses a single numeric value: a number, a percentage, an
+// angle, a channel keyword, a constant or a math function
+func (p *cssParser) parseValue(ctx cssContext) (float64, error) {
+	t := p.next()
+
+	switch t.kind {
+	case cssNumber:
+		return t.num, nil
+	case cssPercentage:
+		if ctx.isHue {
+			return 0, errors.New("a hue cannot be a percentage")
+		}
+
+		return t.num * ctx.ref / 100, nil //nolint:mnd
+	case cssDimension:
+		if scale, ok := angleUnits[t.text]; ok && ctx.isHue {
+			return t.num * scale, nil
+		}
+
+		return 0, fmt.Errorf("unexpected unit %q", t.text)
+	case cssIdent:
+		if v, ok := ctx.keywords[t.text]; ok {
+			return v, nil
+		}
+
+		switch t.text {
+		case "pi":
+			return math.Pi, nil
+		case "e":
+			return math.E, nil
+		}
+
+		return 0, fmt.Errorf("unknown keyword %q", t.text)
+	case cssFunction:
+		return p.parseMathFunction(t.text, ctx)
+	case cssOpenParen:
+		return p.parseParenthesised(ctx)
+	}
+
+	return 0, fmt.Errorf("expected a value, found %s", p.describe(t))
+}
+
+// parseParenthesised parses an expression and the closing parenthesis
+func (p *cssParser) parseParenthesised(ctx cssContext) (float64, error) {
+	v, err := p.parseSum(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return v, p.expect(cssCloseParen, "\")\"")
+}
+
+// parseMathFunction parses the arguments of one of the CSS math functions
+func (p *cssParser) parseMathFunction(name string, ctx cssContext) (
+	float64, error,
+) {
+	var args []float64
+
+	for {
+		v, err := p.parseSum(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("bad %s() argument: %w", name, err)
+		}
+
+		args = append(args, v)
+
+		if p.peek().kind != cssComma {
+			break
+		}
+
+		p.next()
+	}
+
+	if err := p.expectClose(name); err != nil {
+		return 0, err
+	}
+
+	switch name {
+	case "calc":
+		if len(args) == 1 {
+			return args[0], nil
+		}
+	case "min":
+		return slices.Min(args), nil
+	case "max":
+		return slices.Max(args), nil
+	case "clamp":
+		if len(args) == 3 { //nolint:mnd
+			return max(args[0], min(args[1], args[2])), nil
+		}
+	case "abs":
+		if len(args) == 1 {
+			return math.Abs(args[0]), nil
+		}
+	default:
+		return 0, fmt.Errorf("unknown function %q", name+"()")
+	}
+
+	return 0, fmt.Errorf("wrong number of arguments (%d) for %s()",
+		len(args), name)
+}
+
+// parseSum parses an expression of terms separated by "+" or "-"
+func (p *cssParser) parseSum(ctx cssContext) (float64, error) {
+	v, err := p.parseProduct(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for {
+		t := p.peek()
+		if t.kind != cssDelim || (t.text != "+" && t.text != "-") {
+			return v, nil
+		}
+
+		p.next()
+
+		rhs, err := p.parseProduct(ctx)
+		if err != nil {
+			return 0, err
+		}
+
+		if t.text == "+" {
+			v += rhs
+		} else {
+			v -= rhs
+		}
+	}
+}
+
+// parseProduct parses an expression of values separated by "*" or "/"
+func (p *cssParser) parseProduct(ctx cssContext) (float64, error) {
+	v, err := p.parseValue(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for {
+		t := p.peek()
+
+		switch {
+		case t.kind == cssDelim && t.text == "*":
+			p.next()
+
+			rhs, err := p.parseValue(ctx)
+			if err != nil {
+				return 0, err
+			}
+
+			v *= rhs
+		case t.kind == cssSlash:
+			p.next()
+
+			rhs, err := p.parseValue(ctx)
+			if err != nil {
+				return 0, err
+			}
+
+			if rhs == 0 {
+				return 0, errors.New("division by zero")
+			}
+
+			v /= rhs
+		default:
+			return v, nil
+		}
+	}
+}
+
+// hueMethod identifies how hues are interpolated when mixing colours in a
+// colour space with a hue component
+type hueMethod string
+
+// These are the CSS hue interpolation methods
+const (
+	hueShorter    hueMethod = "shorter"
+	hueLonger     hueMethod = "longer"
+	hueIncreasing hueMethod = "increasing"
+	hueDecreasing hueMethod = "decreasing"
+)
+
+// fixup adjusts the two hues (in degrees, in the range [0, 360)) so that
+// interpolating between them goes the required way around the hue circle
+func (hm hueMethod) fixup(h1, h2 float64) (float64, float64) {
+	const halfTurn, fullTurn = 180, 360
+
+	d := h2 - h1
+
+	switch hm {
+	case hueShorter:
+		if d > halfTurn {
+			h1 += fullTurn
+		} else if d < -halfTurn {
+			h2 += fullTurn
+		}
+	case hueLonger:
+		if d > 0 && d < halfTurn {
+			h1 += fullTurn
+		} else if d > -halfTurn && d <= 0 {
+			h2 += fullTurn
+		}
+	case hueIncreasing:
+		if d < 0 {
+			h2 += fullTurn
+		}
+	case hueDecreasing:
+		if d > 0 {
+			h1 += fullTurn
+		}
+	}
+
+	return h1, h2
+}
+
+// mixOperand is a colour and its (optional) percentage in a color-mix()
+type mixOperand struct {
+	colour cssColour
+	pct    float64
+	hasPct bool
+}
+
+// parseColorMix parses the arguments of the color-mix() function
+func (p *cssParser) parseColorMix() (cssColour, error) {
+	const fn = "color-mix"
+
+	space := cssOklab
+	hm := hueShorter
+
+	if t := p.peek(); t.kind == cssIdent && t.text == "in" {
+		p.next()
+
+		t = p.next()
+
+		var ok bool
+
+		if space, ok = cssSpaces[t.text]; t.kind != cssIdent || !ok {
+			return cssColour{},
+				fmt.Errorf("bad color-mix() colour space %s,"+
+					" it should be one of: %s",
+					p.describe(t), cssSpaceNames(mapKeys(cssSpaces)))
+		}
+
+		if t := p.peek(); t.kind == cssIdent && t.text != "hue" {
+			if !space.polar() {
+				return cssColour{},
+					fmt.Errorf("a hue interpolation method cannot be used"+
+						" with the %q colour space", space.name)
+			}
+
+			hm = hueMethod(p.next().text)
+			switch hm {
+			case hueShorter, hueLonger, hueIncreasing, hueDecreasing:
+			default:
+				return cssColour{},
+					fmt.Errorf("bad hue interpolation method %q", hm)
+			}
+
+			if err := p.expect(cssIdent, "\"hue\""); err != nil {
+				return cssColour{}, err
+			}
+		}
+
+		if err := p.expect(cssComma, "\",\""); err != nil {
+			return cssColour{}, err
+		}
+	}
+
+	op1, err := p.parseMixOperand()
+	if err != nil {
+		return cssColour{}, err
+	}
+
+	if err := p.expect(cssComma, "\",\" between the colours"); err != nil {
+		return cssColour{}, err
+	}
+
+	op2, err := p.parseMixOperand()
+	if err != nil {
+		return cssColour{}, err
+	}
+
+	if err := p.expectClose(fn); err != nil {
+		return cssColour{}, err
+	}
+
+	return mixCSSColours(space, hm, op1, op2)
+}
+
+// mapKeys returns the keys of the map
+func mapKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	return keys
+}
+
+// parseMixOperand parses a colour and an optional percentage (which may
+// come before or after the colour). If the colour is not a single valid
+// colour then all the tokens up to the percentage or the end of the
+// operand are taken as a colour name; this allows for names containing
+// spaces.
+func (p *cssParser) parseMixOperand() (mixOperand, error) {
+	var op mixOperand
+
+	parsePct := func() error {
+		if t := p.peek(); t.kind == cssPercentage {
+			if op.hasPct {
+				return errors.New("a color-mix() colour has two percentages")
+			}
+
+			p.next()
+
+			if t.num < 0 || t.num > 100 {
+				return fmt.Errorf(
+					"the color-mix() percentage (%g%%) must be between 0%% and 100%%",
+					t.num)
+			}
+
+			op.pct, op.hasPct = t.num, true
+		}
+
+		return nil
+	}
+
+	if err := parsePct(); err != nil {
+		return op, err
+	}
+
+	start := p.pos
+
+	var err error
+
+	op.colour, err = p.parseColour()
+	if err != nil || !p.atOperandEnd() {
+		p.pos = start
+
+		name, ok := p.operandName()
+		if !ok {
+			if err == nil {
+				err = fmt.Errorf("unexpected %s after the colour",
+					p.describe(p.peek()))
+			}
+
+			return op, err
+		}
+
+		if op.colour, err = p.namedColour(name); err != nil {
+			return op, err
+		}
+	}
+
+	return op, parsePct()
+}
+
+// atOperandEnd returns true if the next token ends a color-mix() operand
+func (p *cssParser) atOperandEnd() bool {
+	switch p.peek().kind {
+	case cssComma, cssCloseParen, cssPercentage:
+		return true
+	}
+
+	return false
+}
+
+// operandName consumes the identifiers up to the end of the color-mix()
+// operand and returns the source text they cover. It returns false if any
+// of the tokens is not an identifier.
+func (p *cssParser) operandName() (string, bool) {
+	first := p.peek()
+	last := first
+
+	for !p.atOperandEnd() {
+		t := p.next()
+		if t.kind != cssIdent {
+			return "", false
+		}
+
+		last = t
+	}
+
+	if first.kind != cssIdent {
+		return "", false
+	}
+
+	return p.src[first.start:last.end], true
+}
+
+// mixCSSColours mixes the two colours in the colour space as specified for
+// the CSS color-mix() function
+func mixCSSColours(
+	space *cssSpace, hm hueMethod, op1, op2 mixOperand,
+) (
+	cssColour, error,
+) {
+	p1, p2 := op1.pct, op2.pct
+
+	switch {
+	case !op1.hasPct && !op2.hasPct:
+		p1, p2 = 50, 50 //nolint:mnd
+	case !op1.hasPct:
+		p1 = 100 - p2 //nolint:mnd
+	case !op2.hasPct:
+		p2 = 100 - p1 //nolint:mnd
+	}
+
+	total := p1 + p2
+	if total == 0 {
+		return cssColour{},
+			errors.New("the color-mix() percentages must not both be zero")
+	}
+
+	alphaMult := min(total/100, 1) //nolint:mnd
+	frac := p2 / total
+
+	c1, c2 := op1.colour.to(space), op2.colour.to(space)
+
+	mixed := cssColour{space: space}
+
+	a1, a2 := c1.alpha, c2.alpha
+
+	switch {
+	case c1.alphaMissing && c2.alphaMissing:
+		mixed.alphaMissing = true
+		a1, a2 = 1, 1
+	case c1.alphaMissing:
+		a1 = a2
+	case c2.alphaMissing:
+		a2 = a1
+	}
+
+	a1, a2 = min(max(a1, 0), 1), min(max(a2, 0), 1)
+	alpha := a1*(1-frac) + a2*frac
+
+	for i := range mixed.vals {
+		v1, v2 := c1.vals[i], c2.vals[i]
+
+		switch {
+		case c1.missing[i] && c2.missing[i]:
+			mixed.missing[i] = true
+			continue
+		case c1.missing[i]:
+			v1 = v2
+		case c2.missing[i]:
+			v2 = v1
+		}
+
+		if i == space.hue {
+			v1, v2 = hm.fixup(normaliseHue(v1), normaliseHue(v2))
+			mixed.vals[i] = normaliseHue(v1*(1-frac) + v2*frac)
+
+			continue
+		}
+
+		v := v1*a1*(1-frac) + v2*a2*frac
+		if alpha != 0 {
+			v /= alpha
+		}
+
+		mixed.vals[i] = v
+	}
+
+	mixed.alpha = alpha * alphaMult
+
+	return mixed, nil
+}
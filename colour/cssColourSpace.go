@@ -0,0 +1,460 @@
+package colour
+
+import (
+	"math"
+	"slices"
+	"strings"
+)
+
+// cssSpace describes one of the colour spaces used by CSS for the color()
+// function, for relative colours and for interpolating colours in
+// color-mix(). Colours are converted between spaces through CIE XYZ values
+// with a D65 white point.
+type cssSpace struct {
+	// name is the CSS name of the colour space
+	name string
+	// channels gives the names of the colour components as used in
+	// relative colour syntax
+	channels [3]string
+	// ref gives the value of each component corresponding to 100%
+	ref [3]float64
+	// hue gives the index of the hue component, it is -1 if there is no
+	// hue component
+	hue int
+	// isAchromatic reports whether the colour is neutral (and so has no
+	// meaningful hue). It is only set for spaces with a hue component
+	isAchromatic func([3]float64) bool
+	// toXYZ converts the components into XYZ values
+	toXYZ func([3]float64) [3]float64
+	// fromXYZ converts XYZ values into the components
+	fromXYZ func([3]float64) [3]float64
+}
+
+// polar returns true if the space has a hue component
+func (cs *cssSpace) polar() bool {
+	return cs.hue >= 0
+}
+
+// rgbToXYZMatrix returns the matrix which converts linear RGB values into
+// XYZ values for the RGB colour space with the given red, green and blue
+// primaries (given as CIE 1931 xy chromaticities) and white point.
+func rgbToXYZMatrix(r, g, b [2]float64, white XYZ) matrix3 {
+	primary := func(p [2]float64) [3]float64 {
+		return [3]float64{p[0] / p[1], 1, (1 - p[0] - p[1]) / p[1]}
+	}
+
+	pr, pg, pb := primary(r), primary(g), primary(b)
+	m := matrix3{
+		{pr[0], pg[0], pb[0]},
+		{pr[1], pg[1], pb[1]},
+		{pr[2], pg[2], pb[2]},
+	}
+	s := m.inverse().apply(white.vec())
+
+	return m.mul(diagonal(s))
+}
+
+// transfer returns a function which applies the transfer function to each
+// component, preserving the sign so that values outside the gamut can be
+// represented
+func transfer(f func(float64) float64) func([3]float64) [3]float64 {
+	return func(v [3]float64) [3]float64 {
+		for i, x := range v {
+			v[i] = math.Copysign(f(math.Abs(x)), x)
+		}
+
+		return v
+	}
+}
+
+// rgbSpace returns a cssSpace for an RGB colour space with the given
+// linearising transfer function (toLinear), its inverse (fromLinear) and
+// the matrix converting linear values to XYZ values
+func rgbSpace(
+	name string,
+	toLinear, fromLinear func(float64) float64,
+	toXYZ matrix3,
+) *cssSpace {
+	fromXYZ := toXYZ.inverse()
+	lin := transfer(toLinear)
+	enc := transfer(fromLinear)
+
+	return &cssSpace{
+		name:     name,
+		channels: [3]string{"r", "g", "b"},
+		ref:      [3]float64{1, 1, 1},
+		hue:      -1,
+		toXYZ: func(v [3]float64) [3]float64 {
+			return toXYZ.apply(lin(v))
+		},
+		fromXYZ: func(v [3]float64) [3]float64 {
+			return enc(fromXYZ.apply(v))
+		},
+	}
+}
+
+// identity returns its argument unchanged
+func identity(v float64) float64 { return v }
+
+// gammaFuncs returns a pair of functions which apply and remove a simple
+// power law with the given exponent
+func gammaFuncs(gamma float64) (func(float64) float64, func(float64) float64) {
+	return func(v float64) float64 { return math.Pow(v, gamma) },
+		func(v float64) float64 { return math.Pow(v, 1/gamma) }
+}
+
+// proPhotoToLinear linearises a ProPhoto RGB component value
+func proPhotoToLinear(v float64) float64 {
+	const (
+		threshold = 16.0 / 512.0
+		linScale  = 16
+		gamma     = 1.8
+	)
+
+	if v <= threshold {
+		return v / linScale
+	}
+
+	return math.Pow(v, gamma)
+}
+
+// linearToProPhoto gamma-encodes a linear ProPhoto RGB component value
+func linearToProPhoto(v float64) float64 {
+	const (
+		threshold = 1.0 / 512.0
+		linScale  = 16
+		gamma     = 1.8
+	)
+
+	if v < threshold {
+		return v * linScale
+	}
+
+	return math.Pow(v, 1/gamma)
+}
+
+// These constants are used in the ITU-R BT.2020 transfer function
+const (
+	rec2020Alpha = 1.09929682680944
+	rec2020Beta  = 0.018053968510807
+	rec2020Scale = 4.5
+	rec2020Gamma = 0.45
+)
+
+// rec2020ToLinear linearises a Rec. 2020 component value
+func rec2020ToLinear(v float64) float64 {
+	if v < rec2020Beta*rec2020Scale {
+		return v / rec2020Scale
+	}
+
+	return math.Pow((v+rec2020Alpha-1)/rec2020Alpha, 1/rec2020Gamma)
+}
+
+// linearToRec2020 gamma-encodes a linear Rec. 2020 component value
+func linearToRec2020(v float64) float64 {
+	if v < rec2020Beta {
+		return v * rec2020Scale
+	}
+
+	return rec2020Alpha*math.Pow(v, rec2020Gamma) - (rec2020Alpha - 1)
+}
+
+// xyzSpace returns a cssSpace for XYZ values with the given white point.
+// The values are adapted (using the Bradford transform) from and to the
+// D65 white point.
+func xyzSpace(name string, white XYZ) *cssSpace {
+	toD65 := mustCATMatrix(Bradford, white, WhiteD65)
+	fromD65 := mustCATMatrix(Bradford, WhiteD65, white)
+
+	return &cssSpace{
+		name:     name,
+		channels: [3]string{"x", "y", "z"},
+		ref:      [3]float64{1, 1, 1},
+		hue:      -1,
+		toXYZ:    toD65.apply,
+		fromXYZ:  fromD65.apply,
+	}
+}
+
+// srgbToHSL converts gamma-encoded sRGB values in the range [0, 1] into
+// HSL values. The hue is in degrees and the saturation and lightness are
+// in the range [0, 100]. The hue of a neutral colour is zero.
+func srgbToHSL(v [3]float64) [3]float64 {
+	r, g, b := v[0], v[1], v[2]
+	hi, lo := max(r, g, b), min(r, g, b)
+	l := (hi + lo) / 2 //nolint:mnd
+	d := hi - lo
+
+	var h, s float64
+
+	if d != 0 {
+		if l != 0 && l != 1 {
+			s = (hi - l) / min(l, 1-l)
+		}
+
+		switch hi {
+		case r:
+			h = (g - b) / d
+			if g < b {
+				h += 6
+			}
+		case g:
+			h = (b-r)/d + 2 //nolint:mnd
+		default:
+			h = (r-g)/d + 4 //nolint:mnd
+		}
+
+		h *= 60
+	}
+
+	if s < 0 {
+		h += 180
+		s = -s
+	}
+
+	return [3]float64{normaliseHue(h), s * 100, l * 100} //nolint:mnd
+}
+
+// hslToSRGB converts HSL values (as returned by srgbToHSL) into
+// gamma-encoded sRGB values
+func hslToSRGB(v [3]float64) [3]float64 {
+	h := normaliseHue(v[0])
+	s := v[1] / 100 //nolint:mnd
+	l := v[2] / 100 //nolint:mnd
+
+	f := func(n float64) float64 {
+		k := math.Mod(n+h/30, 12)              //nolint:mnd
+		a := s * min(l, 1-l)                   //nolint:mnd
+		return l - a*max(-1, min(k-3, 9-k, 1)) //nolint:mnd
+	}
+
+	return [3]float64{f(0), f(8), f(4)} //nolint:mnd
+}
+
+// srgbToHWB converts gamma-encoded sRGB values in the range [0, 1] into
+// HWB values. The hue is in degrees and the whiteness and blackness are in
+// the range [0, 100].
+func srgbToHWB(v [3]float64) [3]float64 {
+	hsl := srgbToHSL(v)
+
+	return [3]float64{
+		hsl[0],
+		min(v[0], v[1], v[2]) * 100,       //nolint:mnd
+		(1 - max(v[0], v[1], v[2])) * 100, //nolint:mnd
+	}
+}
+
+// hwbToSRGB converts HWB values (as returned by srgbToHWB) into
+// gamma-encoded sRGB values
+func hwbToSRGB(v [3]float64) [3]float64 {
+	w := v[1] / 100 //nolint:mnd
+	b := v[2] / 100 //nolint:mnd
+
+	if w+b >= 1 {
+		grey := w / (w + b)
+		return [3]float64{grey, grey, grey}
+	}
+
+	rgb := hslToSRGB([3]float64{v[0], 100, 50}) //nolint:mnd
+	for i, c := range rgb {
+		rgb[i] = c*(1-w-b) + w
+	}
+
+	return rgb
+}
+
+// normaliseHue returns the hue angle (in degrees) in the range [0, 360)
+func normaliseHue(h float64) float64 {
+	h = math.Mod(h, 360) //nolint:mnd
+	if h < 0 {
+		h += 360
+	}
+
+	return h
+}
+
+// These constants give the chroma below which a colour is taken to be
+// neutral
+const (
+	lchAchromatic   = 1e-2
+	oklchAchromatic = 4e-5
+	hslAchromatic   = 1e-3
+)
+
+// The CSS colour spaces
+//
+//nolint:mnd
+var (
+	cssSRGB = rgbSpace("srgb", srgbToLinear, linearToSRGB, linearSRGBToXYZ)
+
+	cssSRGBLinear = rgbSpace("srgb-linear", identity, identity,
+		linearSRGBToXYZ)
+
+	cssDisplayP3 = rgbSpace("display-p3", srgbToLinear, linearToSRGB,
+		rgbToXYZMatrix(
+			[2]float64{0.680, 0.320},
+			[2]float64{0.265, 0.690},
+			[2]float64{0.150, 0.060},
+			WhiteD65))
+
+	cssA98RGB = func() *cssSpace {
+		toLin, fromLin := gammaFuncs(563.0 / 256.0)
+
+		return rgbSpace("a98-rgb", toLin, fromLin,
+			rgbToXYZMatrix(
+				[2]float64{0.64, 0.33},
+				[2]float64{0.21, 0.71},
+				[2]float64{0.15, 0.06},
+				WhiteD65))
+	}()
+
+	cssProPhotoRGB = rgbSpace("prophoto-rgb",
+		proPhotoToLinear, linearToProPhoto,
+		mustCATMatrix(Bradford, WhiteD50, WhiteD65).mul(
+			rgbToXYZMatrix(
+				[2]float64{0.7347, 0.2653},
+				[2]float64{0.1596, 0.8404},
+				[2]float64{0.0366, 0.0001},
+				WhiteD50)))
+
+	cssRec2020 = rgbSpace("rec2020", rec2020ToLinear, linearToRec2020,
+		rgbToXYZMatrix(
+			[2]float64{0.708, 0.292},
+			[2]float64{0.170, 0.797},
+			[2]float64{0.131, 0.046},
+			WhiteD65))
+
+	cssXYZD65 = xyzSpace("xyz-d65", WhiteD65)
+	cssXYZD50 = xyzSpace("xyz-d50", WhiteD50)
+
+	cssHSL = &cssSpace{
+		name:     "hsl",
+		channels: [3]string{"h", "s", "l"},
+		ref:      [3]float64{0, 100, 100},
+		hue:      0,
+		isAchromatic: func(v [3]float64) bool {
+			return v[1] < hslAchromatic || v[2] <= 0 || v[2] >= 100
+		},
+		toXYZ: func(v [3]float64) [3]float64 {
+			return cssSRGB.toXYZ(hslToSRGB(v))
+		},
+		fromXYZ: func(v [3]float64) [3]float64 {
+			return srgbToHSL(cssSRGB.fromXYZ(v))
+		},
+	}
+
+	cssHWB = &cssSpace{
+		name:     "hwb",
+		channels: [3]string{"h", "w", "b"},
+		ref:      [3]float64{0, 100, 100},
+		hue:      0,
+		isAchromatic: func(v [3]float64) bool {
+			return v[1]+v[2] >= 100-hslAchromatic
+		},
+		toXYZ: func(v [3]float64) [3]float64 {
+			return cssSRGB.toXYZ(hwbToSRGB(v))
+		},
+		fromXYZ: func(v [3]float64) [3]float64 {
+			return srgbToHWB(cssSRGB.fromXYZ(v))
+		},
+	}
+
+	cssLab = &cssSpace{
+		name:     "lab",
+		channels: [3]string{"l", "a", "b"},
+		ref:      [3]float64{100, 125, 125},
+		hue:      -1,
+		toXYZ: func(v [3]float64) [3]float64 {
+			return Lab{L: v[0], A: v[1], B: v[2]}.XYZ().vec()
+		},
+		fromXYZ: func(v [3]float64) [3]float64 {
+			lab := makeXYZ(v).Lab()
+			return [3]float64{lab.L, lab.A, lab.B}
+		},
+	}
+
+	cssLCh = &cssSpace{
+		name:     "lch",
+		channels: [3]string{"l", "c", "h"},
+		ref:      [3]float64{100, 150, 0},
+		hue:      2,
+		isAchromatic: func(v [3]float64) bool {
+			return v[1] < lchAchromatic
+		},
+		toXYZ: func(v [3]float64) [3]float64 {
+			return LCh{L: v[0], C: v[1], H: v[2]}.Lab().XYZ().vec()
+		},
+		fromXYZ: func(v [3]float64) [3]float64 {
+			lch := makeXYZ(v).Lab().LCh()
+			return [3]float64{lch.L, lch.C, lch.H}
+		},
+	}
+
+	cssOklab = &cssSpace{
+		name:     "oklab",
+		channels: [3]string{"l", "a", "b"},
+		ref:      [3]float64{1, 0.4, 0.4},
+		hue:      -1,
+		toXYZ: func(v [3]float64) [3]float64 {
+			return Oklab{L: v[0], A: v[1], B: v[2]}.XYZ().vec()
+		},
+		fromXYZ: func(v [3]float64) [3]float64 {
+			ok := makeXYZ(v).Oklab()
+			return [3]float64{ok.L, ok.A, ok.B}
+		},
+	}
+
+	cssOklch = &cssSpace{
+		name:     "oklch",
+		channels: [3]string{"l", "c", "h"},
+		ref:      [3]float64{1, 0.4, 0},
+		hue:      2,
+		isAchromatic: func(v [3]float64) bool {
+			return v[1] < oklchAchromatic
+		},
+		toXYZ: func(v [3]float64) [3]float64 {
+			return Oklch{L: v[0], C: v[1], H: v[2]}.Oklab().XYZ().vec()
+		},
+		fromXYZ: func(v [3]float64) [3]float64 {
+			ok := makeXYZ(v).Oklab().Oklch()
+			return [3]float64{ok.L, ok.C, ok.H}
+		},
+	}
+)
+
+// cssSpaces maps the names of the colour spaces which can be used in
+// color-mix() to their descriptions. The names "xyz" and "xyz-d65" refer
+// to the same space.
+var cssSpaces = map[string]*cssSpace{
+	"srgb":         cssSRGB,
+	"srgb-linear":  cssSRGBLinear,
+	"display-p3":   cssDisplayP3,
+	"a98-rgb":      cssA98RGB,
+	"prophoto-rgb": cssProPhotoRGB,
+	"rec2020":      cssRec2020,
+	"xyz":          cssXYZD65,
+	"xyz-d65":      cssXYZD65,
+	"xyz-d50":      cssXYZD50,
+	"hsl":          cssHSL,
+	"hwb":          cssHWB,
+	"lab":          cssLab,
+	"lch":          cssLCh,
+	"oklab":        cssOklab,
+	"oklch":        cssOklch,
+}
+
+// cssPredefinedSpaces lists the names of the colour spaces which can be
+// used in the color() function
+var cssPredefinedSpaces = []string{
+	"srgb", "srgb-linear", "display-p3", "a98-rgb", "prophoto-rgb",
+	"rec2020", "xyz", "xyz-d65", "xyz-d50",
+}
+
+// cssSpaceNames returns the sorted names of the colour spaces in the list,
+// joined with commas
+func cssSpaceNames(names []string) string {
+	names = slices.Clone(names)
+	slices.Sort(names)
+
+	return strings.Join(names, ", ")
+}
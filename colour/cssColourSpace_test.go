@@ -0,0 +1,91 @@
+package colour
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/nickwells/colour.mod/v2/colourtesthelper"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestCSSSpaceRoundTrip(t *testing.T) {
+	colours := []rgba{
+		{A: 0xff},
+		{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+		{R: 0xff, A: 0xff},
+		{G: 0xff, A: 0xff},
+		{B: 0xff, A: 0xff},
+		{R: 0xd2, G: 0xb4, B: 0x8c, A: 0xff},
+		{R: 0x33, G: 0x66, B: 0xff, A: 0x80},
+	}
+
+	for name, space := range cssSpaces {
+		for _, c := range colours {
+			id := fmt.Sprintf("%s: %v", name, c)
+			cc := rgbaToCSSColour(c).to(space)
+			colourtesthelper.DiffRGBA(t, id, "round trip",
+				cc.to(cssSRGB).toRGBA(), c)
+		}
+	}
+}
+
+func TestCSSSpaceToXYZ(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		space  *cssSpace
+		vals   [3]float64
+		expXYZ XYZ
+	}{
+		{
+			ID:     testhelper.MkID("display-p3 red"),
+			space:  cssDisplayP3,
+			vals:   [3]float64{1, 0, 0},
+			expXYZ: XYZ{X: 0.48657, Y: 0.22897, Z: 0},
+		},
+		{
+			ID:     testhelper.MkID("rec2020 green"),
+			space:  cssRec2020,
+			vals:   [3]float64{0, 1, 0},
+			expXYZ: XYZ{X: 0.14462, Y: 0.67800, Z: 0.02807},
+		},
+		{
+			ID:     testhelper.MkID("a98-rgb white"),
+			space:  cssA98RGB,
+			vals:   [3]float64{1, 1, 1},
+			expXYZ: WhiteD65,
+		},
+		{
+			ID:     testhelper.MkID("prophoto-rgb white"),
+			space:  cssProPhotoRGB,
+			vals:   [3]float64{1, 1, 1},
+			expXYZ: WhiteD65,
+		},
+		{
+			ID:     testhelper.MkID("xyz-d50 white"),
+			space:  cssXYZD50,
+			vals:   WhiteD50.vec(),
+			expXYZ: WhiteD65,
+		},
+		{
+			ID:     testhelper.MkID("hsl blue"),
+			space:  cssHSL,
+			vals:   [3]float64{240, 100, 50},
+			expXYZ: XYZ{X: 0.18044, Y: 0.07218, Z: 0.95030},
+		},
+		{
+			ID:     testhelper.MkID("hwb grey"),
+			space:  cssHWB,
+			vals:   [3]float64{90, 60, 60},
+			expXYZ: XYZ{X: 0.20344, Y: 0.21404, Z: 0.23305},
+		},
+	}
+
+	for _, tc := range testCases {
+		xyz := makeXYZ(tc.space.toXYZ(tc.vals))
+
+		const epsilon = 0.0001
+		testhelper.DiffFloat(t, tc.IDStr(), "X", xyz.X, tc.expXYZ.X, epsilon)
+		testhelper.DiffFloat(t, tc.IDStr(), "Y", xyz.Y, tc.expXYZ.Y, epsilon)
+		testhelper.DiffFloat(t, tc.IDStr(), "Z", xyz.Z, tc.expXYZ.Z, epsilon)
+	}
+}
@@ -0,0 +1,283 @@
+package colour
+
+import (
+	"testing"
+
+	"github.com/nickwells/colour.mod/v2/colourtesthelper"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestCSSColourEvaluator(t *testing.T) {
+	e := CSSColourEvaluator{
+		Vars: map[string]string{
+			"--brand":  "#3366ff",
+			"--accent": "var(--brand)",
+			"--pct":    "40%",
+			"--loop":   "var(--loop)",
+		},
+	}
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		s         string
+		expColour rgba
+	}{
+		{
+			ID:        testhelper.MkID("hex: 3 digits"),
+			s:         "#f00",
+			expColour: rgba{R: 0xff, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("hex: 8 digits"),
+			s:         "#3366ff80",
+			expColour: rgba{R: 0x33, G: 0x66, B: 0xff, A: 0x80},
+		},
+		{
+			ID: testhelper.MkID("hex: bad"),
+			ExpErr: testhelper.MkExpErr(
+				"bad hex colour #12345, it must have 3, 4, 6 or 8 digits"),
+			s: "#12345",
+		},
+		{
+			ID:        testhelper.MkID("transparent"),
+			s:         "transparent",
+			expColour: rgba{},
+		},
+		{
+			ID:        testhelper.MkID("named colour"),
+			s:         "web:teal",
+			expColour: rgba{G: 0x80, B: 0x80, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("rgb: legacy"),
+			s:         "rgba(10, 20, 30, 0.5)",
+			expColour: rgba{R: 10, G: 20, B: 30, A: 0x80},
+		},
+		{
+			ID:        testhelper.MkID("rgb: modern"),
+			s:         "rgb(10% 20% 30% / 0.5)",
+			expColour: rgba{R: 0x1a, G: 0x33, B: 0x4d, A: 0x80},
+		},
+		{
+			ID:        testhelper.MkID("hsl"),
+			s:         "hsl(120, 100%, 25%)",
+			expColour: rgba{G: 0x80, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("hsl: angle"),
+			s:         "hsl(0.5turn 100% 50%)",
+			expColour: rgba{G: 0xff, B: 0xff, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("hwb"),
+			s:         "hwb(0 20% 20%)",
+			expColour: rgba{R: 0xcc, G: 0x33, B: 0x33, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("lab"),
+			s:         "lab(50 40 59.5)",
+			expColour: rgba{R: 0xbf, G: 0x57, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("oklch"),
+			s:         "oklch(0.7 0.15 200)",
+			expColour: rgba{G: 0xb7, B: 0xc0, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("color: srgb"),
+			s:         "color(srgb 0.5 0.5 0.5 / 50%)",
+			expColour: rgba{R: 0x80, G: 0x80, B: 0x80, A: 0x80},
+		},
+		{
+			ID:        testhelper.MkID("color: display-p3, gamut mapped"),
+			s:         "color(display-p3 1 0 0)",
+			expColour: rgba{R: 0xff, G: 0x0b, B: 0x0c, A: 0xff},
+		},
+		{
+			ID: testhelper.MkID("color: bad space"),
+			ExpErr: testhelper.MkExpErr(
+				`unknown color() colour space "lab"`),
+			s: "color(lab 1 0 0)",
+		},
+		{
+			ID:        testhelper.MkID("mix: srgb"),
+			s:         "color-mix(in srgb, red, blue)",
+			expColour: rgba{R: 0x80, B: 0x80, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("mix: default space"),
+			s:         "color-mix(red, blue)",
+			expColour: rgba{R: 0x8c, G: 0x53, B: 0xa2, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("mix: hsl, shorter hue"),
+			s:         "color-mix(in hsl, red 40%, blue)",
+			expColour: rgba{R: 0xcc, B: 0xff, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("mix: hsl, longer hue"),
+			s:         "color-mix(in hsl longer hue, red, blue)",
+			expColour: rgba{G: 0xff, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("mix: oklch, with var"),
+			s:         "color-mix(in oklch, var(--brand) var(--pct), white)",
+			expColour: rgba{R: 0xaa, G: 0xc6, B: 0xff, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("mix: lch, powerless hue"),
+			s:         "color-mix(in lch, red, white)",
+			expColour: rgba{R: 0xff, G: 0xa2, B: 0x84, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("mix: percentages under 100%"),
+			s:         "color-mix(in srgb, red 30%, blue 30%)",
+			expColour: rgba{R: 0x80, B: 0x80, A: 0x99},
+		},
+		{
+			ID:        testhelper.MkID("mix: percentage first"),
+			s:         "color-mix(in srgb, 25% red, blue)",
+			expColour: rgba{R: 0x40, B: 0xbf, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("mix: premultiplied alpha"),
+			s:         "color-mix(in srgb, rgb(255 0 0 / 0), blue)",
+			expColour: rgba{B: 0xff, A: 0x80},
+		},
+		{
+			ID:        testhelper.MkID("mix: named colours"),
+			s:         "color-mix(in srgb, web:teal, white)",
+			expColour: rgba{R: 0x80, G: 0xc0, B: 0xc0, A: 0xff},
+		},
+		{
+			ID: testhelper.MkID("mix: nested"),
+			s: "color-mix(in srgb," +
+				" color-mix(in srgb, red, blue), blue)",
+			expColour: rgba{R: 0x40, B: 0xbf, A: 0xff},
+		},
+		{
+			ID: testhelper.MkID("mix: bad space"),
+			ExpErr: testhelper.MkExpErr(
+				`bad color-mix() colour space "foo"`),
+			s: "color-mix(in foo, red, blue)",
+		},
+		{
+			ID: testhelper.MkID("mix: hue method in a rectangular space"),
+			ExpErr: testhelper.MkExpErr(
+				"a hue interpolation method cannot be used" +
+					` with the "srgb" colour space`),
+			s: "color-mix(in srgb longer hue, red, blue)",
+		},
+		{
+			ID: testhelper.MkID("mix: bad percentage"),
+			ExpErr: testhelper.MkExpErr(
+				"the color-mix() percentage (120%)" +
+					" must be between 0% and 100%"),
+			s: "color-mix(in srgb, red 120%, blue)",
+		},
+		{
+			ID: testhelper.MkID("mix: zero percentages"),
+			ExpErr: testhelper.MkExpErr(
+				"the color-mix() percentages must not both be zero"),
+			s: "color-mix(in srgb, red 0%, blue 0%)",
+		},
+		{
+			ID:        testhelper.MkID("relative: oklch"),
+			s:         "oklch(from #3366ff calc(l + 0.1) c h)",
+			expColour: rgba{R: 0x5b, G: 0x8d, B: 0xff, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("relative: rgb"),
+			s:         "rgb(from red calc(r / 2) g b / calc(alpha / 2))",
+			expColour: rgba{R: 0x80, A: 0x80},
+		},
+		{
+			ID:        testhelper.MkID("relative: hsl, complementary hue"),
+			s:         "hsl(from var(--accent) calc(h + 180) s l)",
+			expColour: rgba{R: 0xff, G: 0xcc, B: 0x33, A: 0xff},
+		},
+		{
+			ID: testhelper.MkID("relative: min, max and clamp"),
+			s: "rgb(from #808080" +
+				" max(r, 200) min(g, 10) clamp(0, b * 3, 255))",
+			expColour: rgba{R: 0xc8, G: 0x0a, B: 0xff, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("relative: color()"),
+			s:         "color(from red xyz x y z)",
+			expColour: rgba{R: 0xff, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("relative: from color-mix()"),
+			s:         "rgb(from color-mix(in srgb, red, blue) r 0 b)",
+			expColour: rgba{R: 0x80, B: 0x80, A: 0xff},
+		},
+		{
+			ID: testhelper.MkID("relative: unknown keyword"),
+			ExpErr: testhelper.MkExpErr(
+				`bad oklch() component "l": unknown keyword "r"`),
+			s: "oklch(from red r c h)",
+		},
+		{
+			ID: testhelper.MkID("relative: division by zero"),
+			ExpErr: testhelper.MkExpErr(
+				"bad calc() argument: division by zero"),
+			s: "rgb(from red calc(r / 0) g b)",
+		},
+		{
+			ID:        testhelper.MkID("var: fallback"),
+			s:         "var(--unset, green)",
+			expColour: rgba{G: 0x80, A: 0xff},
+		},
+		{
+			ID: testhelper.MkID("var: not set"),
+			ExpErr: testhelper.MkExpErr(
+				`the custom property "--unset" is not set`),
+			s: "var(--unset)",
+		},
+		{
+			ID: testhelper.MkID("var: loop"),
+			ExpErr: testhelper.MkExpErr(
+				"too many nested var() references"),
+			s: "var(--loop)",
+		},
+		{
+			ID: testhelper.MkID("too few components"),
+			ExpErr: testhelper.MkExpErr(
+				`bad rgb() component "b": expected a value, found ")"`),
+			s: "rgb(1 2)",
+		},
+		{
+			ID: testhelper.MkID("missing parenthesis"),
+			ExpErr: testhelper.MkExpErr(
+				`expected ")" to end rgb(), found the end of the value`),
+			s: "rgb(1 2 3",
+		},
+		{
+			ID: testhelper.MkID("trailing text"),
+			ExpErr: testhelper.MkExpErr(
+				`unexpected "blue" after the colour`),
+			s: "red blue",
+		},
+	}
+
+	for _, tc := range testCases {
+		c, err := e.Eval(tc.s)
+		if testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc) &&
+			err == nil {
+			colourtesthelper.DiffRGBA(t, tc.IDStr(), tc.s, c, tc.expColour)
+		}
+	}
+}
+
+func TestEvalCSSColour(t *testing.T) {
+	c, err := EvalCSSColour(Families{WebColours},
+		"color-mix(in oklab, teal, white)")
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	colourtesthelper.DiffRGBA(t, "teal and white", "mixed colour",
+		c, rgba{R: 0x93, G: 0xbe, B: 0xbe, A: 0xff})
+}
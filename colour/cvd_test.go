@@ -0,0 +1,124 @@
+package colour
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/nickwells/colour.mod/v2/colourtesthelper"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestSimulateCVD(t *testing.T) {
+	red := rgba{R: 0xff, A: 0xff}
+	green := rgba{G: 0xff, A: 0xff}
+	blue := rgba{B: 0xff, A: 0x80}
+	orange := rgba{R: 0xff, G: 0x80, A: 0xff}
+	grey := rgba{R: 0x80, G: 0x80, B: 0x80, A: 0xff}
+	cs := []rgba{red, green, blue, orange, grey}
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		cvd       CVD
+		severity  float64
+		expColour []rgba
+	}{
+		{
+			ID:       testhelper.MkID("bad severity: too small"),
+			ExpErr:   testhelper.MkExpErr("the severity (-0.10) must be >= 0"),
+			cvd:      Protanopia,
+			severity: -0.1,
+		},
+		{
+			ID:       testhelper.MkID("bad severity: too big"),
+			ExpErr:   testhelper.MkExpErr("the severity (1.10) must be <= 1"),
+			cvd:      Protanopia,
+			severity: 1.1,
+		},
+		{
+			ID: testhelper.MkID("bad CVD"),
+			ExpErr: testhelper.MkExpErr(
+				"unknown colour vision deficiency: CVD(99)"),
+			cvd:      CVD(99),
+			severity: 1,
+		},
+		{
+			ID:        testhelper.MkID("no deficiency"),
+			cvd:       Deuteranopia,
+			expColour: cs,
+		},
+		{
+			ID:       testhelper.MkID("protanopia"),
+			cvd:      Protanopia,
+			severity: 1,
+			expColour: []rgba{
+				{R: 0x6d, G: 0x5f, A: 0xff},
+				{R: 0xff, G: 0xe5, A: 0xff},
+				{G: 0x59, B: 0xff, A: 0x80},
+				{R: 0xa6, G: 0x91, A: 0xff},
+				grey,
+			},
+		},
+		{
+			ID:       testhelper.MkID("protanomaly"),
+			cvd:      Protanopia,
+			severity: 0.5,
+			expColour: []rgba{
+				{R: 0xc8, G: 0x44, A: 0xff},
+				{R: 0xc0, G: 0xf3, A: 0xff},
+				{G: 0x3f, B: 0xff, A: 0x80},
+				{R: 0xd8, G: 0x89, A: 0xff},
+				grey,
+			},
+		},
+		{
+			ID:       testhelper.MkID("deuteranopia"),
+			cvd:      Deuteranopia,
+			severity: 1,
+			expColour: []rgba{
+				{R: 0xa3, G: 0x90, A: 0xff},
+				{R: 0xef, G: 0xd6, B: 0x3a, A: 0xff},
+				{G: 0x3d, B: 0xfb, A: 0x80},
+				{R: 0xc4, G: 0xae, A: 0xff},
+				grey,
+			},
+		},
+		{
+			ID:       testhelper.MkID("tritanopia"),
+			cvd:      Tritanopia,
+			severity: 1,
+			expColour: []rgba{
+				{R: 0xff, B: 0x0f, A: 0xff},
+				{G: 0xf7, B: 0xd9, A: 0xff},
+				{G: 0x6b, B: 0x96, A: 0x80},
+				{R: 0xff, G: 0x62, B: 0x6d, A: 0xff},
+				grey,
+			},
+		},
+		{
+			ID:       testhelper.MkID("achromatopsia"),
+			cvd:      Achromatopsia,
+			severity: 1,
+			expColour: []rgba{
+				{R: 0x7f, G: 0x7f, B: 0x7f, A: 0xff},
+				{R: 0xdc, G: 0xdc, B: 0xdc, A: 0xff},
+				{R: 0x4c, G: 0x4c, B: 0x4c, A: 0x80},
+				{R: 0xa3, G: 0xa3, B: 0xa3, A: 0xff},
+				grey,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tr, err := SimulateCVD(tc.cvd, tc.severity)
+		if testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc) &&
+			err == nil {
+			act := tr.ApplyToColours(cs)
+			for i, c := range act {
+				colourtesthelper.DiffRGBA(t, tc.IDStr(),
+					fmt.Sprintf("colour %d", i),
+					c, tc.expColour[i])
+			}
+		}
+	}
+}
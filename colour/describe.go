@@ -20,21 +20,45 @@ type qualifiedColourName struct {
 // is not found then the RGB values are shown. Otherwise the shortest name
 // for the colour in each family is used and if only one name is found then
 // that is returned without any Family-qualification. It searches in the
-// standard families. See [Families.Describe] .
+// standard families. See [Families.Describe] and, for a name for a colour
+// which has no exact match, [DescriptiveName].
 func Describe(c color.RGBA) string { //nolint:misspell
 	return standardFamilies.Describe(c)
 }
 
+// DescribeIn returns a string representation of the colour with the colour
+// names given in the language, where the language has a name for the
+// colour. Otherwise the English names are used. It searches in the standard
+// families. See [Families.DescribeIn].
+func DescribeIn(l Language, c color.RGBA) string { //nolint:misspell
+	return standardFamilies.DescribeIn(l, c)
+}
+
 // Describe returns a string representation of the colour. If an exact match
 // is not found then the RGB value is shown. Otherwise the shortest name for
 // the colour in each family is used and if only one name is found then that
-// is returned without any Family-qualification
+// is returned without any Family-qualification. See [Families.DescribeApprox]
+// for a description using the closest named colour.
 func (fl Families) Describe(c color.RGBA) string { //nolint:misspell
+	return fl.DescribeIn(LangEnglish, c)
+}
+
+// DescribeIn returns a string representation of the colour as for
+// [Families.Describe] but with the colour names given in the language, where
+// the language has a name for the colour. Otherwise the English names are
+// used.
+//
+//nolint:misspell
+func (fl Families) DescribeIn(l Language, c color.RGBA) string {
 	colours, err := fl.ClosestWithin(c, 0)
 	if err != nil {
 		return err.Error()
 	}
 
+	for i, fc := range colours {
+		colours[i] = l.localise(fc)
+	}
+
 	if len(colours) == 0 {
 		return fmt.Sprintf("%#4.2v", c)
 	}
@@ -0,0 +1,198 @@
+package colour
+
+import (
+	"fmt"
+	"image/color" //nolint:misspell
+	"math"
+	"slices"
+
+	"github.com/nickwells/english.mod/english"
+)
+
+// ApproxQualifier identifies the wording used to show that a description is
+// of a colour close to, rather than the same as, the described colour
+type ApproxQualifier int
+
+// These are the available qualifiers. The qualifying word is placed before
+// the colour names, as in "approximately teal"; the tilde is placed
+// directly before them, as in "~teal".
+const (
+	QualApproximately ApproxQualifier = iota // approximately
+	QualAbout                                // about
+	QualCloseTo                              // close to
+	QualNear                                 // near
+	QualTilde                                // ~
+)
+
+// qualify returns the description with the qualifier before it
+func (q ApproxQualifier) qualify(desc string) string {
+	if q == QualTilde {
+		return q.String() + desc
+	}
+
+	return q.String() + " " + desc
+}
+
+// ApproxDescription describes a colour by the closest named colour within
+// a tolerance. The closeness is measured by the CIEDE2000 colour
+// difference (ΔE). Use [Families.DescribeApprox] to make one.
+type ApproxDescription struct {
+	// Colour is the colour being described
+	Colour color.RGBA //nolint:misspell
+	// Match is the closest named colour. It is only valid if Names is not
+	// empty.
+	Match color.RGBA //nolint:misspell
+	// Names gives the names of the Match colour; each name is the preferred
+	// name of the colour in the corresponding entry in Families. It is empty
+	// if there is no named colour within the tolerance.
+	Names []string
+	// Families gives the Family of each of the Names
+	Families Families
+	// DeltaE is the CIEDE2000 colour difference between the Colour and the
+	// Match
+	DeltaE float64
+	// Exact is true if the Match has the same red, green and blue values as
+	// the Colour
+	Exact bool
+	// Qualifier gives the wording used when the match is not exact
+	Qualifier ApproxQualifier
+}
+
+// String returns the description as a string. An exact match is described
+// as by [Families.Describe]. Otherwise the names are preceded by the
+// qualifier and followed by the colour difference, as in "approximately
+// teal (ΔE 1.8)". If there is no named colour within the tolerance the
+// colour is given a descriptive name; see [DescriptiveName].
+func (ad ApproxDescription) String() string {
+	if len(ad.Names) == 0 {
+		return DescriptiveName(ad.Colour)
+	}
+
+	desc := ad.Names[0]
+
+	if slices.ContainsFunc(ad.Names,
+		func(n string) bool { return n != ad.Names[0] }) {
+		names := []string{}
+		for i, n := range ad.Names {
+			names = append(names,
+				fmt.Sprintf("%q", ad.Families[i].String()+":"+n))
+		}
+
+		desc = english.Join(names, ", ", " or ")
+	}
+
+	if ad.Exact {
+		return desc
+	}
+
+	return fmt.Sprintf("%s (ΔE %.1f)", ad.Qualifier.qualify(desc), ad.DeltaE)
+}
+
+// checkTolerance returns a non-nil error if the tolerance is invalid
+func checkTolerance(tolerance float64) error {
+	if tolerance < 0 || math.IsNaN(tolerance) {
+		return fmt.Errorf("bad tolerance: %g,"+
+			" it must be greater than or equal to 0", tolerance)
+	}
+
+	return nil
+}
+
+// DescribeApprox returns a description of the colour by the closest named
+// colour whose CIEDE2000 colour difference from the colour is no more than
+// the tolerance. It searches in the standard families. See
+// [Families.DescribeApprox].
+//
+//nolint:misspell
+func DescribeApprox(c color.RGBA, tolerance float64, q ApproxQualifier) (
+	ApproxDescription, error,
+) {
+	return standardFamilies.DescribeApprox(c, tolerance, q)
+}
+
+// DescribeApprox returns a description of the colour by the closest named
+// colour amongst the Families whose CIEDE2000 colour difference (ΔE) from
+// the colour is no more than the tolerance. A tolerance of about 1 allows
+// only differences which are hard to see; a tolerance of 0 allows only
+// exact matches, as for [Families.Describe]. The qualifier gives the
+// wording used if the match is not exact. Where colours are equally close
+// the choice between them is made consistently. The alpha values are
+// ignored.
+//
+// A non-nil error is returned if any of the Families is not recognised or
+// if the tolerance is less than zero.
+//
+// If no families are given then the standard families are used.
+//
+//nolint:misspell
+func (fl Families) DescribeApprox(
+	c color.RGBA,
+	tolerance float64,
+	q ApproxQualifier,
+) (
+	ApproxDescription, error,
+) {
+	ad := ApproxDescription{Colour: c, Qualifier: q}
+
+	if len(fl) == 0 {
+		fl = standardFamilies
+	}
+
+	if err := fl.Check(); err != nil {
+		return ad, err
+	}
+
+	if err := checkTolerance(tolerance); err != nil {
+		return ad, err
+	}
+
+	familyColours := fl.getSortedDists(c)
+	target := RGBA2Lab(c)
+	seen := map[rgba]bool{}
+	found := false
+
+	for _, fc := range familyColours {
+		key := rgba{R: fc.Colour.R, G: fc.Colour.G, B: fc.Colour.B}
+		if seen[key] {
+			continue
+		}
+
+		seen[key] = true
+
+		de := 0.0
+		if fc.dist != 0 {
+			de = target.DeltaE2000(RGBA2Lab(key))
+		}
+
+		if de <= tolerance && (!found || de < ad.DeltaE) {
+			ad.Match, ad.DeltaE, ad.Exact = fc.Colour, de, fc.dist == 0
+			found = true
+		}
+	}
+
+	if !found {
+		return ad, nil
+	}
+
+	matches := []FamilyColour{}
+
+	for _, fc := range familyColours {
+		if fc.Colour.R == ad.Match.R &&
+			fc.Colour.G == ad.Match.G &&
+			fc.Colour.B == ad.Match.B {
+			matches = append(matches, fc)
+		}
+	}
+
+	// all the matches are the same distance from the colour
+	matches = coloursWithin(matches, matches[0].dist)
+
+	for _, qcn := range getFamilyNames(matches) {
+		for _, f := range qcn.families {
+			ad.Names = append(ad.Names, qcn.cName)
+			ad.Families = append(ad.Families, f)
+		}
+	}
+
+	return ad, nil
+}
@@ -0,0 +1,132 @@
+package colour
+
+import (
+	"testing"
+
+	"github.com/nickwells/colour.mod/v2/colourtesthelper"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestDescribeApprox(t *testing.T) {
+	teal := rgba{R: 0x00, G: 0x80, B: 0x80, A: 0xff}
+	nearTeal := rgba{R: 0x02, G: 0x82, B: 0x7f, A: 0xff}
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		fl          Families
+		c           rgba
+		tolerance   float64
+		q           ApproxQualifier
+		expMatch    rgba
+		expNames    []string
+		expFamilies Families
+		expDeltaE   float64
+		expExact    bool
+		expStr      string
+	}{
+		{
+			ID:          testhelper.MkID("exact match"),
+			fl:          Families{WebColours},
+			c:           teal,
+			tolerance:   2,
+			expMatch:    teal,
+			expNames:    []string{"teal"},
+			expFamilies: Families{WebColours},
+			expExact:    true,
+			expStr:      "teal",
+		},
+		{
+			ID:          testhelper.MkID("exact match, many families"),
+			c:           teal,
+			expMatch:    teal,
+			expNames:    []string{"teal", "teal", "low cyan"},
+			expFamilies: Families{HTMLColours, WebColours, CGAColours},
+			expExact:    true,
+			expStr:      `"HTML:teal", "Web:teal" or "CGA:low cyan"`,
+		},
+		{
+			ID:          testhelper.MkID("approximate match"),
+			fl:          Families{WebColours},
+			c:           nearTeal,
+			tolerance:   2,
+			expMatch:    teal,
+			expNames:    []string{"teal"},
+			expFamilies: Families{WebColours},
+			expDeltaE:   1.3735,
+			expStr:      "approximately teal (ΔE 1.4)",
+		},
+		{
+			ID:          testhelper.MkID("approximate match, about"),
+			fl:          Families{WebColours},
+			c:           nearTeal,
+			tolerance:   2,
+			q:           QualAbout,
+			expMatch:    teal,
+			expNames:    []string{"teal"},
+			expFamilies: Families{WebColours},
+			expDeltaE:   1.3735,
+			expStr:      "about teal (ΔE 1.4)",
+		},
+		{
+			ID:          testhelper.MkID("approximate match, tilde"),
+			fl:          Families{WebColours},
+			c:           nearTeal,
+			tolerance:   2,
+			q:           QualTilde,
+			expMatch:    teal,
+			expNames:    []string{"teal"},
+			expFamilies: Families{WebColours},
+			expDeltaE:   1.3735,
+			expStr:      "~teal (ΔE 1.4)",
+		},
+		{
+			ID:        testhelper.MkID("no match within the tolerance"),
+			fl:        Families{WebColours},
+			c:         nearTeal,
+			tolerance: 1,
+			expStr:    "greenish blue",
+		},
+		{
+			ID:        testhelper.MkID("zero tolerance, no exact match"),
+			fl:        Families{WebColours},
+			c:         nearTeal,
+			tolerance: 0,
+			expStr:    "greenish blue",
+		},
+		{
+			ID: testhelper.MkID("bad tolerance"),
+			ExpErr: testhelper.MkExpErr(
+				"bad tolerance: -1, it must be greater than or equal to 0"),
+			c:         teal,
+			tolerance: -1,
+		},
+		{
+			ID:     testhelper.MkID("bad family"),
+			ExpErr: testhelper.MkExpErr(`"nonesuch" is not a valid Family`),
+			fl:     Families{"nonesuch"},
+			c:      teal,
+		},
+	}
+
+	for _, tc := range testCases {
+		ad, err := tc.fl.DescribeApprox(tc.c, tc.tolerance, tc.q)
+		if !testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc) ||
+			err != nil {
+			continue
+		}
+
+		colourtesthelper.DiffRGBA(t, tc.IDStr(), "colour", ad.Colour, tc.c)
+		colourtesthelper.DiffRGBA(t, tc.IDStr(), "match",
+			ad.Match, tc.expMatch)
+		testhelper.DiffStringSlice(t, tc.IDStr(), "names",
+			ad.Names, tc.expNames)
+		testhelper.DiffSlice(t, tc.IDStr(), "families",
+			ad.Families, tc.expFamilies)
+		testhelper.DiffFloat(t, tc.IDStr(), "ΔE",
+			ad.DeltaE, tc.expDeltaE, 0.0001)
+		testhelper.DiffBool(t, tc.IDStr(), "exact", ad.Exact, tc.expExact)
+		testhelper.DiffString(t, tc.IDStr(), "description",
+			ad.String(), tc.expStr)
+	}
+}
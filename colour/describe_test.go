@@ -58,3 +58,68 @@ func TestDescribeColour(t *testing.T) {
 			str, tc.expStr)
 	}
 }
+
+func TestDescribeColourIn(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		lang   Language
+		c      rgba
+		expStr string
+	}{
+		{
+			ID:     testhelper.MkID("English"),
+			lang:   LangEnglish,
+			c:      rgba{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+			expStr: "white",
+		},
+		{
+			ID:     testhelper.MkID("French"),
+			lang:   LangFrench,
+			c:      rgba{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+			expStr: "blanc",
+		},
+		{
+			ID:     testhelper.MkID("German, with a region"),
+			lang:   "de-CH",
+			c:      rgba{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+			expStr: "weiß",
+		},
+		{
+			ID:     testhelper.MkID("Spanish, from a run-together name"),
+			lang:   LangSpanish,
+			c:      rgba{R: 0x00, G: 0x00, B: 0x8b, A: 0xff},
+			expStr: "azul oscuro",
+		},
+		{
+			ID:     testhelper.MkID("French, many matches, different names"),
+			lang:   LangFrench,
+			c:      rgba{R: 0xc0, G: 0xc0, B: 0xc0, A: 0xff},
+			expStr: `"HTML:argent", "Web:argent" or "CGA:gris clair"`,
+		},
+		{
+			ID:     testhelper.MkID("French, some names not in the catalogue"),
+			lang:   LangFrench,
+			c:      rgba{R: 0xff, G: 0x00, B: 0x00, A: 0xff},
+			expStr: `"HTML:rouge", "Web:rouge", "X11:rouge" or "CGA:high red"`,
+		},
+		{
+			ID:     testhelper.MkID("French, no name in the catalogue"),
+			lang:   LangFrench,
+			c:      rgba{R: 0x00, G: 0x00, B: 0xee, A: 0xff},
+			expStr: "blue2",
+		},
+		{
+			ID:     testhelper.MkID("unsupported language"),
+			lang:   "it",
+			c:      rgba{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+			expStr: "white",
+		},
+	}
+
+	for _, tc := range testCases {
+		str := DescribeIn(tc.lang, tc.c)
+		testhelper.DiffString(t,
+			tc.IDStr(), fmt.Sprintf("description of %v", tc.c),
+			str, tc.expStr)
+	}
+}
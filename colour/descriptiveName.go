@@ -0,0 +1,406 @@
+package colour
+
+import (
+	"errors"
+	"fmt"
+	"image/color" //nolint:misspell
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// HueTerm names a range of hues. The range starts at the From angle and
+// runs up to the From angle of the next HueTerm; the range of the last
+// HueTerm wraps round to the first.
+type HueTerm struct {
+	// From is the Oklch hue angle, in degrees, at which the range starts
+	From float64
+	// Modifier qualifies the hue, as in "bluish green". It may be empty.
+	Modifier string
+	// Hue is the name of the hue
+	Hue string
+}
+
+// DefaultHueTerms returns the HueTerms used by the DefaultDescriptiveNamer.
+// The hues are divided into ranges named with the basic colour terms (red,
+// orange, yellow, green, blue and purple) and compounds of pairs of
+// neighbouring terms (such as "yellowish green").
+//
+//nolint:mnd
+func DefaultHueTerms() []HueTerm {
+	return []HueTerm{
+		{From: 10, Hue: "red"},
+		{From: 40, Modifier: "reddish", Hue: "orange"},
+		{From: 55, Hue: "orange"},
+		{From: 75, Modifier: "yellowish", Hue: "orange"},
+		{From: 95, Hue: "yellow"},
+		{From: 115, Modifier: "greenish", Hue: "yellow"},
+		{From: 128, Modifier: "yellowish", Hue: "green"},
+		{From: 140, Hue: "green"},
+		{From: 170, Modifier: "bluish", Hue: "green"},
+		{From: 190, Modifier: "greenish", Hue: "blue"},
+		{From: 235, Hue: "blue"},
+		{From: 275, Modifier: "purplish", Hue: "blue"},
+		{From: 290, Modifier: "bluish", Hue: "purple"},
+		{From: 305, Hue: "purple"},
+		{From: 335, Modifier: "reddish", Hue: "purple"},
+		{From: 350, Modifier: "purplish", Hue: "red"},
+	}
+}
+
+// DescriptiveNamer composes a descriptive name for any colour from its
+// perceptual attributes: its lightness, its chroma and its hue, as in "dark
+// muted bluish green". The attributes are measured in the Oklch colour space
+// and the names depend only on the colour and the settings of the
+// DescriptiveNamer, so the same colour is always given the same name.
+//
+// Colours with very little chroma are named as black, white or grey. Dark
+// orange colours are named as brown and light red colours as pink.
+//
+// The names are given in the language of the DescriptiveNamer. The terms
+// (including those in the HueTerms) are translated where the language has a
+// translation; terms that cannot be translated are used as given. The hue
+// is translated using the same colour names as [Language.ColourName] and,
+// in German, it is capitalised as a noun ("dunkles Blau").
+type DescriptiveNamer struct {
+	// Lang is the language of the names. If it has no descriptive terms the
+	// names are in English.
+	Lang Language
+
+	// VeryDarkBelow is the Oklch lightness below which a colour is "very
+	// dark"
+	VeryDarkBelow float64
+	// DarkBelow is the Oklch lightness below which a colour is "dark"
+	DarkBelow float64
+	// BrownBelow is the Oklch lightness below which an orange colour is
+	// "brown"
+	BrownBelow float64
+	// LightAbove is the Oklch lightness above which a colour is "light"
+	LightAbove float64
+	// PaleAbove is the Oklch lightness above which a colour is "pale". A
+	// vivid colour is never pale; it is "light" instead.
+	PaleAbove float64
+	// BlackBelow is the Oklch lightness below which a neutral colour is
+	// "black"
+	BlackBelow float64
+	// WhiteAbove is the Oklch lightness above which a neutral colour is
+	// "white"
+	WhiteAbove float64
+
+	// NeutralBelow is the Oklch chroma below which a colour is neutral:
+	// black, white or grey
+	NeutralBelow float64
+	// GreyishBelow is the Oklch chroma below which a colour is "greyish"
+	GreyishBelow float64
+	// MutedBelow is the Oklch chroma below which a colour is "muted"
+	MutedBelow float64
+	// VividAbove is the Oklch chroma above which a colour is "vivid"
+	VividAbove float64
+
+	// Hues gives the names of the ranges of hues. The From angles must be
+	// strictly increasing and in the range [0, 360).
+	Hues []HueTerm
+}
+
+// DefaultDescriptiveNamer returns a DescriptiveNamer giving names in
+// English with thresholds chosen to give reasonable names across the sRGB
+// gamut. The primary blue (#0000ff) has an Oklch lightness of just over
+// 0.45 and so the DarkBelow threshold is set so that it is not "dark".
+func DefaultDescriptiveNamer() DescriptiveNamer {
+	return DescriptiveNamer{
+		Lang:          LangEnglish,
+		VeryDarkBelow: 0.3,  //nolint:mnd
+		DarkBelow:     0.45, //nolint:mnd
+		BrownBelow:    0.5,  //nolint:mnd
+		LightAbove:    0.75, //nolint:mnd
+		PaleAbove:     0.88, //nolint:mnd
+		BlackBelow:    0.2,  //nolint:mnd
+		WhiteAbove:    0.97, //nolint:mnd
+		NeutralBelow:  0.02, //nolint:mnd
+		GreyishBelow:  0.05, //nolint:mnd
+		MutedBelow:    0.09, //nolint:mnd
+		VividAbove:    0.15, //nolint:mnd
+		Hues:          DefaultHueTerms(),
+	}
+}
+
+// checkIncreasing returns a non-nil error if the named values are not in
+// non-decreasing order
+func checkIncreasing(names []string, vals ...float64) error {
+	for i := 1; i < len(vals); i++ {
+		if vals[i] < vals[i-1] {
+			return fmt.Errorf("%s (%g) must not be less than %s (%g)",
+				names[i], vals[i], names[i-1], vals[i-1])
+		}
+	}
+
+	return nil
+}
+
+// Check returns a non-nil error if the DescriptiveNamer is invalid
+func (dn DescriptiveNamer) Check() error {
+	if err := checkIncreasing(
+		[]string{
+			"BlackBelow", "VeryDarkBelow", "DarkBelow", "BrownBelow",
+			"LightAbove", "PaleAbove", "WhiteAbove",
+		},
+		dn.BlackBelow, dn.VeryDarkBelow, dn.DarkBelow, dn.BrownBelow,
+		dn.LightAbove, dn.PaleAbove, dn.WhiteAbove); err != nil {
+		return err
+	}
+
+	if err := checkIncreasing(
+		[]string{"NeutralBelow", "GreyishBelow", "MutedBelow", "VividAbove"},
+		dn.NeutralBelow, dn.GreyishBelow, dn.MutedBelow,
+		dn.VividAbove); err != nil {
+		return err
+	}
+
+	if len(dn.Hues) == 0 {
+		return errors.New("there are no hue terms")
+	}
+
+	for i, ht := range dn.Hues {
+		if ht.From < 0 || ht.From >= 360 {
+			return fmt.Errorf("the hue term %d (%q) starts at %g,"+
+				" it must be in the range [0, 360)", i, ht.Hue, ht.From)
+		}
+
+		if i > 0 && ht.From <= dn.Hues[i-1].From {
+			return fmt.Errorf("the hue terms must be in increasing order:"+
+				" term %d (%q) starts at %g, not after term %d (%g)",
+				i, ht.Hue, ht.From, i-1, dn.Hues[i-1].From)
+		}
+	}
+
+	return nil
+}
+
+// hueTerm returns the HueTerm whose range includes the hue angle
+func (dn DescriptiveNamer) hueTerm(h float64) HueTerm {
+	ht := dn.Hues[len(dn.Hues)-1] // the last range wraps round
+
+	for _, t := range dn.Hues {
+		if t.From > h {
+			break
+		}
+
+		ht = t
+	}
+
+	return ht
+}
+
+// lightnessTerm returns the term for the lightness of a colour. The chroma
+// is used to avoid calling vivid colours pale.
+func (dn DescriptiveNamer) lightnessTerm(l, c float64) string {
+	switch {
+	case l < dn.VeryDarkBelow:
+		return "very dark"
+	case l < dn.DarkBelow:
+		return "dark"
+	case l > dn.PaleAbove && c <= dn.VividAbove:
+		return "pale"
+	case l > dn.LightAbove:
+		return "light"
+	}
+
+	return ""
+}
+
+// chromaTerm returns the term for the chroma of a colour
+func (dn DescriptiveNamer) chromaTerm(c float64) string {
+	switch {
+	case c < dn.GreyishBelow:
+		return "greyish"
+	case c < dn.MutedBelow:
+		return "muted"
+	case c > dn.VividAbove:
+		return "vivid"
+	}
+
+	return ""
+}
+
+// nameTerms returns the terms of the name of the colour, in English: the
+// lightness, the chroma, the hue modifier and the hue. Any of the terms
+// other than the hue may be empty.
+//
+//nolint:misspell
+func (dn DescriptiveNamer) nameTerms(c color.RGBA) [4]string {
+	ok := RGBA2Oklch(c)
+
+	if ok.C < dn.NeutralBelow {
+		switch {
+		case ok.L < dn.BlackBelow:
+			return [4]string{3: "black"}
+		case ok.L > dn.WhiteAbove:
+			return [4]string{3: "white"}
+		}
+
+		return [4]string{0: dn.lightnessTerm(ok.L, ok.C), 3: "grey"}
+	}
+
+	lightness := dn.lightnessTerm(ok.L, ok.C)
+	ht := dn.hueTerm(ok.H)
+
+	// a dark orange is brown and a light red is pink
+	switch {
+	case ht.Hue == "orange" && ok.L < dn.BrownBelow:
+		ht.Hue = "brown"
+		if lightness == "very dark" {
+			lightness = "dark"
+		} else {
+			lightness = ""
+		}
+	case ht.Hue == "red" && lightness == "light":
+		ht.Hue, lightness = "pink", ""
+	case ht.Hue == "red" && lightness == "pale":
+		ht.Hue = "pink"
+	}
+
+	return [4]string{lightness, dn.chromaTerm(ok.C), ht.Modifier, ht.Hue}
+}
+
+// Name returns a descriptive name for the colour, such as "dark muted
+// bluish green", in the language of the DescriptiveNamer. The alpha value
+// of the colour is ignored. A non-nil error is returned if the
+// DescriptiveNamer is invalid.
+//
+//nolint:misspell
+func (dn DescriptiveNamer) Name(c color.RGBA) (string, error) {
+	if err := dn.Check(); err != nil {
+		return "", err
+	}
+
+	terms := dn.nameTerms(c)
+	vocab := descriptiveVocabs[dn.Lang.primary()]
+
+	words := make([]string, 0, len(terms))
+
+	for i, t := range terms {
+		if t == "" {
+			continue
+		}
+
+		if i == len(terms)-1 {
+			words = append(words, vocab.hueName(dn.Lang, t))
+			continue
+		}
+
+		if lt, ok := vocab.terms[t]; ok {
+			t = lt
+		}
+
+		words = append(words, t)
+	}
+
+	if vocab.nounFirst {
+		for i, j := 0, len(words)-1; i < j; i, j = i+1, j-1 {
+			words[i], words[j] = words[j], words[i]
+		}
+	}
+
+	return strings.Join(words, " "), nil
+}
+
+// DescriptiveName returns a descriptive name for the colour, such as "dark
+// muted bluish green", using the DefaultDescriptiveNamer. Unlike Describe
+// it gives a name for every colour. See [DescriptiveNamer].
+func DescriptiveName(c color.RGBA) string { //nolint:misspell
+	return DescriptiveNameIn(LangEnglish, c)
+}
+
+// DescriptiveNameIn returns a descriptive name for the colour, as for
+// DescriptiveName, in the given language
+func DescriptiveNameIn(l Language, c color.RGBA) string { //nolint:misspell
+	dn := DefaultDescriptiveNamer()
+	dn.Lang = l
+
+	name, _ := dn.Name(c) // the default DescriptiveNamer is always valid
+
+	return name
+}
+
+// descriptiveVocab holds the translations of the terms, other than the
+// hues, used in descriptive names. If nounFirst is true the hue is given
+// first, followed by the hue modifier, the chroma and the lightness, as in
+// French or Spanish. If capitalNoun is true the hue is capitalised, as in
+// German.
+type descriptiveVocab struct {
+	terms       map[string]string
+	nounFirst   bool
+	capitalNoun bool
+}
+
+// hueName returns the name of the hue in the language. The hues are
+// translated using the colour-name catalogues so that the descriptive
+// names agree with the localised colour names.
+func (v descriptiveVocab) hueName(l Language, hue string) string {
+	name, _ := l.ColourName(hue)
+
+	if v.capitalNoun {
+		r, size := utf8.DecodeRuneInString(name)
+		name = string(unicode.ToUpper(r)) + name[size:]
+	}
+
+	return name
+}
+
+// descriptiveVocabs maps each Language to the translations of the terms
+// used in descriptive names. The colours are named as nouns: masculine in
+// French and Spanish and, in German, neuter with the adjectives declined
+// to follow no article.
+var descriptiveVocabs = map[Language]descriptiveVocab{
+	LangFrench: {
+		nounFirst: true,
+		terms: map[string]string{
+			"very dark": "très foncé",
+			"dark":      "foncé",
+			"light":     "clair",
+			"pale":      "pâle",
+			"greyish":   "grisâtre",
+			"muted":     "terne",
+			"vivid":     "vif",
+			"reddish":   "rougeâtre",
+			"yellowish": "jaunâtre",
+			"greenish":  "verdâtre",
+			"bluish":    "bleuâtre",
+			"purplish":  "violacé",
+		},
+	},
+	LangGerman: {
+		capitalNoun: true,
+		terms: map[string]string{
+			"very dark": "sehr dunkles",
+			"dark":      "dunkles",
+			"light":     "helles",
+			"pale":      "blasses",
+			"greyish":   "gräuliches",
+			"muted":     "gedämpftes",
+			"vivid":     "leuchtendes",
+			"reddish":   "rötliches",
+			"yellowish": "gelbliches",
+			"greenish":  "grünliches",
+			"bluish":    "bläuliches",
+			"purplish":  "violettstichiges",
+		},
+	},
+	LangSpanish: {
+		nounFirst: true,
+		terms: map[string]string{
+			"very dark": "muy oscuro",
+			"dark":      "oscuro",
+			"light":     "claro",
+			"pale":      "pálido",
+			"greyish":   "grisáceo",
+			"muted":     "apagado",
+			"vivid":     "vivo",
+			"reddish":   "rojizo",
+			"yellowish": "amarillento",
+			"greenish":  "verdoso",
+			"bluish":    "azulado",
+			"purplish":  "violáceo",
+		},
+	},
+}
@@ -0,0 +1,243 @@
+package colour
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestDescriptiveName(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		lang    Language
+		c       rgba
+		expName string
+	}{
+		{
+			ID:      testhelper.MkID("vivid red"),
+			c:       rgba{R: 0xff, A: 0xff},
+			expName: "vivid red",
+		},
+		{
+			ID:      testhelper.MkID("primary blue is not dark"),
+			c:       rgba{B: 0xff, A: 0xff},
+			expName: "vivid blue",
+		},
+		{
+			ID:      testhelper.MkID("navy is dark"),
+			c:       rgba{B: 0x80, A: 0xff},
+			expName: "very dark vivid blue",
+		},
+		{
+			ID:      testhelper.MkID("dark greyish bluish green"),
+			c:       rgba{R: 0x3c, G: 0x5a, B: 0x50, A: 0xff},
+			expName: "dark greyish bluish green",
+		},
+		{
+			ID:      testhelper.MkID("vivid light colour is not pale"),
+			c:       rgba{R: 0xff, G: 0xff, A: 0xff},
+			expName: "light vivid yellow",
+		},
+		{
+			ID:      testhelper.MkID("pale colour"),
+			c:       rgba{R: 0xe6, G: 0xe6, B: 0xfa, A: 0xff},
+			expName: "pale greyish purplish blue",
+		},
+		{
+			ID:      testhelper.MkID("dark orange is brown"),
+			c:       rgba{R: 0x8b, G: 0x45, B: 0x13, A: 0xff},
+			expName: "reddish brown",
+		},
+		{
+			ID:      testhelper.MkID("light red is pink"),
+			c:       rgba{R: 0xff, G: 0xc0, B: 0xcb, A: 0xff},
+			expName: "muted purplish pink",
+		},
+		{
+			ID:      testhelper.MkID("neutral: grey"),
+			c:       rgba{R: 0x80, G: 0x80, B: 0x80, A: 0xff},
+			expName: "grey",
+		},
+		{
+			ID:      testhelper.MkID("neutral: black"),
+			c:       rgba{R: 0x01, G: 0x02, B: 0x03, A: 0xff},
+			expName: "black",
+		},
+		{
+			ID:      testhelper.MkID("neutral: white"),
+			c:       rgba{R: 0xfa, G: 0xfa, B: 0xfa, A: 0xff},
+			expName: "white",
+		},
+		{
+			ID:      testhelper.MkID("French"),
+			lang:    LangFrench,
+			c:       rgba{R: 0x3c, G: 0x5a, B: 0x50, A: 0xff},
+			expName: "vert bleuâtre grisâtre foncé",
+		},
+		{
+			ID:      testhelper.MkID("German"),
+			lang:    "de-AT",
+			c:       rgba{R: 0x3c, G: 0x5a, B: 0x50, A: 0xff},
+			expName: "dunkles gräuliches bläuliches Grün",
+		},
+		{
+			ID:      testhelper.MkID("German neutral"),
+			lang:    LangGerman,
+			c:       rgba{R: 0x01, G: 0x02, B: 0x03, A: 0xff},
+			expName: "Schwarz",
+		},
+		{
+			ID:      testhelper.MkID("French hue from the colour names"),
+			lang:    LangFrench,
+			c:       rgba{R: 0x80, B: 0x80, A: 0xff},
+			expName: "pourpre vif foncé",
+		},
+		{
+			ID:      testhelper.MkID("Spanish brown from the colour names"),
+			lang:    LangSpanish,
+			c:       rgba{R: 0x8b, G: 0x45, B: 0x13, A: 0xff},
+			expName: "marrón rojizo",
+		},
+		{
+			ID:      testhelper.MkID("Spanish"),
+			lang:    LangSpanish,
+			c:       rgba{R: 0x3c, G: 0x5a, B: 0x50, A: 0xff},
+			expName: "verde azulado grisáceo oscuro",
+		},
+		{
+			ID:      testhelper.MkID("unsupported language"),
+			lang:    "it",
+			c:       rgba{R: 0xff, A: 0xff},
+			expName: "vivid red",
+		},
+	}
+
+	for _, tc := range testCases {
+		lang := tc.lang
+		if lang == "" {
+			lang = LangEnglish
+		}
+
+		testhelper.DiffString(t,
+			tc.IDStr(), fmt.Sprintf("name of %v", tc.c),
+			DescriptiveNameIn(lang, tc.c), tc.expName)
+
+		if tc.lang == "" {
+			testhelper.DiffString(t,
+				tc.IDStr(), fmt.Sprintf("English name of %v", tc.c),
+				DescriptiveName(tc.c), tc.expName)
+		}
+	}
+}
+
+func TestDescriptiveNamer(t *testing.T) {
+	teal := rgba{R: 0x00, G: 0x80, B: 0x80, A: 0xff}
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		dnFunc  func(dn *DescriptiveNamer)
+		expName string
+	}{
+		{
+			ID:      testhelper.MkID("default"),
+			dnFunc:  func(_ *DescriptiveNamer) {},
+			expName: "greenish blue",
+		},
+		{
+			ID: testhelper.MkID("more muted"),
+			dnFunc: func(dn *DescriptiveNamer) {
+				dn.MutedBelow = 0.1
+			},
+			expName: "muted greenish blue",
+		},
+		{
+			ID: testhelper.MkID("custom hue terms, translated"),
+			dnFunc: func(dn *DescriptiveNamer) {
+				dn.Lang = LangFrench
+				dn.Hues = []HueTerm{
+					{From: 0, Hue: "red"},
+					{From: 180, Hue: "teal"},
+					{From: 210, Hue: "blue"},
+				}
+			},
+			expName: "sarcelle",
+		},
+		{
+			ID: testhelper.MkID("custom hue terms, untranslated"),
+			dnFunc: func(dn *DescriptiveNamer) {
+				dn.Lang = LangFrench
+				dn.Hues = []HueTerm{
+					{From: 0, Hue: "red"},
+					{From: 180, Hue: "cerulean"},
+					{From: 210, Hue: "blue"},
+				}
+			},
+			expName: "cerulean",
+		},
+		{
+			ID: testhelper.MkID("bad lightness thresholds"),
+			ExpErr: testhelper.MkExpErr(
+				"DarkBelow (0.2) must not be less than VeryDarkBelow (0.3)"),
+			dnFunc: func(dn *DescriptiveNamer) {
+				dn.DarkBelow = 0.2
+			},
+		},
+		{
+			ID: testhelper.MkID("bad brown threshold"),
+			ExpErr: testhelper.MkExpErr(
+				"LightAbove (0.75) must not be less than BrownBelow (0.9)"),
+			dnFunc: func(dn *DescriptiveNamer) {
+				dn.BrownBelow = 0.9
+			},
+		},
+		{
+			ID: testhelper.MkID("bad chroma thresholds"),
+			ExpErr: testhelper.MkExpErr(
+				"VividAbove (0.01) must not be less than MutedBelow (0.09)"),
+			dnFunc: func(dn *DescriptiveNamer) {
+				dn.VividAbove = 0.01
+			},
+		},
+		{
+			ID:     testhelper.MkID("no hue terms"),
+			ExpErr: testhelper.MkExpErr("there are no hue terms"),
+			dnFunc: func(dn *DescriptiveNamer) {
+				dn.Hues = nil
+			},
+		},
+		{
+			ID: testhelper.MkID("hue term out of range"),
+			ExpErr: testhelper.MkExpErr(
+				`the hue term 0 ("red") starts at 360,` +
+					" it must be in the range [0, 360)"),
+			dnFunc: func(dn *DescriptiveNamer) {
+				dn.Hues = []HueTerm{{From: 360, Hue: "red"}}
+			},
+		},
+		{
+			ID: testhelper.MkID("hue terms out of order"),
+			ExpErr: testhelper.MkExpErr(
+				"the hue terms must be in increasing order:" +
+					` term 1 ("blue") starts at 10, not after term 0 (20)`),
+			dnFunc: func(dn *DescriptiveNamer) {
+				dn.Hues = []HueTerm{
+					{From: 20, Hue: "red"},
+					{From: 10, Hue: "blue"},
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		dn := DefaultDescriptiveNamer()
+		tc.dnFunc(&dn)
+
+		name, err := dn.Name(teal)
+		if testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc) &&
+			err == nil {
+			testhelper.DiffString(t, tc.IDStr(), "name", name, tc.expName)
+		}
+	}
+}
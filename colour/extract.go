@@ -0,0 +1,649 @@
+package colour
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"image"
+	"image/color" //nolint:misspell
+	"math"
+	"slices"
+	"strings"
+)
+
+// ExtractionMethod identifies an algorithm used to extract the dominant
+// colours from an image
+type ExtractionMethod int
+
+// These are the available extraction methods. KMeans gives the best
+// results as it groups colours in the perceptually uniform Oklab colour
+// space but it is the slowest. MedianCut and Octree work in the RGB colour
+// cube and are faster.
+const (
+	KMeans    ExtractionMethod = iota // k-means
+	MedianCut                         // median cut
+	Octree                            // octree
+)
+
+// dfltMaxIterations is the maximum number of iterations of the k-means
+// algorithm used if no value is given
+const dfltMaxIterations = 50
+
+// WeightedColour is a colour together with its share of an image
+type WeightedColour struct {
+	// Colour is the colour. It is always fully opaque.
+	Colour color.RGBA //nolint:misspell
+	// Weight is the proportion of the pixels of the image represented by
+	// the colour, in the range [0, 1]
+	Weight float64
+	// Name is the name of the colour. It is only set when the colours have
+	// been named (see [Families.NamePalette]).
+	Name string
+}
+
+// String returns a description of the WeightedColour giving the weight as
+// a percentage followed by the name of the colour. If the colour has no
+// name it is described using [Describe].
+func (wc WeightedColour) String() string {
+	name := wc.Name
+	if name == "" {
+		name = Describe(wc.Colour)
+	}
+
+	return fmt.Sprintf("%.0f%% %s", wc.Weight*100, name) //nolint:mnd
+}
+
+// DescribePalette returns a description of the colours, such as
+// "60% pantone:navy blazer, 25% web:white, 15% web:black"
+func DescribePalette(wcs []WeightedColour) string {
+	desc := make([]string, 0, len(wcs))
+	for _, wc := range wcs {
+		desc = append(desc, wc.String())
+	}
+
+	return strings.Join(desc, ", ")
+}
+
+// PaletteExtractor extracts the dominant colours from an image
+type PaletteExtractor struct {
+	// Method is the algorithm used to find the colours
+	Method ExtractionMethod
+	// Count is the maximum number of colours to be found. Fewer colours
+	// will be found if the image has fewer distinct colours.
+	Count int
+	// MinAlpha is the smallest alpha value of the pixels used; pixels which
+	// are more transparent than this are ignored. Completely transparent
+	// pixels are always ignored.
+	MinAlpha uint8
+	// MaxIterations is the maximum number of iterations of the k-means
+	// algorithm. If it is zero or less a default value is used.
+	MaxIterations int
+}
+
+// histEntry records a distinct colour in an image and the number of pixels
+// having that colour
+type histEntry struct {
+	c     rgba
+	count int
+}
+
+// histogram returns the distinct colours in the image, ignoring the alpha
+// value, and the number of pixels having each colour. Pixels with an alpha
+// value less than minAlpha, or of zero, are ignored. The colours are
+// returned in a fixed order so that the results of the extraction are
+// repeatable.
+func histogram(img image.Image, minAlpha uint8) []histEntry {
+	counts := map[rgba]int{}
+	b := img.Bounds()
+
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			c := pixelColour(img, x, y)
+			if c.A == 0 || c.A < minAlpha {
+				continue
+			}
+
+			c.A = math.MaxUint8
+			counts[c]++
+		}
+	}
+
+	hist := make([]histEntry, 0, len(counts))
+	for c, n := range counts {
+		hist = append(hist, histEntry{c: c, count: n})
+	}
+
+	slices.SortFunc(hist, func(a, b histEntry) int {
+		return RGBACompare(a.c, b.c)
+	})
+
+	return hist
+}
+
+// Extract returns the dominant colours in the image together with the
+// proportion of the pixels represented by each colour. The colours are
+// returned in order of decreasing weight. An error is returned if the
+// Count is not greater than zero or if the image has no pixels which are
+// opaque enough to be used.
+func (pe PaletteExtractor) Extract(img image.Image) ([]WeightedColour, error) {
+	if pe.Count <= 0 {
+		return nil, badColourCountErr(pe.Count)
+	}
+
+	hist := histogram(img, pe.MinAlpha)
+	if len(hist) == 0 {
+		return nil,
+			errors.New("the image has no pixels to extract colours from")
+	}
+
+	var clusters []histEntry
+
+	switch pe.Method {
+	case KMeans:
+		maxIter := pe.MaxIterations
+		if maxIter <= 0 {
+			maxIter = dfltMaxIterations
+		}
+
+		clusters = kMeans(hist, pe.Count, maxIter)
+	case MedianCut:
+		clusters = medianCut(hist, pe.Count)
+	case Octree:
+		clusters = octreeQuantise(hist, pe.Count)
+	default:
+		return nil, fmt.Errorf("unknown extraction method: %s", pe.Method)
+	}
+
+	return makeWeightedColours(clusters), nil
+}
+
+// makeWeightedColours converts the clusters into WeightedColours, sorted
+// by decreasing weight. Clusters having the same colour (as can happen when
+// distinct cluster centres round to the same RGBA value) are merged and
+// their weights added together.
+func makeWeightedColours(clusters []histEntry) []WeightedColour {
+	total := 0
+	for _, cl := range clusters {
+		total += cl.count
+	}
+
+	wcs := make([]WeightedColour, 0, len(clusters))
+	idx := map[rgba]int{}
+
+	for _, cl := range clusters {
+		if cl.count == 0 {
+			continue
+		}
+
+		weight := float64(cl.count) / float64(total)
+
+		if i, ok := idx[cl.c]; ok {
+			wcs[i].Weight += weight
+			continue
+		}
+
+		idx[cl.c] = len(wcs)
+		wcs = append(wcs, WeightedColour{
+			Colour: cl.c,
+			Weight: weight,
+		})
+	}
+
+	sortWeightedColours(wcs)
+
+	return wcs
+}
+
+// sortWeightedColours sorts the colours by decreasing weight and then by
+// name and colour
+func sortWeightedColours(wcs []WeightedColour) {
+	slices.SortFunc(wcs, func(a, b WeightedColour) int {
+		if d := cmp.Compare(b.Weight, a.Weight); d != 0 {
+			return d
+		}
+
+		if d := strings.Compare(a.Name, b.Name); d != 0 {
+			return d
+		}
+
+		return RGBACompare(a.Colour, b.Colour)
+	})
+}
+
+// oklabDistSquared returns the square of the Euclidean distance between
+// the two Oklab values
+func oklabDistSquared(a, b Oklab) float64 {
+	dl, da, db := a.L-b.L, a.A-b.A, a.B-b.B
+
+	return dl*dl + da*da + db*db
+}
+
+// kMeans groups the colours into at most k clusters using the k-means
+// algorithm in the Oklab colour space. The initial cluster centres are
+// chosen deterministically: the most common colour first and then, in
+// turn, the colour with the greatest product of pixel count and squared
+// distance from the nearest existing centre. The iterations stop when no
+// colour moves to a different cluster; if that has not happened after
+// maxIter iterations the colours are assigned to the final centres so that
+// the counts returned always match the centres.
+func kMeans(hist []histEntry, k, maxIter int) []histEntry {
+	if len(hist) <= k {
+		return hist
+	}
+
+	labs := make([]Oklab, len(hist))
+	for i, h := range hist {
+		labs[i] = RGBA2Oklab(h.c)
+	}
+
+	first := 0
+
+	for i, h := range hist {
+		if h.count > hist[first].count {
+			first = i
+		}
+	}
+
+	centres := []Oklab{labs[first]}
+
+	minDist := make([]float64, len(hist))
+	for i := range hist {
+		minDist[i] = oklabDistSquared(labs[i], centres[0])
+	}
+
+	for len(centres) < k {
+		next, nextScore := -1, 0.0
+
+		for i, h := range hist {
+			if score := float64(h.count) * minDist[i]; score > nextScore {
+				next, nextScore = i, score
+			}
+		}
+
+		if next < 0 {
+			break
+		}
+
+		centres = append(centres, labs[next])
+		for i := range hist {
+			minDist[i] = min(minDist[i],
+				oklabDistSquared(labs[i], labs[next]))
+		}
+	}
+
+	assignment := make([]int, len(hist))
+	for i := range assignment {
+		assignment[i] = -1
+	}
+
+	counts := make([]int, len(centres))
+
+	// assign assigns each colour to its nearest centre and reports whether
+	// any colour has moved to a different cluster
+	assign := func() bool {
+		changed := false
+
+		for i := range hist {
+			best, bestDist := 0, math.Inf(1)
+
+			for j, c := range centres {
+				if d := oklabDistSquared(labs[i], c); d < bestDist {
+					best, bestDist = j, d
+				}
+			}
+
+			if assignment[i] != best {
+				assignment[i] = best
+				changed = true
+			}
+		}
+
+		return changed
+	}
+
+	converged := false
+
+	for range maxIter {
+		if !assign() {
+			converged = true
+			break
+		}
+
+		sums := make([]Oklab, len(centres))
+		clear(counts)
+
+		for i, h := range hist {
+			j := assignment[i]
+			n := float64(h.count)
+			sums[j].L += labs[i].L * n
+			sums[j].A += labs[i].A * n
+			sums[j].B += labs[i].B * n
+			counts[j] += h.count
+		}
+
+		for j, s := range sums {
+			if counts[j] == 0 {
+				continue
+			}
+
+			n := float64(counts[j])
+			centres[j] = Oklab{L: s.L / n, A: s.A / n, B: s.B / n}
+		}
+	}
+
+	if !converged {
+		assign()
+	}
+
+	clear(counts)
+
+	for i, h := range hist {
+		counts[assignment[i]] += h.count
+	}
+
+	clusters := make([]histEntry, 0, len(centres))
+	for j, c := range centres {
+		clusters = append(clusters,
+			histEntry{c: oklabToRGBA(c, math.MaxUint8), count: counts[j]})
+	}
+
+	return clusters
+}
+
+// meanColour returns the mean of the colours weighted by their pixel
+// counts, together with the total count
+func meanColour(hist []histEntry) histEntry {
+	var r, g, b, n int
+
+	for _, h := range hist {
+		r += int(h.c.R) * h.count
+		g += int(h.c.G) * h.count
+		b += int(h.c.B) * h.count
+		n += h.count
+	}
+
+	mean := func(v int) uint8 {
+		return uint8((v + n/2) / n) //nolint:mnd
+	}
+
+	return histEntry{
+		c:     rgba{R: mean(r), G: mean(g), B: mean(b), A: math.MaxUint8},
+		count: n,
+	}
+}
+
+// rgbChannel returns the value of the red (0), green (1) or blue (2)
+// channel of the colour
+func rgbChannel(c rgba, ch int) uint8 {
+	switch ch {
+	case 0:
+		return c.R
+	case 1:
+		return c.G
+	default:
+		return c.B
+	}
+}
+
+// widestChannel returns the RGB channel with the greatest range of values
+// in the colours and the size of that range
+func widestChannel(hist []histEntry) (int, int) {
+	bestCh, bestRange := 0, -1
+
+	for ch := range 3 {
+		lo, hi := uint8(math.MaxUint8), uint8(0)
+
+		for _, h := range hist {
+			v := rgbChannel(h.c, ch)
+			lo, hi = min(lo, v), max(hi, v)
+		}
+
+		if r := int(hi) - int(lo); r > bestRange {
+			bestCh, bestRange = ch, r
+		}
+	}
+
+	return bestCh, bestRange
+}
+
+// medianCut groups the colours into at most k clusters using the median
+// cut algorithm. The group of colours with the greatest range in any of
+// the red, green or blue channels is repeatedly split at the median pixel
+// along that channel.
+func medianCut(hist []histEntry, k int) []histEntry {
+	boxes := [][]histEntry{hist}
+
+	for len(boxes) < k {
+		split, splitCh, splitRange := -1, 0, 0
+
+		for i, box := range boxes {
+			if len(box) < 2 { //nolint:mnd
+				continue
+			}
+
+			if ch, r := widestChannel(box); r > splitRange {
+				split, splitCh, splitRange = i, ch, r
+			}
+		}
+
+		if split < 0 {
+			break
+		}
+
+		box := slices.Clone(boxes[split])
+		slices.SortStableFunc(box, func(a, b histEntry) int {
+			return cmp.Compare(rgbChannel(a.c, splitCh),
+				rgbChannel(b.c, splitCh))
+		})
+
+		total := 0
+		for _, h := range box {
+			total += h.count
+		}
+
+		cut, sum := 1, box[0].count
+		for cut < len(box)-1 && sum*2 < total {
+			sum += box[cut].count
+			cut++
+		}
+
+		boxes[split] = box[:cut]
+		boxes = append(boxes, box[cut:])
+	}
+
+	clusters := make([]histEntry, 0, len(boxes))
+	for _, box := range boxes {
+		clusters = append(clusters, meanColour(box))
+	}
+
+	return clusters
+}
+
+// octreeDepth is the depth of the octree, one level per bit of the red,
+// green and blue values
+const octreeDepth = 8
+
+// octreeNode is a node in the octree used for octree quantisation. Each
+// node records the sums of the colours of the pixels below it.
+type octreeNode struct {
+	children [8]*octreeNode
+	isLeaf   bool
+	r, g, b  int
+	count    int
+}
+
+// octree holds the state of an octree quantisation
+type octree struct {
+	root      *octreeNode
+	leaves    int
+	reducible [octreeDepth][]*octreeNode
+}
+
+// add adds the colour to the totals of the node
+func (n *octreeNode) add(h histEntry) {
+	n.r += int(h.c.R) * h.count
+	n.g += int(h.c.G) * h.count
+	n.b += int(h.c.B) * h.count
+	n.count += h.count
+}
+
+// insert adds the colour to the octree
+func (ot *octree) insert(h histEntry) {
+	node := ot.root
+	node.add(h)
+
+	for level := range octreeDepth {
+		shift := octreeDepth - 1 - level
+		idx := int(h.c.R>>shift&1)<<2 | //nolint:mnd
+			int(h.c.G>>shift&1)<<1 |
+			int(h.c.B>>shift&1)
+
+		child := node.children[idx]
+		if child == nil {
+			child = &octreeNode{}
+			node.children[idx] = child
+
+			if level == octreeDepth-1 {
+				child.isLeaf = true
+				ot.leaves++
+			} else {
+				ot.reducible[level+1] = append(ot.reducible[level+1], child)
+			}
+		}
+
+		node = child
+		node.add(h)
+	}
+}
+
+// prepare sorts the reducible nodes at each level so that those with the
+// fewest pixels are reduced first. It must be called after all the colours
+// have been inserted and before any nodes are reduced.
+func (ot *octree) prepare() {
+	ot.reducible[0] = []*octreeNode{ot.root}
+
+	for _, nodes := range ot.reducible {
+		slices.SortStableFunc(nodes, func(a, b *octreeNode) int {
+			return cmp.Compare(a.count, b.count)
+		})
+	}
+}
+
+// reduce merges the children of the deepest node having the fewest pixels
+// into that node, making it a leaf. The node already holds the totals of
+// its children.
+func (ot *octree) reduce() {
+	level := octreeDepth - 1
+	for level > 0 && len(ot.reducible[level]) == 0 {
+		level--
+	}
+
+	node := ot.reducible[level][0]
+	ot.reducible[level] = ot.reducible[level][1:]
+
+	merged := 0
+
+	for i, child := range node.children {
+		if child != nil {
+			merged++
+			node.children[i] = nil
+		}
+	}
+
+	node.isLeaf = true
+	ot.leaves -= merged - 1
+}
+
+// collect appends the mean colours of the leaves below the node
+func (n *octreeNode) collect(clusters []histEntry) []histEntry {
+	if n.isLeaf {
+		mean := func(v int) uint8 {
+			return uint8((v + n.count/2) / n.count) //nolint:mnd
+		}
+
+		return append(clusters, histEntry{
+			c: rgba{
+				R: mean(n.r), G: mean(n.g), B: mean(n.b), A: math.MaxUint8,
+			},
+			count: n.count,
+		})
+	}
+
+	for _, child := range n.children {
+		if child != nil {
+			clusters = child.collect(clusters)
+		}
+	}
+
+	return clusters
+}
+
+// octreeQuantise groups the colours into at most k clusters using octree
+// quantisation. The colours are placed in a tree with one level for each
+// bit of the red, green and blue values and the leaves are then merged,
+// deepest and least common first, until there are at most k of them.
+func octreeQuantise(hist []histEntry, k int) []histEntry {
+	ot := &octree{root: &octreeNode{}}
+
+	for _, h := range hist {
+		ot.insert(h)
+	}
+
+	ot.prepare()
+
+	for ot.leaves > k {
+		ot.reduce()
+	}
+
+	return ot.root.collect(nil)
+}
+
+// NamePalette returns a copy of the colours with each colour replaced by
+// the closest colour in the Families (see [Families.ClosestN]) and named
+// with the Family name and the colour name, for instance "pantone:navy
+// blazer". Where several colours are replaced by the same named colour
+// they are merged and their weights added together. The colours are
+// returned in order of decreasing weight.
+//
+// If no families are given then the standard families are used.
+func (fl Families) NamePalette(wcs []WeightedColour) ([]WeightedColour, error) {
+	named := []WeightedColour{}
+	idx := map[string]int{}
+
+	for _, wc := range wcs {
+		fcs, err := fl.ClosestN(wc.Colour, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(fcs) == 0 {
+			return nil, fmt.Errorf("no colour was found close to %v",
+				wc.Colour)
+		}
+
+		fc := fcs[0]
+
+		cName := fc.CNames[0]
+		for _, altCName := range fc.CNames[1:] {
+			cName = preferredName(cName, altCName)
+		}
+
+		name := fc.Family.Name() + ":" + cName
+
+		if i, ok := idx[name]; ok {
+			named[i].Weight += wc.Weight
+			continue
+		}
+
+		idx[name] = len(named)
+		named = append(named, WeightedColour{
+			Colour: fc.Colour,
+			Weight: wc.Weight,
+			Name:   name,
+		})
+	}
+
+	sortWeightedColours(named)
+
+	return named, nil
+}
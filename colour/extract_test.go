@@ -0,0 +1,327 @@
+package colour
+
+import (
+	"fmt"
+	"image"
+	"image/color" //nolint:misspell
+	"math"
+	"testing"
+
+	"github.com/nickwells/colour.mod/v2/colourtesthelper"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+// makeExtractTestImage returns a 10x10 image which is 60% shades of dark
+// blue, 30% near-white and 9% red with one transparent pixel and one
+// half-transparent pixel
+func makeExtractTestImage() *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, 10, 10))
+
+	for y := range 10 {
+		for x := range 10 {
+			c := color.NRGBA{ //nolint:misspell
+				R: 0xc0, G: 0x10, B: 0x10, A: 0xff,
+			}
+
+			switch {
+			case x < 6:
+				c.R, c.G, c.B = 0x10+uint8(y), 0x20, 0x60
+			case x < 9:
+				c.R, c.G, c.B = 0xf0, 0xf0+uint8(y&1), 0xf0
+			}
+
+			switch {
+			case x == 9 && y == 9:
+				c.A = 0
+			case x == 9 && y == 8:
+				c.A = 0x80
+			}
+
+			img.SetNRGBA(x, y, c)
+		}
+	}
+
+	return img
+}
+
+// diffWeightedColours reports any differences between the colours
+func diffWeightedColours(t *testing.T, id string, act, exp []WeightedColour) {
+	t.Helper()
+
+	if len(act) != len(exp) {
+		t.Log(id)
+		t.Errorf("\t: expected %d colours, got %d: %v",
+			len(exp), len(act), act)
+
+		return
+	}
+
+	for i, wc := range act {
+		colourtesthelper.DiffRGBA(t, id, "colour", wc.Colour, exp[i].Colour)
+		testhelper.DiffFloat(t, id, "weight", wc.Weight, exp[i].Weight, 1e-9)
+		testhelper.DiffString(t, id, "name", wc.Name, exp[i].Name)
+	}
+}
+
+func TestPaletteExtractor(t *testing.T) {
+	img := makeExtractTestImage()
+
+	var (
+		blue  = rgba{R: 0x15, G: 0x20, B: 0x60, A: 0xff}
+		white = rgba{R: 0xf0, G: 0xf1, B: 0xf0, A: 0xff}
+		red   = rgba{R: 0xc0, G: 0x10, B: 0x10, A: 0xff}
+	)
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		pe     PaletteExtractor
+		img    image.Image
+		expWCs []WeightedColour
+	}{
+		{
+			ID:     testhelper.MkID("bad count"),
+			ExpErr: testhelper.MkExpErr(BadColourCount),
+			pe:     PaletteExtractor{},
+			img:    img,
+		},
+		{
+			ID: testhelper.MkID("bad method"),
+			ExpErr: testhelper.MkExpErr(
+				"unknown extraction method: ExtractionMethod(9)"),
+			pe:  PaletteExtractor{Method: 9, Count: 1},
+			img: img,
+		},
+		{
+			ID: testhelper.MkID("empty image"),
+			ExpErr: testhelper.MkExpErr(
+				"the image has no pixels to extract colours from"),
+			pe:  PaletteExtractor{Count: 1},
+			img: image.NewNRGBA(image.Rect(0, 0, 2, 2)),
+		},
+		{
+			ID: testhelper.MkID("k-means, 3 colours"),
+			pe: PaletteExtractor{Method: KMeans, Count: 3},
+			expWCs: []WeightedColour{
+				{Colour: blue, Weight: 60.0 / 99},
+				{Colour: white, Weight: 30.0 / 99},
+				{Colour: red, Weight: 9.0 / 99},
+			},
+		},
+		{
+			ID: testhelper.MkID("k-means, 3 colours, MinAlpha"),
+			pe: PaletteExtractor{Method: KMeans, Count: 3, MinAlpha: 0x81},
+			expWCs: []WeightedColour{
+				{Colour: blue, Weight: 60.0 / 98},
+				{Colour: white, Weight: 30.0 / 98},
+				{Colour: red, Weight: 8.0 / 98},
+			},
+		},
+		{
+			ID: testhelper.MkID("k-means, 1 colour"),
+			pe: PaletteExtractor{Method: KMeans, Count: 1},
+			expWCs: []WeightedColour{
+				{Colour: rgba{R: 0x61, G: 0x60, B: 0x88, A: 0xff}, Weight: 1},
+			},
+		},
+		{
+			ID: testhelper.MkID("median cut, 3 colours"),
+			pe: PaletteExtractor{Method: MedianCut, Count: 3},
+			expWCs: []WeightedColour{
+				{
+					Colour: rgba{R: 0x32, G: 0x1d, B: 0x52, A: 0xff},
+					Weight: 51.0 / 99,
+				},
+				{
+					Colour: rgba{R: 0x7a, G: 0x7f, B: 0xa1, A: 0xff},
+					Weight: 33.0 / 99,
+				},
+				{Colour: white, Weight: 15.0 / 99},
+			},
+		},
+		{
+			ID: testhelper.MkID("median cut, 1 colour"),
+			pe: PaletteExtractor{Method: MedianCut, Count: 1},
+			expWCs: []WeightedColour{
+				{Colour: rgba{R: 0x67, G: 0x5e, B: 0x84, A: 0xff}, Weight: 1},
+			},
+		},
+		{
+			ID: testhelper.MkID("octree, 3 colours"),
+			pe: PaletteExtractor{Method: Octree, Count: 3},
+			expWCs: []WeightedColour{
+				{Colour: blue, Weight: 60.0 / 99},
+				{Colour: white, Weight: 30.0 / 99},
+				{Colour: red, Weight: 9.0 / 99},
+			},
+		},
+		{
+			ID: testhelper.MkID("octree, 1 colour"),
+			pe: PaletteExtractor{Method: Octree, Count: 1},
+			expWCs: []WeightedColour{
+				{Colour: rgba{R: 0x67, G: 0x5e, B: 0x84, A: 0xff}, Weight: 1},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		if tc.img == nil {
+			tc.img = img
+		}
+
+		wcs, err := tc.pe.Extract(tc.img)
+		if testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc) &&
+			err == nil {
+			diffWeightedColours(t, tc.IDStr(), wcs, tc.expWCs)
+		}
+	}
+}
+
+func TestPaletteExtractorDistinctColours(t *testing.T) {
+	img := makeExtractTestImage()
+
+	for _, m := range []ExtractionMethod{KMeans, MedianCut, Octree} {
+		id := m.String() + ": more colours than are in the image"
+
+		wcs, err := PaletteExtractor{Method: m, Count: 50}.Extract(img)
+		if err != nil {
+			t.Fatal(id, ": unexpected error:", err)
+		}
+
+		testhelper.DiffInt(t, id, "colour count", len(wcs), 13)
+
+		total := 0.0
+		for _, wc := range wcs {
+			total += wc.Weight
+		}
+
+		testhelper.DiffFloat(t, id, "total weight", total, 1, 1e-9)
+	}
+}
+
+func TestKMeansCounts(t *testing.T) {
+	var hist []histEntry
+
+	for i := range 64 {
+		hist = append(hist, histEntry{
+			c: rgba{
+				R: uint8(i&3) * 0x55,
+				G: uint8(i>>2&3) * 0x55,
+				B: uint8(i>>4&3) * 0x55,
+				A: 0xff,
+			},
+			count: i*7%5 + 1,
+		})
+	}
+
+	for _, maxIter := range []int{1, 2, 3, dfltMaxIterations} {
+		id := fmt.Sprintf("k-means, %d iterations", maxIter)
+		clusters := kMeans(hist, 4, maxIter)
+
+		expCounts := make([]int, len(clusters))
+
+		for _, h := range hist {
+			lab := RGBA2Oklab(h.c)
+			best, bestDist := 0, math.Inf(1)
+
+			for j, cl := range clusters {
+				d := oklabDistSquared(lab, RGBA2Oklab(cl.c))
+				if d < bestDist {
+					best, bestDist = j, d
+				}
+			}
+
+			expCounts[best] += h.count
+		}
+
+		for j, cl := range clusters {
+			testhelper.DiffInt(t, id, fmt.Sprintf("cluster %d count", j),
+				cl.count, expCounts[j])
+		}
+	}
+}
+
+func TestMakeWeightedColours(t *testing.T) {
+	red := rgba{R: 0xff, A: 0xff}
+	blue := rgba{B: 0xff, A: 0xff}
+
+	wcs := makeWeightedColours([]histEntry{
+		{c: blue, count: 3},
+		{c: red, count: 4},
+		{c: blue, count: 2},
+		{c: red, count: 0},
+		{c: red, count: 1},
+	})
+
+	diffWeightedColours(t, "duplicate colours", wcs, []WeightedColour{
+		{Colour: blue, Weight: 0.5},
+		{Colour: red, Weight: 0.5},
+	})
+}
+
+func TestNamePalette(t *testing.T) {
+	wcs := []WeightedColour{
+		{Colour: rgba{R: 0x10, G: 0x20, B: 0x60, A: 0xff}, Weight: 0.2},
+		{Colour: rgba{R: 0xf0, G: 0xf0, B: 0xf0, A: 0xff}, Weight: 0.25},
+		{Colour: rgba{R: 0x11, G: 0x20, B: 0x60, A: 0xff}, Weight: 0.4},
+		{Colour: rgba{R: 0xc0, G: 0x10, B: 0x10, A: 0xff}, Weight: 0.15},
+	}
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		fl      Families
+		expWCs  []WeightedColour
+		expDesc string
+	}{
+		{
+			ID:     testhelper.MkID("bad family"),
+			ExpErr: testhelper.MkExpErr(`"nonesuch" is not a valid Family`),
+			fl:     Families{"nonesuch"},
+		},
+		{
+			ID: testhelper.MkID("web colours"),
+			fl: Families{WebColours},
+			expWCs: []WeightedColour{
+				{
+					Colour: rgba{B: 0x80, A: 0xff},
+					Weight: 0.6,
+					Name:   "web:navy",
+				},
+				{
+					Colour: rgba{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+					Weight: 0.25,
+					Name:   "web:white",
+				},
+				{
+					Colour: rgba{R: 0xff, A: 0xff},
+					Weight: 0.15,
+					Name:   "web:red",
+				},
+			},
+			expDesc: "60% web:navy, 25% web:white, 15% web:red",
+		},
+	}
+
+	for _, tc := range testCases {
+		named, err := tc.fl.NamePalette(wcs)
+		if testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc) &&
+			err == nil {
+			diffWeightedColours(t, tc.IDStr(), named, tc.expWCs)
+			testhelper.DiffString(t, tc.IDStr(), "description",
+				DescribePalette(named), tc.expDesc)
+		}
+	}
+}
+
+func TestDescribePalette(t *testing.T) {
+	wcs := []WeightedColour{
+		{Colour: rgba{R: 0xff, G: 0xff, B: 0xff, A: 0xff}, Weight: 0.5},
+		{Colour: rgba{R: 1, G: 2, B: 3, A: 0xff}, Weight: 0.25},
+		{Colour: rgba{R: 1, G: 2, B: 3, A: 0xff}, Weight: 0.25, Name: "x"},
+	}
+
+	testhelper.DiffString(t, "mixed", "description", DescribePalette(wcs),
+		"50% white, 25% "+Describe(wcs[1].Colour)+", 25% x")
+	testhelper.DiffString(t, "empty", "description", DescribePalette(nil), "")
+}
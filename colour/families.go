@@ -33,6 +33,25 @@ func (fl Families) familyColours() []familyToColourMap {
 		CrayolaColours:        crayolaColours,
 		XKCDColours:           xkcdColours,
 		EncycolorpediaColours: encycolorpediaColours,
+		XTerm256Colours:       xterm256Colours,
+		ANSI16Colours:         ansi16Colours,
+		SolarizedColours:      solarizedColours,
+		DraculaColours:        draculaColours,
+		GruvboxColours:        gruvboxColours,
+		NordColours:           nordColours,
+		CatppuccinColours:     catppuccinColours,
+		RALClassicColours:     ralClassicColours,
+		RALDesignColours:      ralDesignColours,
+		BS381CColours:         bs381cColours,
+		BS4800Colours:         bs4800Colours,
+		FS595Colours:          fs595Colours,
+		MaterialColours:       materialColours,
+		TailwindColours:       tailwindColours,
+		CSS4Colours:           css4Colours,
+		ISCCNBSColours:        isccNBSColours,
+		MunsellColours:        munsellColours,
+		JapaneseColours:       japaneseColours,
+		ChineseColours:        chineseColours,
 	}
 
 	fcs := []familyToColourMap{}
@@ -43,7 +62,7 @@ func (fl Families) familyColours() []familyToColourMap {
 			panic(badFamilyErr(f))
 		}
 
-		fcs = append(fcs, familyToColourMap{f, cnm})
+		fcs = append(fcs, familyToColourMap{f: f, cMap: cnm})
 	}
 
 	return fcs
@@ -109,8 +128,9 @@ func (fl Families) Check() error {
 
 // AllColours returns a slice containing all the colours in each of the given
 // Family elements in the list. The returned value has distinct entries (no
-// colour appears twice) but in a random order. A non-nil error is returned
-// if any Familly in the list is not recognised.
+// colour appears twice) but in a random order. The CSS4 system colours,
+// whose values depend on a CSSSystemTheme, are not included. A non-nil error
+// is returned if any Familly in the list is not recognised.
 func (fl Families) AllColours() ([]color.RGBA, error) { //nolint:misspell
 	var colours []rgba
 
@@ -160,6 +180,12 @@ func (fl Families) AllColourNames() ([]string, error) { //nolint:misspell
 				colourMap[name] = true
 			}
 		}
+
+		if fi.hasSystemColours {
+			for _, name := range cssSystemColourNames {
+				colourMap[name] = true
+			}
+		}
 	}
 
 	return slices.Collect(maps.Keys(colourMap)), nil
@@ -36,6 +36,25 @@ const (
 	CrayolaColours        Family = "Crayola"
 	XKCDColours           Family = "xkcd"
 	EncycolorpediaColours Family = "Encycolorpedia"
+	XTerm256Colours       Family = "xterm"
+	ANSI16Colours         Family = "ANSI"
+	SolarizedColours      Family = "Solarized"
+	DraculaColours        Family = "Dracula"
+	GruvboxColours        Family = "Gruvbox"
+	NordColours           Family = "Nord"
+	CatppuccinColours     Family = "Catppuccin"
+	RALClassicColours     Family = "RAL"
+	RALDesignColours      Family = "RALDesign"
+	BS381CColours         Family = "BS381C"
+	BS4800Colours         Family = "BS4800"
+	FS595Colours          Family = "FS595"
+	MaterialColours       Family = "Material"
+	TailwindColours       Family = "Tailwind"
+	CSS4Colours           Family = "CSS4"
+	ISCCNBSColours        Family = "ISCC-NBS"
+	MunsellColours        Family = "Munsell"
+	JapaneseColours       Family = "Japanese"
+	ChineseColours        Family = "Chinese"
 )
 
 // Some aliases for people who use Merriam-Webster rather than the OED
@@ -50,6 +69,25 @@ const (
 	CrayolaColors        = CrayolaColours
 	XKCDColors           = XKCDColours
 	EncycolorpediaColors = EncycolorpediaColours
+	XTerm256Colors       = XTerm256Colours
+	ANSI16Colors         = ANSI16Colours
+	SolarizedColors      = SolarizedColours
+	DraculaColors        = DraculaColours
+	GruvboxColors        = GruvboxColours
+	NordColors           = NordColours
+	CatppuccinColors     = CatppuccinColours
+	RALClassicColors     = RALClassicColours
+	RALDesignColors      = RALDesignColours
+	BS381CColors         = BS381CColours
+	BS4800Colors         = BS4800Colours
+	FS595Colors          = FS595Colours
+	MaterialColors       = MaterialColours
+	TailwindColors       = TailwindColours
+	CSS4Colors           = CSS4Colours
+	ISCCNBSColors        = ISCCNBSColours
+	MunsellColors        = MunsellColours
+	JapaneseColors       = JapaneseColours
+	ChineseColors        = ChineseColours
 )
 
 // colourNameToRGBA is the type of the structures mapping the text names to
@@ -68,6 +106,10 @@ type familyInfo struct {
 	name        string
 	description string
 	colours     []familyToColourMap
+	// hasSystemColours is set if the CSS system colours can be found by name
+	// in the family. Their values are taken from a CSSSystemTheme and so
+	// they are not in the colour maps.
+	hasSystemColours bool
 }
 
 // allFamilies maps from a Family.Name to the associated familyInfo. There is
@@ -116,7 +158,7 @@ var allFamilies = map[string]familyInfo{
 		name:        FarrowAndBallColours.Name(),
 		description: "Farrow And Ball paint colours",
 		colours: []familyToColourMap{
-			{FarrowAndBallColours, farrowAndBallColours},
+			{f: FarrowAndBallColours, cMap: farrowAndBallColours},
 		},
 	},
 	CrayolaColours.Name(): {
@@ -138,6 +180,143 @@ var allFamilies = map[string]familyInfo{
 			" web page as of 2026/Mar/02",
 		colours: Families{EncycolorpediaColours}.familyColours(),
 	},
+	XTerm256Colours.Name(): {
+		id:   XTerm256Colours,
+		name: XTerm256Colours.Name(),
+		description: "the xterm 256-colour terminal palette," +
+			" named by index",
+		colours: Families{XTerm256Colours}.familyColours(),
+	},
+	ANSI16Colours.Name(): {
+		id:          ANSI16Colours,
+		name:        ANSI16Colours.Name(),
+		description: "the 16 ANSI terminal colours, with the CGA values",
+		colours:     Families{ANSI16Colours}.familyColours(),
+	},
+	SolarizedColours.Name(): {
+		id:          SolarizedColours,
+		name:        SolarizedColours.Name(),
+		description: "the Solarized colour scheme by Ethan Schoonover",
+		colours:     Families{SolarizedColours}.familyColours(),
+	},
+	DraculaColours.Name(): {
+		id:          DraculaColours,
+		name:        DraculaColours.Name(),
+		description: "the Dracula colour scheme by Zeno Rocha",
+		colours:     Families{DraculaColours}.familyColours(),
+	},
+	GruvboxColours.Name(): {
+		id:          GruvboxColours,
+		name:        GruvboxColours.Name(),
+		description: "the Gruvbox colour scheme by Pavel Pertsev",
+		colours:     Families{GruvboxColours}.familyColours(),
+	},
+	NordColours.Name(): {
+		id:          NordColours,
+		name:        NordColours.Name(),
+		description: "the Nord colour scheme by Arctic Ice Studio",
+		colours:     Families{NordColours}.familyColours(),
+	},
+	CatppuccinColours.Name(): {
+		id:          CatppuccinColours,
+		name:        CatppuccinColours.Name(),
+		description: "the Catppuccin Mocha colour scheme",
+		colours:     Families{CatppuccinColours}.familyColours(),
+	},
+	RALClassicColours.Name(): {
+		id:   RALClassicColours,
+		name: RALClassicColours.Name(),
+		description: "colour codes and names from the RAL Classic" +
+			" collection (RAL K7), approximate sRGB values" +
+			" from RAL gGmbH (www.ral-farben.de) as of 2024",
+		colours: Families{RALClassicColours}.familyColours(),
+	},
+	RALDesignColours.Name(): {
+		id:   RALDesignColours,
+		name: RALDesignColours.Name(),
+		description: "colour codes from the RAL Design System plus" +
+			" by RAL gGmbH (www.ral-farben.de), such as \"210 50 15\"," +
+			" giving the CIELCh hue, lightness and chroma; the sRGB" +
+			" values are calculated from the codes",
+		colours: Families{RALDesignColours}.familyColours(),
+	},
+	BS381CColours.Name(): {
+		id:   BS381CColours,
+		name: BS381CColours.Name(),
+		description: "colour numbers and names from British Standard" +
+			" BS 381C:1996, unofficial approximate sRGB values",
+		colours: Families{BS381CColours}.familyColours(),
+	},
+	BS4800Colours.Name(): {
+		id:   BS4800Colours,
+		name: BS4800Colours.Name(),
+		description: "colour codes from British Standard BS 4800:2011" +
+			" (with some trade names), unofficial approximate sRGB values",
+		colours: Families{BS4800Colours}.familyColours(),
+	},
+	FS595Colours.Name(): {
+		id:   FS595Colours,
+		name: FS595Colours.Name(),
+		description: "a selection of 34 colour numbers, with informal" +
+			" names, from US Federal Standard 595C (GSA, 2008)," +
+			" unofficial approximate sRGB values",
+		colours: Families{FS595Colours}.familyColours(),
+	},
+	MaterialColours.Name(): {
+		id:   MaterialColours,
+		name: MaterialColours.Name(),
+		description: "the Material Design colour palette," +
+			" named by hue and shade (such as \"blue 500\")",
+		colours: Families{MaterialColours}.familyColours(),
+	},
+	TailwindColours.Name(): {
+		id:   TailwindColours,
+		name: TailwindColours.Name(),
+		description: "the Tailwind CSS colour palette," +
+			" named by hue and shade (such as \"blue 500\")",
+		colours: Families{TailwindColours}.familyColours(),
+	},
+	CSS4Colours.Name(): {
+		id:   CSS4Colours,
+		name: CSS4Colours.Name(),
+		description: "the named colours from CSS Color Module Level 4," +
+			" including \"transparent\" and the system colours" +
+			" (from a theme, these are not matched by colour)",
+		colours:          Families{CSS4Colours}.familyColours(),
+		hasSystemColours: true,
+	},
+	ISCCNBSColours.Name(): {
+		id:   ISCCNBSColours,
+		name: ISCCNBSColours.Name(),
+		description: "the centroid colours of the 267 ISCC-NBS colour" +
+			" categories, named by number and by level 3 name",
+		colours: Families{ISCCNBSColours}.familyColours(),
+	},
+	MunsellColours.Name(): {
+		id:   MunsellColours,
+		name: MunsellColours.Name(),
+		description: "a grid of Munsell chips (such as \"5yr 4/6\")," +
+			" approximate sRGB values calculated from the notation" +
+			" by an analytic model, not from the Munsell renotation data",
+		colours: Families{MunsellColours}.familyColours(),
+	},
+	JapaneseColours.Name(): {
+		id:   JapaneseColours,
+		name: JapaneseColours.Name(),
+		description: "a selection of 64 traditional Japanese colours" +
+			" from NIPPON COLORS (nipponcolors.com), named in Japanese" +
+			" script and by romanisation (such as \"紅梅\" or \"kōbai\")",
+		colours: Families{JapaneseColours}.familyColours(),
+	},
+	ChineseColours.Name(): {
+		id:   ChineseColours,
+		name: ChineseColours.Name(),
+		description: "a selection of 45 traditional Chinese colours" +
+			" from the widely reproduced 中国传统色彩 table, named in" +
+			" Chinese characters and by pinyin" +
+			" (such as \"葱绿\" or \"cōnglǜ\")",
+		colours: Families{ChineseColours}.familyColours(),
+	},
 }
 
 // GetFamily returns the Family for the given family name. If the family name
@@ -223,11 +402,16 @@ func (f Family) ColourNameCount() (int, error) {
 		colourCount += len(fc.cMap)
 	}
 
+	if fi.hasSystemColours {
+		colourCount += len(cssSystemColourNames)
+	}
+
 	return colourCount, nil
 }
 
 // DistinctColourCount returns the number of distinct colours in the colour
-// Family.
+// Family. The CSS4 system colours, whose values depend on a CSSSystemTheme,
+// are not counted.
 //
 // The error is non-nil if the Family is not valid, that is if it is not in
 // the collection of known colour families. It is advisable to use the Family
@@ -277,6 +461,12 @@ func (f Family) ColourNames() ([]string, error) {
 		}
 	}
 
+	if fi.hasSystemColours {
+		for _, cn := range cssSystemColourNames {
+			nameMap[cn] = true
+		}
+	}
+
 	for n := range nameMap {
 		names = append(names, n)
 	}
@@ -286,7 +476,8 @@ func (f Family) ColourNames() ([]string, error) {
 
 // AllColours returns a slice containing all the colours in the given
 // Family. The returned value has distinct entries (no colour appears twice)
-// but in a random order.
+// but in a random order. The CSS4 system colours, whose values depend on a
+// CSSSystemTheme, are not included.
 func (f Family) AllColours() ([]color.RGBA, error) { //nolint:misspell
 	return Families{f}.AllColours()
 }
@@ -295,7 +486,22 @@ func (f Family) AllColours() ([]color.RGBA, error) { //nolint:misspell
 // Family has multiple colour maps then the first matching colour is
 // returned. A non-nil error is returned if the Family is not recognised or
 // if the colour name is not found.
+//
+// The CSS system colours (such as "canvas") in the CSS4 family are taken
+// from the light theme, use ThemedColour to give a different theme.
 func (f Family) Colour(cName string) (color.RGBA, error) { //nolint:misspell
+	return f.ThemedColour(nil, cName)
+}
+
+// ThemedColour returns the RGBA colour of the given name in the given
+// Family, as for Colour, except that the CSS system colours (such as
+// "canvas") in the CSS4 family are taken from the given theme. If the theme
+// is nil or has no colour for the name the light theme is used.
+//
+//nolint:misspell
+func (f Family) ThemedColour(t CSSSystemTheme, cName string) (
+	color.RGBA, error,
+) {
 	fi, ok := f.info()
 	if !ok {
 		return rgba{}, badFamilyErr(f)
@@ -307,5 +513,11 @@ func (f Family) Colour(cName string) (color.RGBA, error) { //nolint:misspell
 		}
 	}
 
+	if fi.hasSystemColours {
+		if c, ok := t.colour(cName); ok {
+			return c, nil
+		}
+	}
+
 	return rgba{}, badColourErr(cName)
 }
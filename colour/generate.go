@@ -3,3 +3,14 @@
 package colour
 
 //go:generate stringer -linecomment -type RoughColour
+//go:generate stringer -linecomment -type CAT
+//go:generate stringer -linecomment -type Observer
+//go:generate stringer -linecomment -type Illuminant
+//go:generate stringer -linecomment -type WorkingSpace
+//go:generate stringer -linecomment -type CVD
+//go:generate stringer -linecomment -type ExtractionMethod
+//go:generate stringer -linecomment -type DistanceMetric
+//go:generate stringer -linecomment -type DitherMethod
+//go:generate stringer -linecomment -type TerminalMode
+//go:generate stringer -linecomment -type SchemeFormat
+//go:generate stringer -linecomment -type ApproxQualifier
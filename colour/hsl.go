@@ -37,9 +37,18 @@ func (hsl HSL) ToRGBA() color.RGBA { //nolint:misspell
 	chroma := (1 - math.Abs(2*hsl.Luminance-1)) * //nolint:mnd
 		hsl.Saturation
 
-	h := math.Mod(hsl.Hue, maxHue) / colourInterval
+	m := hsl.Luminance - chroma/2 //nolint:mnd
+
+	return hueChromaToRGBA(hsl.Hue, chroma, m)
+}
+
+// hueChromaToRGBA returns the RGBA colour with the given hue (in degrees),
+// chroma and lightness offset, m, which is added to each of the red, green
+// and blue values. The values are all in the range [0, 1]. The alpha value
+// is set to 0xff.
+func hueChromaToRGBA(hue, chroma, m float64) color.RGBA { //nolint:misspell
+	h := math.Mod(hue, maxHue) / colourInterval
 	x := chroma * (1 - math.Abs(math.Mod(h, 2)-1)) //nolint:mnd
-	m := hsl.Luminance - chroma/2                  //nolint:mnd
 
 	var r, g, b float64
 
@@ -1,5 +1,10 @@
 package colour
 
+import (
+	"fmt"
+	"image/color" //nolint:misspell
+)
+
 // HSV represents a colour defined by Hue, Saturation and Value.
 type HSV struct {
 	// Hue is a number in the range [0, 360). Zero represents red, 120
@@ -11,3 +16,27 @@ type HSV struct {
 	// Value is a value in the range [0, 1]
 	Value float64
 }
+
+// String returns a string representation of the HSV value
+func (hsv HSV) String() string {
+	return fmt.Sprintf("{H:%3.0f S:%0.3f V:%0.3f}",
+		hsv.Hue, hsv.Saturation, hsv.Value)
+}
+
+// ToRGBA converts an HSV colour value into an RGBA value. The alpha value
+// is forced to 0xff. Note that the conversions between HSV and RGBA values
+// are lossy; that is, converting an RGBA value to an HSV value and back
+// again is not guaranteed to generate the original colour.
+func (hsv HSV) ToRGBA() color.RGBA { //nolint:misspell
+	chroma := hsv.Value * hsv.Saturation
+
+	return hueChromaToRGBA(hsv.Hue, chroma, hsv.Value-chroma)
+}
+
+// RGBA satisfies the Color interface from the [image/color] package
+//
+//nolint:misspell
+func (hsv HSV) RGBA() (r, g, b, a uint32) {
+	c := hsv.ToRGBA()
+	return c.RGBA()
+}
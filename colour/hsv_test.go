@@ -0,0 +1,51 @@
+package colour
+
+import (
+	"testing"
+
+	"github.com/nickwells/colour.mod/v2/colourtesthelper"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestHSVToRGBA(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		hsv       HSV
+		expColour rgba
+	}{
+		{
+			ID:        testhelper.MkID("black"),
+			hsv:       HSV{},
+			expColour: rgba{A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("white"),
+			hsv:       HSV{Value: 1},
+			expColour: rgba{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("red"),
+			hsv:       HSV{Saturation: 1, Value: 1},
+			expColour: rgba{R: 0xff, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("dark green"),
+			hsv:       HSV{Hue: 120, Saturation: 1, Value: 0.5},
+			expColour: rgba{G: 0x80, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("pale blue"),
+			hsv:       HSV{Hue: 200, Saturation: 0.5, Value: 0.8},
+			expColour: rgba{R: 0x66, G: 0xaa, B: 0xcc, A: 0xff},
+		},
+	}
+
+	for _, tc := range testCases {
+		colourtesthelper.DiffRGBA(t, tc.IDStr(), "colour",
+			tc.hsv.ToRGBA(), tc.expColour)
+
+		_, hsv := RGBA2HSLAndHSV(tc.expColour)
+		colourtesthelper.DiffRGBA(t, tc.IDStr(), "round trip",
+			hsv.ToRGBA(), tc.expColour)
+	}
+}
@@ -0,0 +1,278 @@
+package colour
+
+// daylightComponents holds the S0, S1 and S2 characteristic vectors used to
+// construct the spectral power distribution of a CIE daylight illuminant
+// from 380nm to 780nm at 10nm intervals. As the CIE specifies, values at
+// intermediate wavelengths are found by linear interpolation.
+//
+//nolint:mnd
+var daylightComponents = spectralTable{
+	start: 380,
+	step:  10,
+	vals: [][3]float64{
+		{63.4, 38.5, 3.0},   // 380
+		{65.8, 35.0, 1.2},   // 390
+		{94.8, 43.4, -1.1},  // 400
+		{104.8, 46.3, -0.5}, // 410
+		{105.9, 43.9, -0.7}, // 420
+		{96.8, 37.1, -1.2},  // 430
+		{113.9, 36.7, -2.6}, // 440
+		{125.6, 35.9, -2.9}, // 450
+		{125.5, 32.6, -2.8}, // 460
+		{121.3, 27.9, -2.6}, // 470
+		{121.3, 24.3, -2.6}, // 480
+		{113.5, 20.1, -1.8}, // 490
+		{113.1, 16.2, -1.5}, // 500
+		{110.8, 13.2, -1.3}, // 510
+		{106.5, 8.6, -1.2},  // 520
+		{108.8, 6.1, -1.0},  // 530
+		{105.3, 4.2, -0.5},  // 540
+		{104.4, 1.9, -0.3},  // 550
+		{100.0, 0.0, 0.0},   // 560
+		{96.0, -1.6, 0.2},   // 570
+		{95.1, -3.5, 0.5},   // 580
+		{89.1, -3.5, 2.1},   // 590
+		{90.5, -5.8, 3.2},   // 600
+		{90.3, -7.2, 4.1},   // 610
+		{88.4, -8.6, 4.7},   // 620
+		{84.0, -9.5, 5.1},   // 630
+		{85.1, -10.9, 6.7},  // 640
+		{81.9, -10.7, 7.3},  // 650
+		{82.6, -12.0, 8.6},  // 660
+		{84.9, -14.0, 9.8},  // 670
+		{81.3, -13.6, 10.2}, // 680
+		{71.9, -12.0, 8.3},  // 690
+		{74.3, -13.3, 9.6},  // 700
+		{76.4, -12.9, 8.5},  // 710
+		{63.3, -10.6, 7.0},  // 720
+		{71.7, -11.6, 7.6},  // 730
+		{77.0, -12.2, 8.0},  // 740
+		{65.2, -10.2, 6.7},  // 750
+		{47.7, -7.8, 5.2},   // 760
+		{68.6, -11.2, 7.4},  // 770
+		{65.0, -10.4, 6.8},  // 780
+	},
+}
+
+// fluorescentF1 holds the relative spectral power distribution of CIE
+// illuminant F1 (daylight fluorescent, 6430K) from 380nm to 780nm at 5nm
+// intervals.
+//
+//nolint:mnd
+var fluorescentF1 = []float64{
+	1.87, 2.36, 2.94, 3.47, 5.17, 19.49, 6.13, 6.24, // 380nm
+	7.01, 7.79, 8.56, 43.67, 16.94, 10.72, 11.35, 11.89, // 420nm
+	12.37, 12.75, 13.00, 13.15, 13.23, 13.17, 13.13, 12.85, // 460nm
+	12.52, 12.20, 11.83, 11.50, 11.22, 11.05, 11.03, 11.18, // 500nm
+	11.53, 27.74, 17.05, 13.55, 14.33, 15.01, 15.52, 18.29, // 540nm
+	19.55, 15.48, 14.91, 14.15, 13.22, 12.19, 11.12, 10.03, // 580nm
+	8.95, 7.96, 7.02, 6.20, 5.42, 4.73, 4.15, 3.64, // 620nm
+	3.20, 2.81, 2.47, 2.18, 1.93, 1.72, 1.67, 1.43, // 660nm
+	1.29, 1.19, 1.08, 0.96, 0.88, 0.81, 0.77, 0.75, // 700nm
+	0.73, 0.68, 0.69, 0.64, 0.68, 0.69, 0.61, 0.52, // 740nm
+	0.43, // 780nm
+}
+
+// fluorescentF2 holds the relative spectral power distribution of CIE
+// illuminant F2 (cool white fluorescent, 4230K) from 380nm to 780nm at 5nm
+// intervals.
+//
+//nolint:mnd
+var fluorescentF2 = []float64{
+	1.18, 1.48, 1.84, 2.15, 3.44, 15.69, 3.85, 3.74, // 380nm
+	4.19, 4.62, 5.06, 34.98, 11.81, 6.27, 6.63, 6.93, // 420nm
+	7.19, 7.40, 7.54, 7.62, 7.65, 7.62, 7.62, 7.45, // 460nm
+	7.28, 7.15, 7.05, 7.04, 7.16, 7.47, 8.04, 8.88, // 500nm
+	10.01, 24.88, 16.64, 14.59, 16.16, 17.56, 18.62, 21.47, // 540nm
+	22.79, 19.29, 18.66, 17.73, 16.54, 15.21, 13.80, 12.36, // 580nm
+	10.95, 9.65, 8.40, 7.32, 6.31, 5.43, 4.68, 4.02, // 620nm
+	3.45, 2.96, 2.55, 2.19, 1.89, 1.64, 1.53, 1.27, // 660nm
+	1.10, 0.99, 0.88, 0.76, 0.68, 0.61, 0.56, 0.54, // 700nm
+	0.51, 0.47, 0.47, 0.43, 0.46, 0.47, 0.40, 0.33, // 740nm
+	0.27, // 780nm
+}
+
+// fluorescentF3 holds the relative spectral power distribution of CIE
+// illuminant F3 (white fluorescent, 3450K) from 380nm to 780nm at 5nm
+// intervals.
+//
+//nolint:mnd
+var fluorescentF3 = []float64{
+	0.82, 1.02, 1.26, 1.44, 2.57, 14.36, 2.70, 2.45, // 380nm
+	2.73, 3.00, 3.28, 31.85, 9.47, 4.02, 4.25, 4.44, // 420nm
+	4.59, 4.72, 4.80, 4.86, 4.87, 4.85, 4.88, 4.77, // 460nm
+	4.67, 4.62, 4.62, 4.73, 4.99, 5.48, 6.25, 7.34, // 500nm
+	8.78, 23.82, 16.14, 14.59, 16.63, 18.49, 19.95, 23.11, // 540nm
+	24.69, 21.41, 20.85, 19.93, 18.67, 17.22, 15.65, 14.04, // 580nm
+	12.45, 10.95, 9.51, 8.27, 7.11, 6.09, 5.22, 4.45, // 620nm
+	3.80, 3.23, 2.75, 2.33, 1.99, 1.70, 1.55, 1.27, // 660nm
+	1.09, 0.96, 0.83, 0.71, 0.62, 0.54, 0.49, 0.46, // 700nm
+	0.43, 0.39, 0.39, 0.35, 0.38, 0.39, 0.33, 0.28, // 740nm
+	0.21, // 780nm
+}
+
+// fluorescentF4 holds the relative spectral power distribution of CIE
+// illuminant F4 (warm white fluorescent, 2940K) from 380nm to 780nm at 5nm
+// intervals.
+//
+//nolint:mnd
+var fluorescentF4 = []float64{
+	0.57, 0.70, 0.87, 0.98, 2.01, 13.75, 1.95, 1.59, // 380nm
+	1.76, 1.93, 2.10, 30.28, 8.03, 2.55, 2.70, 2.82, // 420nm
+	2.91, 2.99, 3.04, 3.08, 3.09, 3.09, 3.14, 3.06, // 460nm
+	3.00, 2.98, 3.01, 3.14, 3.41, 3.90, 4.69, 5.81, // 500nm
+	7.32, 22.59, 15.11, 13.88, 16.33, 18.68, 20.64, 24.28, // 540nm
+	26.26, 23.28, 22.94, 22.14, 20.91, 19.43, 17.74, 16.00, // 580nm
+	14.42, 12.56, 10.93, 9.52, 8.18, 7.01, 6.00, 5.11, // 620nm
+	4.36, 3.69, 3.13, 2.64, 2.24, 1.91, 1.70, 1.39, // 660nm
+	1.18, 1.03, 0.88, 0.74, 0.64, 0.54, 0.49, 0.46, // 700nm
+	0.42, 0.37, 0.37, 0.33, 0.35, 0.36, 0.31, 0.26, // 740nm
+	0.19, // 780nm
+}
+
+// fluorescentF5 holds the relative spectral power distribution of CIE
+// illuminant F5 (daylight fluorescent, 6350K) from 380nm to 780nm at 5nm
+// intervals.
+//
+//nolint:mnd
+var fluorescentF5 = []float64{
+	1.87, 2.35, 2.92, 3.45, 5.10, 18.91, 6.00, 6.11, // 380nm
+	6.85, 7.58, 8.31, 40.76, 16.06, 10.32, 10.91, 11.40, // 420nm
+	11.83, 12.17, 12.40, 12.54, 12.58, 12.52, 12.47, 12.20, // 460nm
+	11.89, 11.61, 11.33, 11.10, 10.96, 10.97, 11.16, 11.54, // 500nm
+	12.12, 27.78, 17.73, 14.47, 15.20, 15.77, 16.10, 18.54, // 540nm
+	19.50, 15.39, 14.64, 13.72, 12.69, 11.57, 10.45, 9.35, // 580nm
+	8.29, 7.32, 6.41, 5.63, 4.90, 4.26, 3.72, 3.25, // 620nm
+	2.83, 2.49, 2.19, 1.93, 1.71, 1.52, 1.48, 1.26, // 660nm
+	1.13, 1.05, 0.96, 0.85, 0.78, 0.72, 0.68, 0.67, // 700nm
+	0.65, 0.61, 0.62, 0.59, 0.62, 0.64, 0.55, 0.47, // 740nm
+	0.40, // 780nm
+}
+
+// fluorescentF6 holds the relative spectral power distribution of CIE
+// illuminant F6 (lite white fluorescent, 4150K) from 380nm to 780nm at 5nm
+// intervals.
+//
+//nolint:mnd
+var fluorescentF6 = []float64{
+	1.05, 1.31, 1.63, 1.90, 3.11, 14.80, 3.43, 3.30, // 380nm
+	3.68, 4.07, 4.45, 32.61, 10.74, 5.48, 5.78, 6.03, // 420nm
+	6.25, 6.41, 6.52, 6.58, 6.59, 6.56, 6.56, 6.42, // 460nm
+	6.28, 6.20, 6.19, 6.30, 6.60, 7.12, 7.94, 9.07, // 500nm
+	10.49, 25.22, 17.46, 15.63, 17.22, 18.53, 19.43, 21.97, // 540nm
+	23.01, 19.41, 18.56, 17.42, 16.09, 14.64, 13.15, 11.68, // 580nm
+	10.25, 8.96, 7.74, 6.69, 5.71, 4.87, 4.16, 3.55, // 620nm
+	3.02, 2.57, 2.20, 1.87, 1.60, 1.37, 1.29, 1.05, // 660nm
+	0.91, 0.81, 0.71, 0.61, 0.54, 0.48, 0.44, 0.43, // 700nm
+	0.40, 0.37, 0.38, 0.35, 0.39, 0.41, 0.33, 0.26, // 740nm
+	0.21, // 780nm
+}
+
+// fluorescentF7 holds the relative spectral power distribution of CIE
+// illuminant F7 (broadband D65 simulator, 6500K) from 380nm to 780nm at 5nm
+// intervals.
+//
+//nolint:mnd
+var fluorescentF7 = []float64{
+	2.56, 3.18, 3.84, 4.53, 6.15, 19.37, 7.37, 7.05, // 380nm
+	7.71, 8.41, 9.15, 44.14, 17.52, 11.35, 12.00, 12.58, // 420nm
+	13.08, 13.45, 13.71, 13.88, 13.95, 13.93, 13.82, 13.64, // 460nm
+	13.43, 13.25, 13.08, 12.93, 12.78, 12.60, 12.44, 12.33, // 500nm
+	12.26, 29.52, 17.05, 12.44, 12.58, 12.72, 12.83, 15.46, // 540nm
+	16.75, 12.83, 12.67, 12.45, 12.19, 11.89, 11.60, 11.35, // 580nm
+	11.12, 10.95, 10.76, 10.42, 10.11, 10.04, 10.02, 10.11, // 620nm
+	9.87, 8.65, 7.27, 6.44, 5.83, 5.41, 5.04, 4.57, // 660nm
+	4.12, 3.77, 3.46, 3.08, 2.73, 2.47, 2.25, 2.06, // 700nm
+	1.90, 1.75, 1.62, 1.54, 1.45, 1.32, 1.17, 0.99, // 740nm
+	0.81, // 780nm
+}
+
+// fluorescentF8 holds the relative spectral power distribution of CIE
+// illuminant F8 (broadband D50 simulator, 5000K) from 380nm to 780nm at 5nm
+// intervals.
+//
+//nolint:mnd
+var fluorescentF8 = []float64{
+	1.21, 1.50, 1.81, 2.13, 3.17, 13.08, 3.83, 3.45, // 380nm
+	3.86, 4.42, 5.09, 34.10, 12.42, 7.68, 8.60, 9.46, // 420nm
+	10.24, 10.84, 11.33, 11.71, 11.98, 12.17, 12.28, 12.32, // 460nm
+	12.35, 12.44, 12.55, 12.68, 12.77, 12.72, 12.60, 12.43, // 500nm
+	12.22, 28.96, 16.51, 11.79, 11.76, 11.77, 11.84, 14.61, // 540nm
+	16.11, 12.34, 12.53, 12.72, 12.92, 13.12, 13.34, 13.61, // 580nm
+	13.87, 14.07, 14.20, 14.16, 14.13, 14.34, 14.50, 14.46, // 620nm
+	14.00, 12.58, 10.99, 9.98, 9.22, 8.62, 8.07, 7.39, // 660nm
+	6.71, 6.16, 5.63, 5.03, 4.46, 4.02, 3.66, 3.36, // 700nm
+	3.09, 2.85, 2.65, 2.51, 2.37, 2.15, 1.89, 1.61, // 740nm
+	1.32, // 780nm
+}
+
+// fluorescentF9 holds the relative spectral power distribution of CIE
+// illuminant F9 (broadband cool white deluxe, 4150K) from 380nm to 780nm at 5nm
+// intervals.
+//
+//nolint:mnd
+var fluorescentF9 = []float64{
+	0.90, 1.12, 1.36, 1.60, 2.59, 12.80, 3.05, 2.56, // 380nm
+	2.86, 3.30, 3.82, 32.62, 10.77, 5.84, 6.57, 7.25, // 420nm
+	7.86, 8.35, 8.75, 9.06, 9.31, 9.48, 9.61, 9.68, // 460nm
+	9.74, 9.88, 10.04, 10.26, 10.48, 10.63, 10.78, 10.96, // 500nm
+	11.18, 27.71, 16.29, 12.28, 12.74, 13.21, 13.65, 16.57, // 540nm
+	18.14, 14.55, 14.65, 14.66, 14.61, 14.50, 14.39, 14.40, // 580nm
+	14.47, 14.62, 14.72, 14.55, 14.40, 14.58, 14.88, 15.51, // 620nm
+	15.47, 13.20, 10.57, 9.18, 8.25, 7.57, 7.03, 6.35, // 660nm
+	5.72, 5.25, 4.80, 4.29, 3.80, 3.43, 3.12, 2.86, // 700nm
+	2.64, 2.43, 2.26, 2.14, 2.02, 1.83, 1.61, 1.38, // 740nm
+	1.12, // 780nm
+}
+
+// fluorescentF11 holds the relative spectral power distribution of CIE
+// illuminant F11 (narrow tri-band, 4000K) from 380nm to 780nm at 5nm intervals.
+//
+//nolint:mnd
+var fluorescentF11 = []float64{
+	0.91, 0.63, 0.46, 0.37, 1.29, 12.68, 1.59, 1.79, // 380nm
+	2.46, 3.33, 4.49, 33.94, 12.13, 6.95, 7.19, 7.12, // 420nm
+	6.72, 6.13, 5.46, 4.79, 5.66, 14.29, 14.96, 8.97, // 460nm
+	4.72, 2.33, 1.47, 1.10, 0.89, 0.83, 1.18, 4.90, // 500nm
+	39.59, 72.84, 32.61, 7.52, 2.83, 1.96, 1.67, 4.43, // 540nm
+	11.28, 14.76, 12.73, 9.74, 7.33, 9.72, 55.27, 42.58, // 580nm
+	13.18, 13.16, 12.26, 5.11, 2.07, 2.34, 3.58, 3.01, // 620nm
+	2.48, 2.14, 1.54, 1.33, 1.46, 1.94, 2.00, 1.20, // 660nm
+	1.35, 4.10, 5.58, 2.51, 0.57, 0.27, 0.23, 0.21, // 700nm
+	0.24, 0.24, 0.20, 0.24, 0.32, 0.26, 0.16, 0.12, // 740nm
+	0.09, // 780nm
+}
+
+// fluorescentF12 holds the relative spectral power distribution of CIE
+// illuminant F12 (narrow tri-band, 3000K) from 380nm to 780nm at 5nm intervals.
+//
+//nolint:mnd
+var fluorescentF12 = []float64{
+	0.96, 0.64, 0.45, 0.33, 1.19, 12.48, 1.12, 0.94, // 380nm
+	1.08, 1.37, 1.78, 29.05, 7.90, 2.65, 2.71, 2.65, // 420nm
+	2.49, 2.33, 2.10, 1.91, 3.01, 10.83, 11.88, 6.88, // 460nm
+	3.43, 1.49, 0.92, 0.71, 0.60, 0.63, 1.10, 4.56, // 500nm
+	34.40, 65.40, 29.48, 7.16, 3.08, 2.47, 2.27, 5.09, // 540nm
+	11.96, 15.32, 14.27, 11.86, 9.28, 12.31, 68.53, 53.02, // 580nm
+	14.67, 14.38, 14.71, 6.46, 2.57, 2.75, 4.18, 3.44, // 620nm
+	2.81, 2.42, 1.64, 1.36, 1.49, 1.96, 1.93, 1.08, // 660nm
+	1.13, 3.63, 5.85, 2.95, 0.58, 0.25, 0.21, 0.20, // 700nm
+	0.23, 0.23, 0.20, 0.17, 0.20, 0.21, 0.15, 0.11, // 740nm
+	0.08, // 780nm
+}
+
+// fluorescentIlluminants maps the CIE fluorescent illuminants to their
+// relative spectral power distributions
+var fluorescentIlluminants = map[Illuminant][]float64{
+	IlluminantF1:  fluorescentF1,
+	IlluminantF2:  fluorescentF2,
+	IlluminantF3:  fluorescentF3,
+	IlluminantF4:  fluorescentF4,
+	IlluminantF5:  fluorescentF5,
+	IlluminantF6:  fluorescentF6,
+	IlluminantF7:  fluorescentF7,
+	IlluminantF8:  fluorescentF8,
+	IlluminantF9:  fluorescentF9,
+	IlluminantF11: fluorescentF11,
+	IlluminantF12: fluorescentF12,
+}
@@ -0,0 +1,169 @@
+package colour
+
+import (
+	"image"
+	"image/color" //nolint:misspell
+	"runtime"
+	"sync"
+)
+
+// colourCache records the results of a Transform keyed by the input
+// colour. It is safe for concurrent use. Once it holds the maximum number
+// of entries no further entries are added.
+type colourCache struct {
+	mtx     sync.RWMutex
+	maxSize int
+	m       map[rgba]rgba
+}
+
+// newColourCache returns a new colourCache which will hold up to maxSize
+// entries. If maxSize is not greater than zero nil is returned.
+func newColourCache(maxSize int) *colourCache {
+	if maxSize <= 0 {
+		return nil
+	}
+
+	return &colourCache{
+		maxSize: maxSize,
+		m:       map[rgba]rgba{},
+	}
+}
+
+// apply returns the result of applying the Transform to the colour, taking
+// it from the cache if it is there. A nil cache simply applies the
+// Transform.
+func (cc *colourCache) apply(t Transform, c rgba) rgba {
+	if cc == nil {
+		return t(c)
+	}
+
+	cc.mtx.RLock()
+	rval, ok := cc.m[c]
+	cc.mtx.RUnlock()
+
+	if ok {
+		return rval
+	}
+
+	rval = t(c)
+
+	cc.mtx.Lock()
+	if len(cc.m) < cc.maxSize {
+		cc.m[c] = rval
+	}
+	cc.mtx.Unlock()
+
+	return rval
+}
+
+// pixelColour returns the colour of the pixel at x, y in the image with its
+// alpha value not premultiplied, as for all the colours in this package
+func pixelColour(img image.Image, x, y int) rgba {
+	nc := color.NRGBAModel.Convert(img.At(x, y)) //nolint:misspell
+
+	return rgba(nc.(color.NRGBA)) //nolint:misspell
+}
+
+// ImageTransformer applies a Transform to every pixel of an image. It can
+// share the work between several goroutines and can cache the results of
+// the Transform so that it is only applied once to each distinct colour;
+// this is worthwhile for expensive Transforms (such as Families.Recolour)
+// and for images with relatively few distinct colours.
+type ImageTransformer struct {
+	// Transform is the transform applied to each pixel
+	Transform Transform
+	// Workers is the number of goroutines used to transform an image. If
+	// it is zero or less the number of CPUs available is used.
+	Workers int
+	// CacheSize is the maximum number of distinct colours whose
+	// transformed value is cached. If it is zero or less no cache is used.
+	CacheSize int
+}
+
+// workers returns the number of goroutines to use
+func (it ImageTransformer) workers() int {
+	if it.Workers > 0 {
+		return it.Workers
+	}
+
+	return runtime.GOMAXPROCS(0)
+}
+
+// Apply returns a new image with the same bounds as the supplied image and
+// with the Transform applied to each pixel. The rows of the image are
+// shared between the workers and so the supplied image must be safe to
+// read concurrently, as are all the image types in the standard library.
+func (it ImageTransformer) Apply(img image.Image) *image.NRGBA {
+	b := img.Bounds()
+	out := image.NewNRGBA(b)
+	cache := newColourCache(it.CacheSize)
+
+	rows := make(chan int)
+
+	var wg sync.WaitGroup
+
+	for range min(it.workers(), max(b.Dy(), 1)) {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for y := range rows {
+				for x := b.Min.X; x < b.Max.X; x++ {
+					c := cache.apply(it.Transform, pixelColour(img, x, y))
+					out.SetNRGBA(x, y, color.NRGBA(c)) //nolint:misspell
+				}
+			}
+		}()
+	}
+
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		rows <- y
+	}
+
+	close(rows)
+	wg.Wait()
+
+	return out
+}
+
+// Lazy returns an image which applies the Transform to the pixels of the
+// supplied image as they are read. No work is done until a pixel is read
+// and so this is suited to images which are large or of which only a part
+// will be read. The Workers value is not used but any cache is shared by
+// all reads of the returned image.
+func (it ImageTransformer) Lazy(img image.Image) *TransformedImage {
+	return &TransformedImage{
+		src:   img,
+		t:     it.Transform,
+		cache: newColourCache(it.CacheSize),
+	}
+}
+
+// TransformedImage is an image.Image which applies a Transform to the
+// pixels of another image as they are read. It is safe for concurrent use
+// if the underlying image is. Use [ImageTransformer.Lazy] to make one.
+type TransformedImage struct {
+	src   image.Image
+	t     Transform
+	cache *colourCache
+}
+
+// ColorModel returns the colour model of the image. The colours have their
+// alpha values not premultiplied.
+func (ti *TransformedImage) ColorModel() color.Model { //nolint:misspell
+	return color.NRGBAModel //nolint:misspell
+}
+
+// Bounds returns the bounds of the image. These are the bounds of the
+// underlying image.
+func (ti *TransformedImage) Bounds() image.Rectangle {
+	return ti.src.Bounds()
+}
+
+// At returns the transformed colour of the pixel at x, y
+func (ti *TransformedImage) At(x, y int) color.Color { //nolint:misspell
+	c := ti.cache.apply(ti.t, pixelColour(ti.src, x, y))
+
+	return color.NRGBA(c) //nolint:misspell
+}
@@ -0,0 +1,130 @@
+package colour
+
+import (
+	"fmt"
+	"image"
+	"image/color" //nolint:misspell
+	"sync/atomic"
+	"testing"
+
+	"github.com/nickwells/colour.mod/v2/colourtesthelper"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+// makeTestImage returns an image with a few distinct colours
+func makeTestImage() *image.RGBA {
+	img := image.NewRGBA(image.Rect(-2, 3, 5, 8))
+	b := img.Bounds()
+
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			img.Set(x, y, color.NRGBA{ //nolint:misspell
+				R: uint8(x&1) * 0xff,
+				G: uint8(y&1) * 0x80,
+				B: 0x40,
+				A: 0xff,
+			})
+		}
+	}
+
+	return img
+}
+
+// checkImage checks that the image holds the expected colours
+func checkImage(t *testing.T, id string, act, exp image.Image) {
+	t.Helper()
+
+	if act.Bounds() != exp.Bounds() {
+		t.Log(id)
+		t.Errorf("\t: bad bounds: expected %v, got %v",
+			exp.Bounds(), act.Bounds())
+
+		return
+	}
+
+	b := exp.Bounds()
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			colourtesthelper.DiffRGBA(t, id,
+				fmt.Sprintf("pixel (%d, %d)", x, y),
+				pixelColour(act, x, y), pixelColour(exp, x, y))
+		}
+	}
+}
+
+func TestImageTransformer(t *testing.T) {
+	img := makeTestImage()
+
+	exp := image.NewNRGBA(img.Bounds())
+	b := img.Bounds()
+
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			exp.SetNRGBA(x, y,
+				color.NRGBA(Invert(pixelColour(img, x, y)))) //nolint:misspell
+		}
+	}
+
+	testCases := []struct {
+		testhelper.ID
+		workers      int
+		cacheSize    int
+		expCallsLazy int64
+	}{
+		{
+			ID:           testhelper.MkID("default"),
+			expCallsLazy: 35,
+		},
+		{
+			ID:           testhelper.MkID("one worker, cached"),
+			workers:      1,
+			cacheSize:    100,
+			expCallsLazy: 4,
+		},
+		{
+			ID:           testhelper.MkID("many workers, small cache"),
+			workers:      20,
+			cacheSize:    2,
+			expCallsLazy: 16,
+		},
+	}
+
+	for _, tc := range testCases {
+		var calls atomic.Int64
+
+		it := ImageTransformer{
+			Transform: func(c rgba) rgba {
+				calls.Add(1)
+				return Invert(c)
+			},
+			Workers:   tc.workers,
+			CacheSize: tc.cacheSize,
+		}
+
+		checkImage(t, tc.IDStr()+": Apply", it.Apply(img), exp)
+
+		calls.Store(0)
+
+		lazy := it.Lazy(img)
+		testhelper.DiffInt(t, tc.IDStr(), "calls before reading",
+			calls.Load(), 0)
+		checkImage(t, tc.IDStr()+": Lazy", lazy, exp)
+		testhelper.DiffInt(t, tc.IDStr(), "calls after reading",
+			calls.Load(), tc.expCallsLazy)
+
+		if lazy.ColorModel() != color.NRGBAModel { //nolint:misspell
+			t.Log(tc.IDStr())
+			t.Errorf("\t: the lazy image should have the NRGBA colour model")
+		}
+	}
+}
+
+func TestImageTransformerEmptyImage(t *testing.T) {
+	img := image.NewRGBA(image.Rectangle{})
+
+	out := ImageTransformer{Transform: Invert}.Apply(img)
+	if !out.Bounds().Empty() {
+		t.Errorf("the transformed image should be empty, bounds: %v",
+			out.Bounds())
+	}
+}
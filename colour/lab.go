@@ -0,0 +1,264 @@
+package colour
+
+import (
+	"fmt"
+	"image/color" //nolint:misspell
+	"math"
+)
+
+// The matrices for adapting XYZ values between the D65 white point (of the
+// sRGB colour space) and the D50 white point (of the CIELAB colour space)
+var (
+	xyzD65ToD50 = mustCATMatrix(Bradford, WhiteD65, WhiteD50)
+	xyzD50ToD65 = mustCATMatrix(Bradford, WhiteD50, WhiteD65)
+)
+
+// mustCATMatrix returns the chromatic adaptation matrix for the CAT and the
+// white points. It panics if the matrix cannot be made.
+func mustCATMatrix(cat CAT, from, to XYZ) matrix3 {
+	m, err := cat.matrix(from, to)
+	if err != nil {
+		panic(fmt.Errorf("cannot make the %s adaptation matrix: %w", cat, err))
+	}
+
+	return m
+}
+
+// These constants are used in the conversion between XYZ and CIELAB
+const (
+	labEpsilon = 216.0 / 24389.0
+	labKappa   = 24389.0 / 27.0
+)
+
+// Lab represents a colour in the CIE 1976 L*a*b* colour space (CIELAB). The
+// reference white is D50, as used by ICC profiles and by CSS, and colours
+// are adapted to and from the D65 white point of sRGB using the Bradford
+// transform.
+type Lab struct {
+	// L is the lightness, from 0 (black) to 100 (white)
+	L float64
+	// A is the position between green (negative) and red (positive)
+	A float64
+	// B is the position between blue (negative) and yellow (positive)
+	B float64
+}
+
+// String returns a string representation of the Lab value
+func (lab Lab) String() string {
+	return fmt.Sprintf("{L:%0.4f A:%0.4f B:%0.4f}", lab.L, lab.A, lab.B)
+}
+
+// Lab converts the XYZ value (with a D65 white point) into a CIELAB value
+func (xyz XYZ) Lab() Lab {
+	v := xyzD65ToD50.apply(xyz.vec())
+
+	f := func(t float64) float64 {
+		if t > labEpsilon {
+			return math.Cbrt(t)
+		}
+
+		return (labKappa*t + 16) / 116 //nolint:mnd
+	}
+
+	fx := f(v[0] / WhiteD50.X)
+	fy := f(v[1] / WhiteD50.Y)
+	fz := f(v[2] / WhiteD50.Z)
+
+	return Lab{
+		L: 116*fy - 16,     //nolint:mnd
+		A: 500 * (fx - fy), //nolint:mnd
+		B: 200 * (fy - fz), //nolint:mnd
+	}
+}
+
+// XYZ converts the CIELAB value into an XYZ value with a D65 white point
+func (lab Lab) XYZ() XYZ {
+	fy := (lab.L + 16) / 116 //nolint:mnd
+	fx := fy + lab.A/500     //nolint:mnd
+	fz := fy - lab.B/200     //nolint:mnd
+
+	fInv := func(f float64) float64 {
+		if t := f * f * f; t > labEpsilon {
+			return t
+		}
+
+		return (116*f - 16) / labKappa //nolint:mnd
+	}
+
+	var y float64
+	if lab.L > labKappa*labEpsilon {
+		y = fy * fy * fy
+	} else {
+		y = lab.L / labKappa
+	}
+
+	v := [3]float64{
+		fInv(fx) * WhiteD50.X,
+		y * WhiteD50.Y,
+		fInv(fz) * WhiteD50.Z,
+	}
+
+	return makeXYZ(xyzD50ToD65.apply(v))
+}
+
+// RGBA2Lab converts an RGBA colour value into a CIELAB value. The RGBA
+// value is taken to be in the sRGB colour space.
+func RGBA2Lab(c color.RGBA) Lab { //nolint:misspell
+	return RGBA2XYZ(c).Lab()
+}
+
+// ToRGBA converts a CIELAB colour value into an RGBA value in the sRGB
+// colour space. The alpha value is forced to 0xff. Colours outside the sRGB
+// gamut are clipped.
+func (lab Lab) ToRGBA() color.RGBA { //nolint:misspell
+	return lab.XYZ().ToRGBA()
+}
+
+// RGBA satisfies the Color interface from the [image/color] package
+//
+//nolint:misspell
+func (lab Lab) RGBA() (r, g, b, a uint32) {
+	c := lab.ToRGBA()
+	return c.RGBA()
+}
+
+// LCh converts the CIELAB value into the equivalent cylindrical CIELCh
+// value
+func (lab Lab) LCh() LCh {
+	l, c, h := toPolar(lab.L, lab.A, lab.B)
+	return LCh{L: l, C: c, H: h}
+}
+
+// LCh represents a colour in the CIE 1976 L*C*h colour space (CIELCh). This
+// is the CIELAB colour space in cylindrical coordinates.
+type LCh struct {
+	// L is the lightness, from 0 (black) to 100 (white)
+	L float64
+	// C is the chroma, the distance from the neutral axis
+	C float64
+	// H is the hue angle in degrees, in the range [0, 360)
+	H float64
+}
+
+// String returns a string representation of the LCh value
+func (lch LCh) String() string {
+	return fmt.Sprintf("{L:%0.4f C:%0.4f H:%0.4f}", lch.L, lch.C, lch.H)
+}
+
+// Lab converts the CIELCh value into the equivalent CIELAB value
+func (lch LCh) Lab() Lab {
+	l, a, b := fromPolar(lch.L, lch.C, lch.H)
+	return Lab{L: l, A: a, B: b}
+}
+
+// RGBA2LCh converts an RGBA colour value into a CIELCh value. The RGBA
+// value is taken to be in the sRGB colour space.
+func RGBA2LCh(c color.RGBA) LCh { //nolint:misspell
+	return RGBA2Lab(c).LCh()
+}
+
+// ToRGBA converts a CIELCh colour value into an RGBA value in the sRGB
+// colour space. The alpha value is forced to 0xff. Colours outside the sRGB
+// gamut are clipped.
+func (lch LCh) ToRGBA() color.RGBA { //nolint:misspell
+	return lch.Lab().ToRGBA()
+}
+
+// RGBA satisfies the Color interface from the [image/color] package
+//
+//nolint:misspell
+func (lch LCh) RGBA() (r, g, b, a uint32) {
+	c := lch.ToRGBA()
+	return c.RGBA()
+}
+
+// toPolar converts the rectangular coordinates of an opponent colour space
+// (such as CIELAB) into polar coordinates. The hue is given in degrees in
+// the range [0, 360).
+func toPolar(l, a, b float64) (float64, float64, float64) {
+	h := math.Atan2(b, a) * 180 / math.Pi //nolint:mnd
+	if h < 0 {
+		h += 360
+	}
+
+	return l, math.Hypot(a, b), h
+}
+
+// fromPolar converts the polar coordinates of an opponent colour space
+// (such as CIELCh) into rectangular coordinates. The hue is given in
+// degrees.
+func fromPolar(l, c, h float64) (float64, float64, float64) {
+	rad := h * math.Pi / 180 //nolint:mnd
+	return l, c * math.Cos(rad), c * math.Sin(rad)
+}
+
+// DeltaE2000 returns the CIEDE2000 colour difference between the two CIELAB
+// values. A difference of about 1 is the smallest that can be seen when
+// the colours are side by side.
+//
+//nolint:mnd
+func (lab Lab) DeltaE2000(other Lab) float64 {
+	const pow25To7 = 6103515625.0 // 25^7
+
+	rad := func(deg float64) float64 { return deg * math.Pi / 180 }
+
+	cBar := (math.Hypot(lab.A, lab.B) + math.Hypot(other.A, other.B)) / 2
+	cBar7 := math.Pow(cBar, 7)
+	g := 0.5 * (1 - math.Sqrt(cBar7/(cBar7+pow25To7)))
+
+	l1, c1, h1 := toPolar(lab.L, (1+g)*lab.A, lab.B)
+	l2, c2, h2 := toPolar(other.L, (1+g)*other.A, other.B)
+
+	dh := 0.0
+	hBar := h1 + h2
+
+	if c1*c2 != 0 {
+		dh = h2 - h1
+
+		switch {
+		case dh > 180:
+			dh -= 360
+		case dh < -180:
+			dh += 360
+		}
+
+		switch {
+		case math.Abs(h1-h2) <= 180:
+			hBar /= 2
+		case hBar < 360:
+			hBar = (hBar + 360) / 2
+		default:
+			hBar = (hBar - 360) / 2
+		}
+	}
+
+	dL := l2 - l1
+	dC := c2 - c1
+	dH := 2 * math.Sqrt(c1*c2) * math.Sin(rad(dh/2))
+
+	lBar := (l1 + l2) / 2
+	cPrimeBar := (c1 + c2) / 2
+	cPrimeBar7 := math.Pow(cPrimeBar, 7)
+
+	t := 1 - 0.17*math.Cos(rad(hBar-30)) + 0.24*math.Cos(rad(2*hBar)) +
+		0.32*math.Cos(rad(3*hBar+6)) - 0.20*math.Cos(rad(4*hBar-63))
+	dTheta := 30 * math.Exp(-math.Pow((hBar-275)/25, 2))
+	rC := 2 * math.Sqrt(cPrimeBar7/(cPrimeBar7+pow25To7))
+
+	lBar50Sq := (lBar - 50) * (lBar - 50)
+	sL := 1 + 0.015*lBar50Sq/math.Sqrt(20+lBar50Sq)
+	sC := 1 + 0.045*cPrimeBar
+	sH := 1 + 0.015*cPrimeBar*t
+	rT := -math.Sin(rad(2*dTheta)) * rC
+
+	dLS, dCS, dHS := dL/sL, dC/sC, dH/sH
+
+	return math.Sqrt(dLS*dLS + dCS*dCS + dHS*dHS + rT*dCS*dHS)
+}
+
+// DeltaE2000 returns the CIEDE2000 colour difference between the two
+// colours, which are taken to be in the sRGB colour space. The alpha values
+// are ignored. See [Lab.DeltaE2000].
+func DeltaE2000(c1, c2 color.RGBA) float64 { //nolint:misspell
+	return RGBA2Lab(c1).DeltaE2000(RGBA2Lab(c2))
+}
@@ -0,0 +1,114 @@
+package colour
+
+import (
+	"testing"
+
+	"github.com/nickwells/colour.mod/v2/colourtesthelper"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestRGBA2Lab(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		c      rgba
+		expLab Lab
+		expLCh LCh
+	}{
+		{
+			ID: testhelper.MkID("black"),
+			c:  rgba{A: 0xff},
+		},
+		{
+			ID:     testhelper.MkID("white"),
+			c:      rgba{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+			expLab: Lab{L: 100},
+			expLCh: LCh{L: 100},
+		},
+		{
+			ID:     testhelper.MkID("red"),
+			c:      rgba{R: 0xff, A: 0xff},
+			expLab: Lab{L: 54.29, A: 80.80, B: 69.89},
+			expLCh: LCh{L: 54.29, C: 106.84, H: 40.85},
+		},
+		{
+			ID:     testhelper.MkID("blue"),
+			c:      rgba{B: 0xff, A: 0xff},
+			expLab: Lab{L: 29.57, A: 68.30, B: -112.03},
+			expLCh: LCh{L: 29.57, C: 131.21, H: 301.37},
+		},
+	}
+
+	for _, tc := range testCases {
+		lab := RGBA2Lab(tc.c)
+
+		const epsilon = 0.05
+		testhelper.DiffFloat(t, tc.IDStr(), "L", lab.L, tc.expLab.L, epsilon)
+		testhelper.DiffFloat(t, tc.IDStr(), "A", lab.A, tc.expLab.A, epsilon)
+		testhelper.DiffFloat(t, tc.IDStr(), "B", lab.B, tc.expLab.B, epsilon)
+
+		colourtesthelper.DiffRGBA(t, tc.IDStr(), "Lab round trip",
+			lab.ToRGBA(), tc.c)
+
+		if tc.expLCh.C == 0 {
+			continue
+		}
+
+		lch := RGBA2LCh(tc.c)
+		testhelper.DiffFloat(t, tc.IDStr(), "L", lch.L, tc.expLCh.L, epsilon)
+		testhelper.DiffFloat(t, tc.IDStr(), "C", lch.C, tc.expLCh.C, epsilon)
+		testhelper.DiffFloat(t, tc.IDStr(), "H", lch.H, tc.expLCh.H, epsilon)
+
+		colourtesthelper.DiffRGBA(t, tc.IDStr(), "LCh round trip",
+			lch.ToRGBA(), tc.c)
+	}
+}
+
+func TestDeltaE2000(t *testing.T) {
+	// the test data is from Sharma, Wu and Dalal, "The CIEDE2000
+	// Color-Difference Formula: Implementation Notes, Supplementary Test
+	// Data, and Mathematical Observations"
+	testCases := []struct {
+		testhelper.ID
+		lab1, lab2 Lab
+		expDE      float64
+	}{
+		{
+			ID:    testhelper.MkID("pair 1"),
+			lab1:  Lab{L: 50, A: 2.6772, B: -79.7751},
+			lab2:  Lab{L: 50, A: 0, B: -82.7485},
+			expDE: 2.0425,
+		},
+		{
+			ID:    testhelper.MkID("pair 7 - a neutral colour"),
+			lab1:  Lab{L: 50, A: 0, B: 0},
+			lab2:  Lab{L: 50, A: -1, B: 2},
+			expDE: 2.3669,
+		},
+		{
+			ID:    testhelper.MkID("pair 17"),
+			lab1:  Lab{L: 50, A: 2.5, B: 0},
+			lab2:  Lab{L: 73, A: 25, B: -18},
+			expDE: 27.1492,
+		},
+		{
+			ID:    testhelper.MkID("pair 25"),
+			lab1:  Lab{L: 60.2574, A: -34.0099, B: 36.2677},
+			lab2:  Lab{L: 60.4626, A: -34.1751, B: 39.4387},
+			expDE: 1.2644,
+		},
+		{
+			ID:    testhelper.MkID("same colour"),
+			lab1:  Lab{L: 50, A: 10, B: -10},
+			lab2:  Lab{L: 50, A: 10, B: -10},
+			expDE: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		const epsilon = 0.0001
+		testhelper.DiffFloat(t, tc.IDStr(), "ΔE",
+			tc.lab1.DeltaE2000(tc.lab2), tc.expDE, epsilon)
+		testhelper.DiffFloat(t, tc.IDStr(), "ΔE (swapped)",
+			tc.lab2.DeltaE2000(tc.lab1), tc.expDE, epsilon)
+	}
+}
@@ -0,0 +1,275 @@
+package colour
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// Language identifies the language of a colour name by its language tag,
+// such as "fr" or "de-CH". Only the primary language subtag is used and so
+// "fr-CA" and "fr-FR" are both French.
+type Language string
+
+// These are the languages which have colour-name catalogues. Colour names
+// are otherwise in English.
+const (
+	LangEnglish Language = "en"
+	LangFrench  Language = "fr"
+	LangGerman  Language = "de"
+	LangSpanish Language = "es"
+)
+
+// colourNameCatalogues maps each Language to a catalogue giving the
+// localised names of colours. Each catalogue maps from the English colour
+// name to the localised name. They cover the Web colours and the basic
+// colour terms (with some light and dark variants); the English names are
+// all names in the standard families.
+var colourNameCatalogues = map[Language]map[string]string{
+	LangFrench: {
+		"white":       "blanc",
+		"silver":      "argent",
+		"grey":        "gris",
+		"black":       "noir",
+		"red":         "rouge",
+		"maroon":      "bordeaux",
+		"yellow":      "jaune",
+		"olive":       "olive",
+		"lime":        "vert citron",
+		"green":       "vert",
+		"aqua":        "aqua",
+		"teal":        "sarcelle",
+		"blue":        "bleu",
+		"navy":        "bleu marine",
+		"fuchsia":     "fuchsia",
+		"purple":      "pourpre",
+		"orange":      "orange",
+		"pink":        "rose",
+		"brown":       "marron",
+		"cyan":        "cyan",
+		"magenta":     "magenta",
+		"violet":      "violet",
+		"indigo":      "indigo",
+		"turquoise":   "turquoise",
+		"gold":        "or",
+		"beige":       "beige",
+		"dark blue":   "bleu foncé",
+		"light blue":  "bleu clair",
+		"dark green":  "vert foncé",
+		"light green": "vert clair",
+		"dark red":    "rouge foncé",
+		"dark grey":   "gris foncé",
+		"light grey":  "gris clair",
+	},
+	LangGerman: {
+		"white":       "weiß",
+		"silver":      "silber",
+		"grey":        "grau",
+		"black":       "schwarz",
+		"red":         "rot",
+		"maroon":      "kastanienbraun",
+		"yellow":      "gelb",
+		"olive":       "oliv",
+		"lime":        "limette",
+		"green":       "grün",
+		"aqua":        "aqua",
+		"teal":        "blaugrün",
+		"blue":        "blau",
+		"navy":        "marineblau",
+		"fuchsia":     "fuchsia",
+		"purple":      "purpur",
+		"orange":      "orange",
+		"pink":        "rosa",
+		"brown":       "braun",
+		"cyan":        "cyan",
+		"magenta":     "magenta",
+		"violet":      "violett",
+		"indigo":      "indigo",
+		"turquoise":   "türkis",
+		"gold":        "gold",
+		"beige":       "beige",
+		"dark blue":   "dunkelblau",
+		"light blue":  "hellblau",
+		"dark green":  "dunkelgrün",
+		"light green": "hellgrün",
+		"dark red":    "dunkelrot",
+		"dark grey":   "dunkelgrau",
+		"light grey":  "hellgrau",
+	},
+	LangSpanish: {
+		"white":       "blanco",
+		"silver":      "plata",
+		"grey":        "gris",
+		"black":       "negro",
+		"red":         "rojo",
+		"maroon":      "granate",
+		"yellow":      "amarillo",
+		"olive":       "oliva",
+		"lime":        "lima",
+		"green":       "verde",
+		"aqua":        "agua",
+		"teal":        "verde azulado",
+		"blue":        "azul",
+		"navy":        "azul marino",
+		"fuchsia":     "fucsia",
+		"purple":      "púrpura",
+		"orange":      "naranja",
+		"pink":        "rosa",
+		"brown":       "marrón",
+		"cyan":        "cian",
+		"magenta":     "magenta",
+		"violet":      "violeta",
+		"indigo":      "índigo",
+		"turquoise":   "turquesa",
+		"gold":        "oro",
+		"beige":       "beige",
+		"dark blue":   "azul oscuro",
+		"light blue":  "azul claro",
+		"dark green":  "verde oscuro",
+		"light green": "verde claro",
+		"dark red":    "rojo oscuro",
+		"dark grey":   "gris oscuro",
+		"light grey":  "gris claro",
+	},
+}
+
+// localisedNames maps each Language to a map from every alias of an English
+// colour name (as generated by withAliases, or with the words run together)
+// to the localised name
+var localisedNames = makeLocalisedNames()
+
+// englishNames maps each Language to a map from every alias of a localised
+// colour name to the English name
+var englishNames = makeEnglishNames()
+
+// makeLocalisedNames constructs the localisedNames map from the catalogues
+func makeLocalisedNames() map[Language]map[string]string {
+	ln := map[Language]map[string]string{}
+
+	for lang, cat := range colourNameCatalogues {
+		ln[lang] = map[string]string{}
+
+		for en, loc := range cat {
+			// some families run the words together ("darkblue")
+			names := append(generateAltSpellings(en),
+				generateAltSpellings(strings.ReplaceAll(en, " ", ""))...)
+
+			for alias := range transformColourNames(names) {
+				ln[lang][alias] = loc
+			}
+		}
+	}
+
+	return ln
+}
+
+// makeEnglishNames constructs the englishNames map from the catalogues
+func makeEnglishNames() map[Language]map[string]string {
+	en := map[Language]map[string]string{}
+
+	for lang, cat := range colourNameCatalogues {
+		en[lang] = map[string]string{}
+
+		for name, loc := range cat {
+			for alias := range transformColourNames([]string{loc}) {
+				en[lang][alias] = name
+			}
+		}
+	}
+
+	return en
+}
+
+// Languages returns the languages which have colour names, sorted by
+// language tag
+func Languages() []Language {
+	langs := append(slices.Collect(maps.Keys(colourNameCatalogues)),
+		LangEnglish)
+	slices.Sort(langs)
+
+	return langs
+}
+
+// primary returns the Language given by the primary subtag of the language
+// tag, in lower case
+func (l Language) primary() Language {
+	tag := strings.ToLower(strings.TrimSpace(string(l)))
+	tag, _, _ = strings.Cut(strings.ReplaceAll(tag, "_", "-"), "-")
+
+	return Language(tag)
+}
+
+// ParseLanguage returns the Language for the language tag, such as "fr" or
+// "de-CH". A non-nil error is returned if there are no colour names in the
+// language.
+func ParseLanguage(tag string) (Language, error) {
+	l := Language(tag).primary()
+	if !slices.Contains(Languages(), l) {
+		langs := []string{}
+		for _, l := range Languages() {
+			langs = append(langs, string(l))
+		}
+
+		return l, fmt.Errorf("bad language tag: %q,"+
+			" the language must be one of: %s",
+			tag, strings.Join(langs, ", "))
+	}
+
+	return l, nil
+}
+
+// ColourName returns the name of the colour in the language and true. The
+// name may be given with any of the usual aliases ("dark-gray" for "dark
+// grey"). If the colour name is not in the language's catalogue, or the
+// language has no catalogue, the name is returned unchanged with false. No
+// names are changed for English.
+func (l Language) ColourName(name string) (string, bool) {
+	key := strings.ToLower(strings.TrimSpace(name))
+	if loc, ok := localisedNames[l.primary()][key]; ok {
+		return loc, true
+	}
+
+	return name, false
+}
+
+// englishColourName returns the English colour name for the localised
+// colour name and true. The languages are searched in the order given by
+// Languages. If the name is not in any catalogue it is returned unchanged
+// with false.
+func englishColourName(name string) (string, bool) {
+	for _, l := range Languages() {
+		if en, ok := englishNames[l][name]; ok {
+			return en, true
+		}
+	}
+
+	return name, false
+}
+
+// localise returns a copy of the FamilyColour with the colour names
+// replaced by the names in the language. If none of the names are in the
+// language's catalogue the FamilyColour is returned unchanged.
+func (l Language) localise(fc FamilyColour) FamilyColour {
+	names := []string{}
+
+	for _, cn := range fc.CNames {
+		if loc, ok := l.ColourName(cn); ok && !slices.Contains(names, loc) {
+			names = append(names, loc)
+		}
+	}
+
+	if len(names) > 0 {
+		fc.CNames = names
+	}
+
+	return fc
+}
+
+// FullNamesIn returns a single string giving all the possible names for
+// this colour in the language, quoted and prefixed by the Family name. If
+// the colour has no name in the language the English names are given. See
+// [FamilyColour.FullNames].
+func (fc FamilyColour) FullNamesIn(l Language) string {
+	return l.localise(fc).FullNames()
+}
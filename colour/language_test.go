@@ -0,0 +1,125 @@
+package colour
+
+import (
+	"testing"
+
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestParseLanguage(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		tag     string
+		expLang Language
+	}{
+		{
+			ID:      testhelper.MkID("French"),
+			tag:     "fr",
+			expLang: LangFrench,
+		},
+		{
+			ID:      testhelper.MkID("German, with a region and capitals"),
+			tag:     " DE-ch ",
+			expLang: LangGerman,
+		},
+		{
+			ID:      testhelper.MkID("Spanish, with an underscore"),
+			tag:     "es_MX",
+			expLang: LangSpanish,
+		},
+		{
+			ID:      testhelper.MkID("English"),
+			tag:     "en-GB",
+			expLang: LangEnglish,
+		},
+		{
+			ID: testhelper.MkID("unsupported language"),
+			ExpErr: testhelper.MkExpErr(`bad language tag: "it-IT",` +
+				" the language must be one of: de, en, es, fr"),
+			tag: "it-IT",
+		},
+	}
+
+	for _, tc := range testCases {
+		l, err := ParseLanguage(tc.tag)
+		if testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc) &&
+			err == nil {
+			testhelper.DiffString(t, tc.IDStr(), "language", l, tc.expLang)
+		}
+	}
+}
+
+func TestLanguageColourName(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		lang    Language
+		name    string
+		expName string
+		expOK   bool
+	}{
+		{
+			ID:      testhelper.MkID("French"),
+			lang:    LangFrench,
+			name:    "red",
+			expName: "rouge",
+			expOK:   true,
+		},
+		{
+			ID:      testhelper.MkID("German, alias of the English name"),
+			lang:    LangGerman,
+			name:    "Dark-Gray",
+			expName: "dunkelgrau",
+			expOK:   true,
+		},
+		{
+			ID:      testhelper.MkID("Spanish, English words run together"),
+			lang:    LangSpanish,
+			name:    "darkblue",
+			expName: "azul oscuro",
+			expOK:   true,
+		},
+		{
+			ID:      testhelper.MkID("French, not in the catalogue"),
+			lang:    LangFrench,
+			name:    "blue2",
+			expName: "blue2",
+		},
+		{
+			ID:      testhelper.MkID("English"),
+			lang:    LangEnglish,
+			name:    "red",
+			expName: "red",
+		},
+	}
+
+	for _, tc := range testCases {
+		name, ok := tc.lang.ColourName(tc.name)
+		testhelper.DiffString(t, tc.IDStr(), "name", name, tc.expName)
+		testhelper.DiffBool(t, tc.IDStr(), "ok", ok, tc.expOK)
+	}
+}
+
+func TestFamilyColour_FullNamesIn(t *testing.T) {
+	fc := FamilyColour{
+		Family: WebColours,
+		CNames: []string{"grey", "gray"},
+		Colour: webColours["grey"],
+	}
+
+	testhelper.DiffString(t, "French", "FullNamesIn",
+		fc.FullNamesIn(LangFrench), `"web:gris"`)
+	testhelper.DiffString(t, "English", "FullNamesIn",
+		fc.FullNamesIn(LangEnglish), `"web:grey" or "web:gray"`)
+}
+
+func TestLocalisedNameClashes(t *testing.T) {
+	for _, l := range Languages() {
+		for name, en := range englishNames[l] {
+			if otherEn, ok := englishColourName(name); ok && otherEn != en {
+				t.Errorf("the %s colour name %q is %q in another language",
+					l, name, otherEn)
+			}
+		}
+	}
+}
@@ -0,0 +1,333 @@
+package colour
+
+import (
+	"fmt"
+	"image/color" //nolint:misspell
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// munsellHueNames holds the names of the ten Munsell hue families in order
+// around the hue circle
+var munsellHueNames = []string{
+	"R", "YR", "Y", "GY", "G", "BG", "B", "PB", "P", "RP",
+}
+
+// MunsellNeutral is the HueName of the neutral (achromatic) colours
+const MunsellNeutral = "N"
+
+// These constants describe the Munsell scales
+const (
+	munsellHueStep   = 10.0
+	munsellHueCircle = munsellHueStep * 10
+	munsellMaxValue  = 10.0
+	// munsellChromaScale is the CIELAB chroma of one step of Munsell chroma
+	munsellChromaScale = 5.0
+	// munsellNeutralChroma is the Munsell chroma below which a converted
+	// colour is taken to be neutral
+	munsellNeutralChroma = 0.05
+	// munsellHueResolution is the largest error in the hue of a colour
+	// converted to an RGBA value and back, multiplied by its chroma
+	munsellHueResolution = 2.5
+	// munsellValueResolution is the largest error in the value of a colour
+	// converted to an RGBA value and back
+	munsellValueResolution = 0.025
+	// munsellChromaResolution is the largest error in the chroma of a
+	// colour converted to an RGBA value and back
+	munsellChromaResolution = 0.125
+)
+
+// munsellHueAngles gives the approximate CIELAB hue angle, in degrees, of
+// the principal hue (5R, 5YR, ...) of each Munsell hue family, for colours
+// of middle value and moderate chroma. The angles of other hues are found
+// by linear interpolation.
+//
+//nolint:mnd
+var munsellHueAngles = []float64{
+	27, 62, 90, 118, 160, 195, 233, 275, 315, 352,
+}
+
+// munsellRE matches Munsell notation: either a hue, a value and a chroma
+// (as in "5YR 4/6") or a neutral value (as in "N 5/" or "N5")
+var munsellRE = regexp.MustCompile(
+	`^(?:(\d+(?:\.\d+)?)\s*([A-Z]+)\s*(\d+(?:\.\d+)?)\s*/\s*(\d+(?:\.\d+)?)` +
+		`|N\s*(\d+(?:\.\d+)?)\s*(?:/\s*0*(?:\.0*)?)?)$`)
+
+// Munsell represents a colour in the Munsell colour system, which describes
+// a colour by its hue, its value (lightness) and its chroma (strength).
+//
+// The conversions between Munsell and RGB values use an analytic
+// approximation: the value is converted using the ASTM D1535 polynomial,
+// the hue is mapped to a CIELAB hue angle by interpolating between the
+// angles of the principal hues and each step of chroma is taken to be a
+// fixed CIELAB chroma. The Munsell renotation data is not used and so the
+// results are only approximate, particularly for strong colours and for
+// very light or dark ones.
+type Munsell struct {
+	// Hue is the position in the hue family, greater than 0 and no more
+	// than 10, with 5 being the principal hue. It is ignored for neutrals
+	Hue float64
+	// HueName is the hue family (one of R, YR, Y, GY, G, BG, B, PB, P or
+	// RP) or MunsellNeutral for a neutral colour
+	HueName string
+	// Value is the lightness, from 0 (black) to 10 (white)
+	Value float64
+	// Chroma is the strength of the colour, 0 for a neutral colour
+	Chroma float64
+}
+
+// fmtMunsellNum formats the Munsell number to at most one decimal place
+func fmtMunsellNum(v float64) string {
+	const scale = 10
+
+	return strconv.FormatFloat(math.Round(v*scale)/scale, 'f', -1, 64)
+}
+
+// String returns the Munsell notation for the colour, such as "5YR 4/6" or
+// "N 5/". The numbers are given to at most one decimal place.
+func (m Munsell) String() string {
+	if m.HueName == MunsellNeutral {
+		return MunsellNeutral + " " + fmtMunsellNum(m.Value) + "/"
+	}
+
+	return fmtMunsellNum(m.Hue) + m.HueName + " " +
+		fmtMunsellNum(m.Value) + "/" + fmtMunsellNum(m.Chroma)
+}
+
+// ParseMunsell parses the Munsell notation, such as "5YR 4/6", "2.5 PB
+// 3/10" or "N 5/", and returns the Munsell value. A hue of 0 is taken to be
+// a hue of 10 in the preceding hue family, so "0YR" is the same as "10R",
+// and a chroma of 0 gives a neutral colour. A non-nil error is returned if
+// the notation cannot be parsed or if any part is out of range.
+func ParseMunsell(s string) (Munsell, error) {
+	parts := munsellRE.FindStringSubmatch(strings.ToUpper(strings.TrimSpace(s)))
+	if parts == nil {
+		return Munsell{}, fmt.Errorf("bad Munsell notation: %q", s)
+	}
+
+	num := func(p string) float64 {
+		v, _ := strconv.ParseFloat(p, 64) // the regexp ensures it's valid
+
+		return v
+	}
+
+	if parts[5] != "" {
+		m := Munsell{HueName: MunsellNeutral, Value: num(parts[5])}
+
+		return m, m.check(s)
+	}
+
+	idx := slices.Index(munsellHueNames, parts[2])
+	if idx < 0 {
+		return Munsell{}, fmt.Errorf("bad Munsell notation: %q,"+
+			" the hue must be one of: %s",
+			s, strings.Join(munsellHueNames, ", "))
+	}
+
+	hue := num(parts[1])
+	if hue > munsellHueStep {
+		return Munsell{}, fmt.Errorf("bad Munsell notation: %q,"+
+			" the hue must be no more than %g", s, munsellHueStep)
+	}
+
+	m := munsellFromHuePosition(float64(idx)*munsellHueStep + hue)
+	m.Value = num(parts[3])
+	m.Chroma = num(parts[4])
+
+	if m.Chroma == 0 {
+		m = Munsell{HueName: MunsellNeutral, Value: m.Value}
+	}
+
+	return m, m.check(s)
+}
+
+// check returns a non-nil error if the Munsell value is out of range. The
+// regular expression ensures that no part is negative.
+func (m Munsell) check(s string) error {
+	if m.Value > munsellMaxValue {
+		return fmt.Errorf("bad Munsell notation: %q,"+
+			" the value must be from 0 to %g", s, munsellMaxValue)
+	}
+
+	return nil
+}
+
+// huePosition returns the position of the hue on the hue circle, from 0 to
+// 100, where 10R is at 10 and 10RP at 100
+func (m Munsell) huePosition() float64 {
+	return float64(slices.Index(munsellHueNames, m.HueName))*munsellHueStep +
+		m.Hue
+}
+
+// munsellFromHuePosition returns a Munsell value with the hue set from the
+// position on the hue circle. A hue of 0 is given as 10 in the preceding
+// hue family.
+func munsellFromHuePosition(pos float64) Munsell {
+	pos = math.Mod(pos, munsellHueCircle)
+	if pos <= 0 {
+		pos += munsellHueCircle
+	}
+
+	idx := int(math.Ceil(pos/munsellHueStep)) - 1
+
+	return Munsell{
+		Hue:     pos - float64(idx)*munsellHueStep,
+		HueName: munsellHueNames[idx],
+	}
+}
+
+// munsellValueToY converts the Munsell value to the luminance factor, Y,
+// using the ASTM D1535 polynomial. Y is scaled so that a perfect white is 1.
+//
+//nolint:mnd
+func munsellValueToY(v float64) float64 {
+	y := v * (1.1914 + v*(-0.22533+v*(0.23352+v*(-0.020484+v*0.00081939))))
+
+	return y / 100
+}
+
+// munsellYToValue converts the luminance factor, Y, to the Munsell value by
+// inverting the ASTM D1535 polynomial
+func munsellYToValue(y float64) float64 {
+	const iterations = 50
+
+	lo, hi := 0.0, munsellMaxValue
+
+	for range iterations {
+		mid := (lo + hi) / 2 //nolint:mnd
+		if munsellValueToY(mid) < y {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+
+	return (lo + hi) / 2 //nolint:mnd
+}
+
+// munsellHueToAngle converts the position on the Munsell hue circle to an
+// approximate CIELAB hue angle in degrees
+func munsellHueToAngle(pos float64) float64 {
+	const halfStep = munsellHueStep / 2
+
+	n := len(munsellHueAngles)
+	x := (pos - halfStep) / munsellHueStep
+	i := int(math.Floor(x))
+	frac := x - float64(i)
+
+	a1 := munsellHueAngles[(i%n+n)%n]
+	a2 := munsellHueAngles[((i+1)%n+n)%n]
+
+	if a2 < a1 {
+		a2 += 360
+	}
+
+	return math.Mod(a1+frac*(a2-a1), 360) //nolint:mnd
+}
+
+// munsellAngleToHue converts the CIELAB hue angle in degrees to the
+// position on the Munsell hue circle, inverting munsellHueToAngle
+func munsellAngleToHue(angle float64) float64 {
+	const halfStep = munsellHueStep / 2
+
+	n := len(munsellHueAngles)
+
+	for i := range n {
+		a1 := munsellHueAngles[i]
+		a2 := munsellHueAngles[(i+1)%n]
+		h := angle
+
+		if a2 < a1 {
+			a2 += 360
+
+			if h < a1 {
+				h += 360
+			}
+		}
+
+		if h >= a1 && h < a2 {
+			return math.Mod(
+				halfStep+(float64(i)+(h-a1)/(a2-a1))*munsellHueStep,
+				munsellHueCircle)
+		}
+	}
+
+	return halfStep // not reached: the segments cover the circle
+}
+
+// LCh converts the Munsell value into an approximate CIELCh value
+func (m Munsell) LCh() LCh {
+	lch := LCh{L: lightnessFromY(munsellValueToY(m.Value))}
+	if m.HueName == MunsellNeutral {
+		return lch
+	}
+
+	lch.C = m.Chroma * munsellChromaScale
+	lch.H = munsellHueToAngle(m.huePosition())
+
+	return lch
+}
+
+// lightnessFromY returns the CIELAB lightness for the luminance factor, Y
+func lightnessFromY(y float64) float64 {
+	if y > labEpsilon {
+		return 116*math.Cbrt(y) - 16 //nolint:mnd
+	}
+
+	return labKappa * y
+}
+
+// yFromLightness returns the luminance factor, Y, for the CIELAB lightness
+func yFromLightness(l float64) float64 {
+	if l > labKappa*labEpsilon {
+		return math.Pow((l+16)/116, 3) //nolint:mnd
+	}
+
+	return l / labKappa
+}
+
+// ToRGBA converts the Munsell value into an RGBA value in the sRGB colour
+// space. The alpha value is forced to 0xff. Colours outside the sRGB gamut
+// are clipped.
+//
+//nolint:misspell
+func (m Munsell) ToRGBA() color.RGBA {
+	return m.LCh().ToRGBA()
+}
+
+// RGBA satisfies the Color interface from the [image/color] package
+//
+//nolint:misspell
+func (m Munsell) RGBA() (r, g, b, a uint32) {
+	c := m.ToRGBA()
+	return c.RGBA()
+}
+
+// RGBA2Munsell converts an RGBA colour value into an approximate Munsell
+// value. The RGBA value is taken to be in the sRGB colour space. Colours
+// with very little chroma are given as neutrals.
+//
+// The RGBA value has only 8 bits for each of red, green and blue and so
+// the hue of a weak colour is imprecise: a Munsell value converted to an
+// RGBA value and back again may differ in hue by as much as 2.5 divided by
+// its chroma. So "7.5YR 8/2" may come back with any hue from 6.25YR to
+// 8.75YR, whereas "7.5YR 7/6" will come back with a hue within 0.5 of
+// 7.5YR. The value and chroma will be within 0.025 and 0.125 respectively.
+func RGBA2Munsell(c color.RGBA) Munsell { //nolint:misspell
+	lch := RGBA2LCh(c)
+	value := munsellYToValue(yFromLightness(lch.L))
+
+	chroma := lch.C / munsellChromaScale
+	if chroma < munsellNeutralChroma {
+		return Munsell{HueName: MunsellNeutral, Value: value}
+	}
+
+	m := munsellFromHuePosition(munsellAngleToHue(lch.H))
+	m.Value = value
+	m.Chroma = chroma
+
+	return m
+}
@@ -0,0 +1,187 @@
+package colour
+
+import (
+	"math"
+	"testing"
+
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestParseMunsell(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		s      string
+		expVal Munsell
+		expStr string
+	}{
+		{
+			ID:     testhelper.MkID("chromatic"),
+			s:      "5YR 4/6",
+			expVal: Munsell{Hue: 5, HueName: "YR", Value: 4, Chroma: 6},
+			expStr: "5YR 4/6",
+		},
+		{
+			ID:     testhelper.MkID("spaces and fractions"),
+			s:      " 2.5 pb 3.5 / 10 ",
+			expVal: Munsell{Hue: 2.5, HueName: "PB", Value: 3.5, Chroma: 10},
+			expStr: "2.5PB 3.5/10",
+		},
+		{
+			ID:     testhelper.MkID("zero hue"),
+			s:      "0YR 5/4",
+			expVal: Munsell{Hue: 10, HueName: "R", Value: 5, Chroma: 4},
+			expStr: "10R 5/4",
+		},
+		{
+			ID:     testhelper.MkID("zero hue, first family"),
+			s:      "0R 5/4",
+			expVal: Munsell{Hue: 10, HueName: "RP", Value: 5, Chroma: 4},
+			expStr: "10RP 5/4",
+		},
+		{
+			ID:     testhelper.MkID("neutral"),
+			s:      "N 5/",
+			expVal: Munsell{HueName: MunsellNeutral, Value: 5},
+			expStr: "N 5/",
+		},
+		{
+			ID:     testhelper.MkID("neutral, short form"),
+			s:      "n8.5",
+			expVal: Munsell{HueName: MunsellNeutral, Value: 8.5},
+			expStr: "N 8.5/",
+		},
+		{
+			ID:     testhelper.MkID("zero chroma"),
+			s:      "5Y 6/0",
+			expVal: Munsell{HueName: MunsellNeutral, Value: 6},
+			expStr: "N 6/",
+		},
+		{
+			ID: testhelper.MkID("bad hue family"),
+			ExpErr: testhelper.MkExpErr(`bad Munsell notation: "5X 4/6",` +
+				" the hue must be one of: R, YR, Y, GY, G, BG, B, PB, P, RP"),
+			s: "5X 4/6",
+		},
+		{
+			ID: testhelper.MkID("bad hue"),
+			ExpErr: testhelper.MkExpErr(`bad Munsell notation: "12R 4/6",` +
+				" the hue must be no more than 10"),
+			s: "12R 4/6",
+		},
+		{
+			ID: testhelper.MkID("bad value"),
+			ExpErr: testhelper.MkExpErr(`bad Munsell notation: "5R 11/2",` +
+				" the value must be from 0 to 10"),
+			s: "5R 11/2",
+		},
+		{
+			ID:     testhelper.MkID("no chroma"),
+			ExpErr: testhelper.MkExpErr(`bad Munsell notation: "5R 4/"`),
+			s:      "5R 4/",
+		},
+		{
+			ID:     testhelper.MkID("neutral with chroma"),
+			ExpErr: testhelper.MkExpErr(`bad Munsell notation: "N 5/2"`),
+			s:      "N 5/2",
+		},
+	}
+
+	for _, tc := range testCases {
+		m, err := ParseMunsell(tc.s)
+		if testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc) &&
+			err == nil {
+			testhelper.DiffString(t, tc.IDStr(), "hue name",
+				m.HueName, tc.expVal.HueName)
+			testhelper.DiffFloat(t, tc.IDStr(), "hue",
+				m.Hue, tc.expVal.Hue, 0)
+			testhelper.DiffFloat(t, tc.IDStr(), "value",
+				m.Value, tc.expVal.Value, 0)
+			testhelper.DiffFloat(t, tc.IDStr(), "chroma",
+				m.Chroma, tc.expVal.Chroma, 0)
+			testhelper.DiffString(t, tc.IDStr(), "string",
+				m.String(), tc.expStr)
+		}
+	}
+}
+
+// munsellHueDiff returns the difference between the positions of the hues
+// on the hue circle. The positions are compared rather than the hues as
+// the hue may cross into the neighbouring hue family ("10R" may give
+// "0.2YR").
+func munsellHueDiff(a, b Munsell) float64 {
+	return math.Mod(a.huePosition()-b.huePosition()+munsellHueCircle*1.5,
+		munsellHueCircle) - munsellHueCircle/2 //nolint:mnd
+}
+
+func TestMunsellRoundTrip(t *testing.T) {
+	for _, s := range []string{
+		"5YR 4/6", "5R 4/14", "7.5YR 7/6", "7.5YR 8/2", "5YR 2/1",
+		"7.5GY 6/4", "10R 5/8", "N 5/", "N 2/",
+	} {
+		m, err := ParseMunsell(s)
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		rt := RGBA2Munsell(m.ToRGBA())
+		testhelper.DiffFloat(t, s, "value", rt.Value, m.Value, 0.02)
+		testhelper.DiffFloat(t, s, "chroma", rt.Chroma, m.Chroma, 0.06)
+
+		if m.HueName == MunsellNeutral {
+			testhelper.DiffString(t, s, "hue name", rt.HueName, m.HueName)
+			continue
+		}
+
+		testhelper.DiffFloat(t, s, "hue position",
+			munsellHueDiff(rt, m), 0, munsellHueResolution/m.Chroma)
+	}
+}
+
+// TestMunsellResolution checks that the value, chroma and hue of every
+// Munsell chip survive conversion to an RGBA value and back within the
+// documented resolution
+func TestMunsellResolution(t *testing.T) {
+	for name := range munsellColours {
+		m, err := ParseMunsell(name)
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		rt := RGBA2Munsell(m.ToRGBA())
+		testhelper.DiffFloat(t, name, "value",
+			rt.Value, m.Value, munsellValueResolution)
+
+		if m.HueName == MunsellNeutral {
+			continue
+		}
+
+		testhelper.DiffFloat(t, name, "chroma",
+			rt.Chroma, m.Chroma, munsellChromaResolution)
+		testhelper.DiffFloat(t, name, "hue position",
+			munsellHueDiff(rt, m), 0, munsellHueResolution/m.Chroma)
+	}
+}
+
+func TestMunsellClosest(t *testing.T) {
+	fcs, err := Families{MunsellColours}.ClosestN(
+		rgba{R: 0x8b, G: 0x5a, B: 0x2b, A: 0xff}, 1)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	if len(fcs) != 1 {
+		t.Fatalf("expected 1 colour, got %d", len(fcs))
+	}
+
+	testhelper.DiffSlice(t, "closest Munsell", "names",
+		fcs[0].CNames, []string{"5yr 4/8"})
+
+	nc, err := ParseNamedColour(nil, "munsell:N 5/")
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	m := RGBA2Munsell(nc.Colour())
+	testhelper.DiffString(t, "munsell:N 5/", "notation", m.String(), "N 5/")
+}
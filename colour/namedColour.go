@@ -96,7 +96,50 @@ func MakeDistinctNamedColoursFromFamilyColour(fc FamilyColour) []NamedColour {
 // use that. Otherwise if the string matches a family name and colour name
 // (separated by a ':') then it will return the named colour from the given
 // family. Lastly it will find the named colour in the given families list.
+//
+// A colour name not found in the families may be given in any of the
+// Languages having colour names, such as "rouge" or "dunkelblau". The
+// English names take precedence: "marron" is the Pantone colour of that
+// name rather than the French for brown. Where a name is in more than one
+// language's catalogue the languages are searched in the order given by
+// Languages. Use [ParseNamedColourIn] to give the localised names
+// precedence.
+//
+// The CSS system colours (such as "css4:canvas") are taken from the light
+// theme, use a [NamedColourParser] to give a different theme.
 func ParseNamedColour(fl Families, s string) (NamedColour, error) {
+	return NamedColourParser{Families: fl}.Parse(s)
+}
+
+// ParseNamedColourIn creates a NamedColour from the given string, as for
+// [ParseNamedColour] except that a colour name in the language's catalogue
+// takes precedence over an English name. So, for French, "marron" is brown
+// and "bordeaux" is maroon, whichever families they are found in. For
+// English, or a language with no colour names, this is the same as
+// ParseNamedColour.
+func ParseNamedColourIn(l Language, fl Families, s string) (
+	NamedColour, error,
+) {
+	return NamedColourParser{Lang: l, Families: fl}.Parse(s)
+}
+
+// NamedColourParser parses named colours as for [ParseNamedColourIn]. The
+// zero value parses colours as ParseNamedColour does.
+type NamedColourParser struct {
+	// Lang gives the language whose colour names take precedence over the
+	// English names. If it is empty English is used.
+	Lang Language
+	// Families gives the colour families used to find colour names given
+	// without a family. If it is empty the standard families are used.
+	Families Families
+	// SystemTheme gives the colours of the CSS system colours (such as
+	// "css4:canvas"). If it is nil the light theme is used.
+	SystemTheme CSSSystemTheme
+}
+
+// Parse creates a NamedColour from the given string. It returns a non-nil
+// error if the string is not a colour definition or the name of a colour.
+func (p NamedColourParser) Parse(s string) (NamedColour, error) {
 	nc := NamedColour{name: s}
 
 	var err error
@@ -108,19 +151,21 @@ func ParseNamedColour(fl Families, s string) (NamedColour, error) {
 	}
 
 	if familyName, colourName, found := strings.Cut(s, ":"); found {
-		nc.colour, err = getColourByFamilyAndColourName(familyName, colourName)
+		nc.colour, err = p.getColourByFamilyAndColourName(
+			familyName, colourName)
 
 		return nc, err
 	}
 
-	nc.colour, err = getColourByColourName(fl, s)
+	nc.colour, err = p.getColourByColourName(s)
 
 	return nc, err
 }
 
 // getColourByFamilyAndColourName gets the colour value from the family and
-// colour names. It returns a non-nil error if the value can not be set.
-func getColourByFamilyAndColourName(fName, cName string,
+// colour names. A colour name in the language's catalogue takes precedence.
+// It returns a non-nil error if the value can not be set.
+func (p NamedColourParser) getColourByFamilyAndColourName(fName, cName string,
 ) (
 	c color.RGBA, err error, //nolint:misspell
 ) {
@@ -141,8 +186,20 @@ func getColourByFamilyAndColourName(fName, cName string,
 				)))
 	}
 
-	c, err = f.Colour(cName)
+	if en, ok := englishNames[p.Lang.primary()][cName]; ok {
+		if c, err := f.ThemedColour(p.SystemTheme, en); err == nil {
+			return c, nil
+		}
+	}
+
+	c, err = f.ThemedColour(p.SystemTheme, cName)
 	if err != nil {
+		if en, ok := englishColourName(cName); ok {
+			if c, enErr := f.ThemedColour(p.SystemTheme, en); enErr == nil {
+				return c, nil
+			}
+		}
+
 		altNames := ""
 		if cNames, err := f.ColourNames(); err == nil {
 			altNames = strdist.SuggestionString(
@@ -156,21 +213,28 @@ func getColourByFamilyAndColourName(fName, cName string,
 	return c, nil
 }
 
-// getColourByColourName sets the RGB from the colour name. It
-// returns a non-nil error if the value can not be set.
-func getColourByColourName(fl Families, cName string) (
+// getColourByColourName sets the RGB from the colour name. A colour name
+// in the language's catalogue takes precedence. It returns a non-nil error
+// if the value can not be set.
+func (p NamedColourParser) getColourByColourName(cName string) (
 	c color.RGBA, err error, //nolint:misspell
 ) {
+	fl := p.Families
+
 	cName = strings.TrimSpace(cName)
 	cName = strings.ToLower(cName)
 
-	if len(fl) == 0 {
-		c, err = StandardColours.Colour(cName)
-	} else {
-		for _, f := range fl {
-			c, err = f.Colour(cName)
-			if err == nil {
-				break
+	if en, ok := englishNames[p.Lang.primary()][cName]; ok {
+		if c, err := p.findColour(en); err == nil {
+			return c, nil
+		}
+	}
+
+	c, err = p.findColour(cName)
+	if err != nil {
+		if en, ok := englishColourName(cName); ok {
+			if c, enErr := p.findColour(en); enErr == nil {
+				return c, nil
 			}
 		}
 	}
@@ -191,6 +255,26 @@ func getColourByColourName(fl Families, cName string) (
 	return c, nil
 }
 
+// findColour returns the colour with the given name from the first of the
+// families having it. If no families are given the standard families are
+// searched. A non-nil error is returned if the colour is not found.
+func (p NamedColourParser) findColour(cName string) (
+	c color.RGBA, err error, //nolint:misspell
+) {
+	if len(p.Families) == 0 {
+		return StandardColours.Colour(cName)
+	}
+
+	for _, f := range p.Families {
+		c, err = f.ThemedColour(p.SystemTheme, cName)
+		if err == nil {
+			break
+		}
+	}
+
+	return c, err
+}
+
 // ColoursMatchingByRegexp returns a set of NamedColours where the colour
 // names in the families match the regular expression. The resulting colour
 // names will include the family from which they were matched, separated by a
@@ -210,6 +210,7 @@ func TestParseNamedColour(t *testing.T) {
 	testCases := []struct {
 		testhelper.ID
 		testhelper.ExpErr
+		lang  Language
 		fl    Families
 		s     string
 		expNC NamedColour
@@ -390,11 +391,96 @@ func TestParseNamedColour(t *testing.T) {
 				colour: webGrey,
 			},
 		},
+		{
+			ID: testhelper.MkID("good Colour name - French: rouge"),
+			s:  "rouge",
+			expNC: NamedColour{
+				name:   "rouge",
+				colour: rgba{R: 0xff, A: 0xff},
+			},
+		},
+		{
+			ID: testhelper.MkID("good Colour name - German: Dunkelblau"),
+			s:  "Dunkelblau",
+			expNC: NamedColour{
+				name:   "Dunkelblau",
+				colour: rgba{B: 0x8b, A: 0xff},
+			},
+		},
+		{
+			ID: testhelper.MkID("good Colour name - Spanish: gris"),
+			fl: Families{WebColours},
+			s:  "gris",
+			expNC: NamedColour{
+				name:   "gris",
+				colour: webGrey,
+			},
+		},
+		{
+			ID: testhelper.MkID("good Family:Colour - French: web:gris"),
+			s:  "web:gris",
+			expNC: NamedColour{
+				name:   "web:gris",
+				colour: webGrey,
+			},
+		},
+		{
+			ID:     testhelper.MkID("bad Family:Colour - French: web:marron"),
+			ExpErr: testhelper.MkExpErr(`bad colour name: "marron"`),
+			s:      "web:marron",
+		},
+		{
+			ID: testhelper.MkID("English name wins: marron"),
+			s:  "marron",
+			expNC: NamedColour{
+				name:   "marron",
+				colour: pantoneColours["marron"],
+			},
+		},
+		{
+			ID:   testhelper.MkID("French name wins: marron"),
+			lang: LangFrench,
+			s:    "marron",
+			expNC: NamedColour{
+				name:   "marron",
+				colour: cgaColours["brown"],
+			},
+		},
+		{
+			ID:   testhelper.MkID("French name wins: bordeaux"),
+			lang: "fr-CA",
+			s:    "bordeaux",
+			expNC: NamedColour{
+				name:   "bordeaux",
+				colour: webColours["maroon"],
+			},
+		},
+		{
+			ID:   testhelper.MkID("French name wins: x11:marron"),
+			lang: LangFrench,
+			s:    "x11:marron",
+			expNC: NamedColour{
+				name:   "x11:marron",
+				colour: x11Colours["brown"],
+			},
+		},
+		{
+			ID:   testhelper.MkID("no French name: teal"),
+			lang: LangFrench,
+			s:    "teal",
+			expNC: NamedColour{
+				name:   "teal",
+				colour: webColours["teal"],
+			},
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.Name, func(t *testing.T) {
-			nc, err := ParseNamedColour(tc.fl, tc.s)
+			nc, err := ParseNamedColourIn(tc.lang, tc.fl, tc.s)
+			if tc.lang == "" {
+				nc, err = ParseNamedColour(tc.fl, tc.s)
+			}
 
 			testhelper.CheckExpErr(t, err, tc)
 
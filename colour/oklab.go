@@ -0,0 +1,163 @@
+package colour
+
+import (
+	"fmt"
+	"image/color" //nolint:misspell
+	"math"
+)
+
+// The matrices used in the conversion between linear sRGB values and Oklab
+// values, as given by Björn Ottosson
+//
+//nolint:mnd
+var (
+	linearSRGBToLMS = matrix3{
+		{0.4122214708, 0.5363325363, 0.0514459929},
+		{0.2119034982, 0.6806995451, 0.1073969566},
+		{0.0883024619, 0.2817188376, 0.6299787005},
+	}
+	lmsToLinearSRGB = linearSRGBToLMS.inverse()
+
+	lmsToOklab = matrix3{
+		{0.2104542553, 0.7936177850, -0.0040720468},
+		{1.9779984951, -2.4285922050, 0.4505937099},
+		{0.0259040371, 0.7827717662, -0.8086757660},
+	}
+	oklabToLMS = lmsToOklab.inverse()
+)
+
+// Oklab represents a colour in the Oklab colour space. This is a
+// perceptually uniform colour space designed by Björn Ottosson to give
+// better predictions of lightness, chroma and hue than CIELAB. The
+// reference white is D65.
+type Oklab struct {
+	// L is the perceived lightness, from 0 (black) to 1 (white)
+	L float64
+	// A is the position between green (negative) and red (positive)
+	A float64
+	// B is the position between blue (negative) and yellow (positive)
+	B float64
+}
+
+// String returns a string representation of the Oklab value
+func (ok Oklab) String() string {
+	return fmt.Sprintf("{L:%0.4f A:%0.4f B:%0.4f}", ok.L, ok.A, ok.B)
+}
+
+// linearToOklab converts linear sRGB values into an Oklab value
+func linearToOklab(lin [3]float64) Oklab {
+	lms := linearSRGBToLMS.apply(lin)
+	for i, v := range lms {
+		lms[i] = math.Cbrt(v)
+	}
+
+	v := lmsToOklab.apply(lms)
+
+	return Oklab{L: v[0], A: v[1], B: v[2]}
+}
+
+// linear converts the Oklab value into linear sRGB values. The values are
+// not clipped and so may be outside the range [0, 1].
+func (ok Oklab) linear() [3]float64 {
+	lms := oklabToLMS.apply([3]float64{ok.L, ok.A, ok.B})
+	for i, v := range lms {
+		lms[i] = v * v * v
+	}
+
+	return lmsToLinearSRGB.apply(lms)
+}
+
+// Oklab converts the XYZ value (with a D65 white point) into an Oklab value
+func (xyz XYZ) Oklab() Oklab {
+	return linearToOklab(xyzToLinearSRGB.apply(xyz.vec()))
+}
+
+// XYZ converts the Oklab value into an XYZ value with a D65 white point
+func (ok Oklab) XYZ() XYZ {
+	return makeXYZ(linearSRGBToXYZ.apply(ok.linear()))
+}
+
+// RGBA2Oklab converts an RGBA colour value into an Oklab value. The RGBA
+// value is taken to be in the sRGB colour space.
+func RGBA2Oklab(c color.RGBA) Oklab { //nolint:misspell
+	return linearToOklab(rgbLinear(c))
+}
+
+// ToRGBA converts an Oklab colour value into an RGBA value in the sRGB
+// colour space. The alpha value is forced to 0xff. Colours outside the sRGB
+// gamut are clipped.
+func (ok Oklab) ToRGBA() color.RGBA { //nolint:misspell
+	return linearToRGBA(ok.linear(), math.MaxUint8)
+}
+
+// RGBA satisfies the Color interface from the [image/color] package
+//
+//nolint:misspell
+func (ok Oklab) RGBA() (r, g, b, a uint32) {
+	c := ok.ToRGBA()
+	return c.RGBA()
+}
+
+// Oklch converts the Oklab value into the equivalent cylindrical Oklch
+// value
+func (ok Oklab) Oklch() Oklch {
+	l, c, h := toPolar(ok.L, ok.A, ok.B)
+	return Oklch{L: l, C: c, H: h}
+}
+
+// Oklch represents a colour in the Oklch colour space. This is the Oklab
+// colour space in cylindrical coordinates.
+type Oklch struct {
+	// L is the perceived lightness, from 0 (black) to 1 (white)
+	L float64
+	// C is the chroma, the distance from the neutral axis
+	C float64
+	// H is the hue angle in degrees, in the range [0, 360)
+	H float64
+}
+
+// String returns a string representation of the Oklch value
+func (ok Oklch) String() string {
+	return fmt.Sprintf("{L:%0.4f C:%0.4f H:%0.4f}", ok.L, ok.C, ok.H)
+}
+
+// Oklab converts the Oklch value into the equivalent Oklab value
+func (ok Oklch) Oklab() Oklab {
+	l, a, b := fromPolar(ok.L, ok.C, ok.H)
+	return Oklab{L: l, A: a, B: b}
+}
+
+// RGBA2Oklch converts an RGBA colour value into an Oklch value. The RGBA
+// value is taken to be in the sRGB colour space.
+func RGBA2Oklch(c color.RGBA) Oklch { //nolint:misspell
+	return RGBA2Oklab(c).Oklch()
+}
+
+// ToRGBA converts an Oklch colour value into an RGBA value in the sRGB
+// colour space. The alpha value is forced to 0xff. Colours outside the sRGB
+// gamut are clipped.
+func (ok Oklch) ToRGBA() color.RGBA { //nolint:misspell
+	return ok.Oklab().ToRGBA()
+}
+
+// RGBA satisfies the Color interface from the [image/color] package
+//
+//nolint:misspell
+func (ok Oklch) RGBA() (r, g, b, a uint32) {
+	c := ok.ToRGBA()
+	return c.RGBA()
+}
+
+// oklabToRGBA converts the Oklab value into an RGBA value in the sRGB colour
+// space with the given alpha value. Colours outside the sRGB gamut are
+// mapped into it by reducing the chroma, preserving the lightness and hue.
+func oklabToRGBA(ok Oklab, a uint8) rgba {
+	srgb := gamutMapSRGB(ok.XYZ().vec())
+
+	return rgba{
+		R: toUint8(srgb[0] * math.MaxUint8),
+		G: toUint8(srgb[1] * math.MaxUint8),
+		B: toUint8(srgb[2] * math.MaxUint8),
+		A: a,
+	}
+}
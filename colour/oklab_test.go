@@ -0,0 +1,76 @@
+package colour
+
+import (
+	"testing"
+
+	"github.com/nickwells/colour.mod/v2/colourtesthelper"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestRGBA2Oklab(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		c        rgba
+		expOklab Oklab
+		expOklch Oklch
+	}{
+		{
+			ID: testhelper.MkID("black"),
+			c:  rgba{A: 0xff},
+		},
+		{
+			ID:       testhelper.MkID("white"),
+			c:        rgba{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+			expOklab: Oklab{L: 1},
+			expOklch: Oklch{L: 1},
+		},
+		{
+			ID:       testhelper.MkID("red"),
+			c:        rgba{R: 0xff, A: 0xff},
+			expOklab: Oklab{L: 0.62796, A: 0.22486, B: 0.12585},
+			expOklch: Oklch{L: 0.62796, C: 0.25768, H: 29.2339},
+		},
+		{
+			ID:       testhelper.MkID("blue"),
+			c:        rgba{B: 0xff, A: 0xff},
+			expOklab: Oklab{L: 0.45201, A: -0.03246, B: -0.31153},
+			expOklch: Oklch{L: 0.45201, C: 0.31321, H: 264.052},
+		},
+	}
+
+	for _, tc := range testCases {
+		ok := RGBA2Oklab(tc.c)
+
+		const epsilon = 0.0005
+		testhelper.DiffFloat(t, tc.IDStr(), "L",
+			ok.L, tc.expOklab.L, epsilon)
+		testhelper.DiffFloat(t, tc.IDStr(), "A",
+			ok.A, tc.expOklab.A, epsilon)
+		testhelper.DiffFloat(t, tc.IDStr(), "B",
+			ok.B, tc.expOklab.B, epsilon)
+
+		colourtesthelper.DiffRGBA(t, tc.IDStr(), "Oklab round trip",
+			ok.ToRGBA(), tc.c)
+
+		if tc.expOklch.C == 0 {
+			continue
+		}
+
+		lch := RGBA2Oklch(tc.c)
+
+		const hueEpsilon = 0.05
+		testhelper.DiffFloat(t, tc.IDStr(), "L",
+			lch.L, tc.expOklch.L, epsilon)
+		testhelper.DiffFloat(t, tc.IDStr(), "C",
+			lch.C, tc.expOklch.C, epsilon)
+		testhelper.DiffFloat(t, tc.IDStr(), "H",
+			lch.H, tc.expOklch.H, hueEpsilon)
+
+		colourtesthelper.DiffRGBA(t, tc.IDStr(), "Oklch round trip",
+			lch.ToRGBA(), tc.c)
+
+		xyz := RGBA2XYZ(tc.c)
+		colourtesthelper.DiffRGBA(t, tc.IDStr(), "XYZ round trip",
+			xyz.Oklab().XYZ().ToRGBA(), tc.c)
+	}
+}
@@ -0,0 +1,423 @@
+package colour
+
+import (
+	"errors"
+	"fmt"
+	"image/color" //nolint:misspell
+	"math"
+)
+
+// Paint records a colour of paint and the number of parts of it to use in a
+// mixture. The parts are relative to the parts of the other paints in the
+// mixture so, for instance, two paints with 1 part each are mixed in the
+// same proportions as two paints with 3 parts each.
+type Paint struct {
+	Colour color.RGBA //nolint:misspell
+	Parts  float64
+}
+
+// MakePaint returns a Paint of the given colour with the given number of
+// parts
+func MakePaint(c color.RGBA, parts float64) Paint { //nolint:misspell
+	return Paint{Colour: c, Parts: parts}
+}
+
+// MakePaintFromNamedColour returns a Paint of the colour of the NamedColour
+// with the given number of parts
+func MakePaintFromNamedColour(nc NamedColour, parts float64) Paint {
+	return MakePaint(nc.Colour(), parts)
+}
+
+// reflectanceModel holds the values needed to convert between spectral
+// reflectances and linear sRGB values. The reflectances are sampled at the
+// wavelengths of the CIE 1931 colour matching functions.
+type reflectanceModel struct {
+	// start is the first wavelength (in nanometres)
+	start float64
+	// step is the interval between wavelengths (in nanometres)
+	step float64
+	// toLinearRGB gives the contribution of the reflectance at each
+	// wavelength to the linear red, green and blue values of the colour
+	// lit by illuminant D65
+	toLinearRGB [3][]float64
+}
+
+// paintModel is the reflectanceModel used for mixing paints
+var paintModel = makeReflectanceModel()
+
+// makeReflectanceModel returns a reflectanceModel for surfaces lit by
+// illuminant D65 and seen by the CIE 1931 standard observer. The values are
+// scaled so that a perfect white reflector gives linear red, green and blue
+// values of exactly 1.
+func makeReflectanceModel() reflectanceModel {
+	d65, err := IlluminantD65.Spectrum()
+	if err != nil {
+		panic(fmt.Errorf("cannot make the reflectance model: %w", err))
+	}
+
+	rm := reflectanceModel{
+		start: cie1931CMF.start,
+		step:  cie1931CMF.step,
+	}
+
+	for i := range rm.toLinearRGB {
+		rm.toLinearRGB[i] = make([]float64, len(cie1931CMF.vals))
+	}
+
+	for i, cmf := range cie1931CMF.vals {
+		power := d65.At(rm.start + float64(i)*rm.step)
+		lin := xyzToLinearSRGB.apply(
+			[3]float64{power * cmf[0], power * cmf[1], power * cmf[2]})
+
+		for j := range lin {
+			rm.toLinearRGB[j][i] = lin[j]
+		}
+	}
+
+	for _, row := range rm.toLinearRGB {
+		var total float64
+		for _, v := range row {
+			total += v
+		}
+
+		for i := range row {
+			row[i] /= total
+		}
+	}
+
+	return rm
+}
+
+// linearRGB returns the linear red, green and blue values of the colour of
+// a surface with the given reflectance values
+func (rm reflectanceModel) linearRGB(r []float64) [3]float64 {
+	var lin [3]float64
+
+	for j, row := range rm.toLinearRGB {
+		for i, v := range row {
+			lin[j] += v * r[i]
+		}
+	}
+
+	return lin
+}
+
+// These constants control the estimation of reflectance curves
+const (
+	// minLinearRGB is the smallest linear red, green or blue value used
+	// when estimating a reflectance. A reflectance of zero cannot be
+	// represented (it has an infinitely large logarithm) so darker values
+	// are raised to this level.
+	minLinearRGB = 1e-4
+	// reflectanceTolerance is the largest error allowed when solving for
+	// the reflectance curve
+	reflectanceTolerance = 1e-9
+	// maxNewtonIterations is the greatest number of iterations of Newton's
+	// method that will be performed
+	maxNewtonIterations = 100
+)
+
+// reflectance returns an estimate of the reflectance curve of a surface
+// with the given colour. The curve is found using Scott Burns' iterative
+// least log slope squared (ILLSS) method. This finds the smoothest curve
+// (the one whose logarithm has the least squared slope) which gives the
+// colour exactly and whose values are all in the range (0, 1].
+//
+//nolint:misspell
+func (rm reflectanceModel) reflectance(c color.RGBA) []float64 {
+	n := len(rm.toLinearRGB[0])
+	target := rgbLinear(c)
+
+	r := make([]float64, n)
+	if target[0] == 1 && target[1] == 1 && target[2] == 1 {
+		for i := range r {
+			r[i] = 1
+		}
+
+		return r
+	}
+
+	for i := range target {
+		target[i] = max(target[i], minLinearRGB)
+	}
+
+	// z holds the logarithms of the reflectance values, fixed records
+	// those which are held at zero (a reflectance of 1)
+	z := make([]float64, n)
+	fixed := make([]bool, n)
+
+	for range n {
+		rm.solveLogReflectance(z, fixed, target)
+
+		changed := false
+
+		for i, v := range z {
+			if v > 0 {
+				z[i] = 0
+				fixed[i] = true
+				changed = true
+			}
+		}
+
+		if !changed {
+			break
+		}
+	}
+
+	for i, v := range z {
+		r[i] = math.Exp(v)
+	}
+
+	return r
+}
+
+// solveLogReflectance uses Newton's method to find the logarithms of the
+// reflectance values which give the target linear red, green and blue
+// values while minimising the sum of the squared differences between
+// adjacent values. Values that are marked as fixed are held at zero. The
+// solution is found using Lagrange multipliers for the constraints.
+func (rm reflectanceModel) solveLogReflectance(
+	z []float64, fixed []bool, target [3]float64,
+) {
+	n := len(z)
+	size := n + len(target)
+
+	var lambda [3]float64
+
+	for range maxNewtonIterations {
+		r := make([]float64, n)
+		for i, v := range z {
+			r[i] = math.Exp(v)
+		}
+
+		jac := make([][]float64, size)
+		for i := range jac {
+			jac[i] = make([]float64, size)
+		}
+
+		f := make([]float64, size)
+		lin := rm.linearRGB(r)
+
+		for k, row := range rm.toLinearRGB {
+			f[n+k] = lin[k] - target[k]
+
+			for i, v := range row {
+				jac[n+k][i] = v * r[i]
+			}
+		}
+
+		for i := range n {
+			if fixed[i] {
+				jac[i][i] = 1
+				continue
+			}
+
+			var tl float64
+			for k, row := range rm.toLinearRGB {
+				tl += row[i] * lambda[k]
+				jac[i][n+k] = row[i] * r[i]
+			}
+
+			// the gradient of the sum of the squared differences
+			diag := 4.0 //nolint:mnd
+			if i == 0 || i == n-1 {
+				diag = 2
+			}
+
+			f[i] = diag*z[i] + r[i]*tl
+			jac[i][i] = diag + r[i]*tl
+
+			if i > 0 {
+				f[i] -= 2 * z[i-1] //nolint:mnd
+				jac[i][i-1] = -2   //nolint:mnd
+			}
+
+			if i < n-1 {
+				f[i] -= 2 * z[i+1] //nolint:mnd
+				jac[i][i+1] = -2   //nolint:mnd
+			}
+		}
+
+		worst := 0.0
+		for _, v := range f {
+			worst = max(worst, math.Abs(v))
+		}
+
+		if worst < reflectanceTolerance {
+			return
+		}
+
+		for i := range f {
+			f[i] = -f[i]
+		}
+
+		delta, err := solveLinear(jac, f)
+		if err != nil {
+			return
+		}
+
+		for i := range n {
+			z[i] += delta[i]
+		}
+
+		for k := range lambda {
+			lambda[k] += delta[n+k]
+		}
+	}
+}
+
+// errSingularMatrix is returned when a set of linear equations has no
+// unique solution
+var errSingularMatrix = errors.New("the matrix is singular")
+
+// solveLinear solves the linear equations a.x = b for x using Gaussian
+// elimination with partial pivoting. The contents of a and b are changed.
+func solveLinear(a [][]float64, b []float64) ([]float64, error) {
+	n := len(b)
+
+	for col := range n {
+		pivot := col
+		for row := col + 1; row < n; row++ {
+			if math.Abs(a[row][col]) > math.Abs(a[pivot][col]) {
+				pivot = row
+			}
+		}
+
+		if a[pivot][col] == 0 {
+			return nil, errSingularMatrix
+		}
+
+		a[col], a[pivot] = a[pivot], a[col]
+		b[col], b[pivot] = b[pivot], b[col]
+
+		for row := col + 1; row < n; row++ {
+			factor := a[row][col] / a[col][col]
+			if factor == 0 {
+				continue
+			}
+
+			for k := col; k < n; k++ {
+				a[row][k] -= factor * a[col][k]
+			}
+
+			b[row] -= factor * b[col]
+		}
+	}
+
+	x := make([]float64, n)
+
+	for row := n - 1; row >= 0; row-- {
+		sum := b[row]
+		for k := row + 1; k < n; k++ {
+			sum -= a[row][k] * x[k]
+		}
+
+		x[row] = sum / a[row][row]
+	}
+
+	return x, nil
+}
+
+// EstimateReflectance returns an estimate of the spectral reflectance of a
+// surface (such as a paint) of the given colour when lit by illuminant
+// D65. Many different reflectance curves give the same colour; this returns
+// the smoothest one, found using Scott Burns' iterative least log slope
+// squared method. The values are all in the range (0, 1] and the curve
+// gives the original colour (except for very dark colours which are
+// lightened slightly).
+func EstimateReflectance(c color.RGBA) Spectrum { //nolint:misspell
+	return Spectrum{
+		start:  paintModel.start,
+		step:   paintModel.step,
+		values: paintModel.reflectance(c),
+	}
+}
+
+// kubelkaMunkKS returns the ratio of the absorption coefficient (K) to the
+// scattering coefficient (S) of an opaque layer of paint with the given
+// reflectance
+func kubelkaMunkKS(r float64) float64 {
+	return (1 - r) * (1 - r) / (2 * r) //nolint:mnd
+}
+
+// kubelkaMunkR returns the reflectance of an opaque layer of paint with the
+// given ratio of the absorption coefficient (K) to the scattering
+// coefficient (S). It is the inverse of kubelkaMunkKS.
+func kubelkaMunkR(ks float64) float64 {
+	return 1 + ks - math.Sqrt(ks*ks+2*ks) //nolint:mnd
+}
+
+// MixPaints returns the colour produced by mixing the paints in the
+// proportions given by their parts. Unlike blending RGB values, which
+// models mixing coloured lights, this models the way that pigments absorb
+// and scatter light so that, for instance, mixing blue and yellow paints
+// gives green rather than grey. The reflectance curve of each paint is
+// estimated (see [EstimateReflectance]) and the curves are combined using
+// the single-constant Kubelka-Munk model, which assumes that all the
+// paints scatter light equally.
+//
+// Saturated and dark colours have reflectances close to zero over much of
+// the spectrum and so, mixed in equal concentrations, they overwhelm the
+// other paints. To give mixtures which look more like those of real paints
+// the concentration of each paint is taken to be its parts multiplied by
+// its luminance. The alpha value of the result is the average of the alpha
+// values of the paints weighted by their parts.
+//
+// It returns a non-nil error if no paints are given, if any paint has a
+// negative number of parts or if the total number of parts is zero.
+//
+//nolint:misspell
+func MixPaints(paints ...Paint) (color.RGBA, error) {
+	if len(paints) == 0 {
+		return rgba{}, errors.New("no paints have been given to mix")
+	}
+
+	var totalParts float64
+
+	for i, p := range paints {
+		if p.Parts < 0 || math.IsNaN(p.Parts) {
+			return rgba{},
+				fmt.Errorf("the parts of paint %d (%.2f) must be >= 0",
+					i, p.Parts)
+		}
+
+		totalParts += p.Parts
+	}
+
+	if totalParts == 0 {
+		return rgba{}, errors.New("the total parts of paint must be > 0")
+	}
+
+	var (
+		alpha         float64
+		concentration = make([]float64, len(paints))
+		totalConc     float64
+	)
+
+	for i, p := range paints {
+		alpha += p.Parts * float64(p.Colour.A) / totalParts
+		concentration[i] = p.Parts * max(RGBA2XYZ(p.Colour).Y, minLinearRGB)
+		totalConc += concentration[i]
+	}
+
+	ks := make([]float64, len(paintModel.toLinearRGB[0]))
+
+	for i, p := range paints {
+		if p.Parts == 0 {
+			continue
+		}
+
+		wt := concentration[i] / totalConc
+
+		for j, r := range paintModel.reflectance(p.Colour) {
+			ks[j] += wt * kubelkaMunkKS(r)
+		}
+	}
+
+	r := make([]float64, len(ks))
+	for i, v := range ks {
+		r[i] = kubelkaMunkR(v)
+	}
+
+	return linearToRGBA(paintModel.linearRGB(r), toUint8(alpha)), nil
+}
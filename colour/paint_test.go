@@ -0,0 +1,157 @@
+package colour
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/nickwells/colour.mod/v2/colourtesthelper"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestEstimateReflectance(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		c rgba
+	}{
+		{ID: testhelper.MkID("white"), c: rgba{R: 0xff, G: 0xff, B: 0xff}},
+		{ID: testhelper.MkID("black"), c: rgba{}},
+		{ID: testhelper.MkID("red"), c: rgba{R: 0xff}},
+		{ID: testhelper.MkID("green"), c: rgba{G: 0xff}},
+		{ID: testhelper.MkID("blue"), c: rgba{B: 0xff}},
+		{ID: testhelper.MkID("yellow"), c: rgba{R: 0xff, G: 0xff}},
+		{ID: testhelper.MkID("tan"), c: rgba{R: 0xd2, G: 0xb4, B: 0x8c}},
+		{ID: testhelper.MkID("teal"), c: rgba{G: 0x80, B: 0x80}},
+	}
+
+	for _, tc := range testCases {
+		s := EstimateReflectance(tc.c)
+
+		testhelper.DiffFloat(t, tc.IDStr(), "start wavelength",
+			s.Start(), MinWavelength, 0)
+		testhelper.DiffFloat(t, tc.IDStr(), "end wavelength",
+			s.End(), MaxWavelength, 0)
+
+		for i, v := range s.Values() {
+			if v <= 0 || v > 1 {
+				t.Log(tc.IDStr())
+				t.Logf("\t: reflectance[%d]: %g", i, v)
+				t.Errorf("\t: the reflectance should be in the range (0, 1]\n")
+			}
+		}
+
+		// the spectrum is integrated at a finer resolution than that
+		// used to estimate it so the values only match approximately
+		xyz, err := CIE1931Observer.ReflectanceToXYZ(s, IlluminantD65)
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		expXYZ := RGBA2XYZ(tc.c)
+
+		const epsilon = 0.01
+		testhelper.DiffFloat(t, tc.IDStr(), "X", xyz.X, expXYZ.X, epsilon)
+		testhelper.DiffFloat(t, tc.IDStr(), "Y", xyz.Y, expXYZ.Y, epsilon)
+		testhelper.DiffFloat(t, tc.IDStr(), "Z", xyz.Z, expXYZ.Z, epsilon)
+	}
+}
+
+func TestMixPaints(t *testing.T) {
+	white := rgba{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+	black := rgba{A: 0xff}
+	blue := rgba{B: 0xff, A: 0xff}
+	yellow := rgba{R: 0xff, G: 0xff, A: 0xff}
+	tan := rgba{R: 0xd2, G: 0xb4, B: 0x8c, A: 0xff}
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		paints    []Paint
+		expColour rgba
+	}{
+		{
+			ID:     testhelper.MkID("no paints"),
+			ExpErr: testhelper.MkExpErr("no paints have been given to mix"),
+		},
+		{
+			ID: testhelper.MkID("negative parts"),
+			ExpErr: testhelper.MkExpErr(
+				"the parts of paint 1 (-1.00) must be >= 0"),
+			paints: []Paint{MakePaint(white, 1), MakePaint(black, -1)},
+		},
+		{
+			ID: testhelper.MkID("no parts"),
+			ExpErr: testhelper.MkExpErr(
+				"the total parts of paint must be > 0"),
+			paints: []Paint{MakePaint(white, 0), MakePaint(black, 0)},
+		},
+		{
+			ID:        testhelper.MkID("one paint"),
+			paints:    []Paint{MakePaint(tan, 3)},
+			expColour: tan,
+		},
+		{
+			ID:        testhelper.MkID("same paint"),
+			paints:    []Paint{MakePaint(tan, 3), MakePaint(tan, 1)},
+			expColour: tan,
+		},
+		{
+			ID:        testhelper.MkID("zero parts ignored"),
+			paints:    []Paint{MakePaint(tan, 1), MakePaint(blue, 0)},
+			expColour: tan,
+		},
+		{
+			ID: testhelper.MkID("named paints"),
+			paints: []Paint{
+				MakePaintFromNamedColour(MakeNamedColour("tan", tan), 1),
+			},
+			expColour: tan,
+		},
+		{
+			ID:        testhelper.MkID("blue and yellow"),
+			paints:    []Paint{MakePaint(blue, 1), MakePaint(yellow, 1)},
+			expColour: rgba{R: 0x00, G: 0x96, B: 0x56, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("white and black"),
+			paints:    []Paint{MakePaint(white, 1), MakePaint(black, 1)},
+			expColour: rgba{R: 0xa6, G: 0xa6, B: 0xa6, A: 0xff},
+		},
+		{
+			ID: testhelper.MkID("alpha"),
+			paints: []Paint{
+				MakePaint(rgba{R: 0xd2, G: 0xb4, B: 0x8c, A: 0x80}, 1),
+				MakePaint(tan, 1),
+			},
+			expColour: rgba{R: 0xd2, G: 0xb4, B: 0x8c, A: 0xc0},
+		},
+	}
+
+	for _, tc := range testCases {
+		c, err := MixPaints(tc.paints...)
+		if testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc) &&
+			err == nil {
+			colourtesthelper.DiffRGBA(t, tc.IDStr(), "mixed paint",
+				c, tc.expColour)
+		}
+	}
+}
+
+func TestMixPaintsIsNotRGBBlending(t *testing.T) {
+	blue := rgba{R: 0x00, G: 0x21, B: 0x85, A: 0xff}
+	yellow := rgba{R: 0xfc, G: 0xd3, B: 0x00, A: 0xff}
+
+	for _, parts := range []float64{1, 2, 3} {
+		id := fmt.Sprintf("1 part blue, %g parts yellow", parts)
+
+		c, err := MixPaints(MakePaint(blue, 1), MakePaint(yellow, parts))
+		if err != nil {
+			t.Fatal(id, ": unexpected error:", err)
+		}
+
+		if c.G <= c.R || c.G <= c.B {
+			t.Log(id)
+			t.Logf("\t: mixed: %v", c)
+			t.Errorf("\t: the mixed colour should be green\n")
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package colour
+
+import (
+	"image"
+	"image/color" //nolint:misspell
+)
+
+// Transform is a colour transformation. Transforms are values and so can be
+// stored, passed around and combined into pipelines using Then. They can be
+// applied to a single colour, to a slice of colours or to an image.
+//
+// Any function taking and returning a single colour can be used as a
+// Transform, for instance:
+//
+//	t := Transform(ToGrey).Then(Invert)
+//
+//nolint:misspell
+type Transform func(c color.RGBA) color.RGBA
+
+// Identity returns a Transform which leaves the colour unchanged
+func Identity() Transform {
+	return func(c rgba) rgba { return c }
+}
+
+// Then returns a Transform which applies the Transform followed by each
+// of the supplied Transforms in turn
+func (t Transform) Then(next ...Transform) Transform {
+	return func(c rgba) rgba {
+		c = t(c)
+		for _, n := range next {
+			c = n(c)
+		}
+
+		return c
+	}
+}
+
+// Pipeline returns a Transform which applies each of the supplied
+// Transforms in turn. If no Transforms are given the colour is unchanged.
+func Pipeline(ts ...Transform) Transform {
+	return Identity().Then(ts...)
+}
+
+// Apply returns the result of applying the Transform to the colour
+func (t Transform) Apply(c color.RGBA) color.RGBA { //nolint:misspell
+	return t(c)
+}
+
+// ApplyToColours returns a new slice holding the results of applying the
+// Transform to each of the colours. The supplied slice is unchanged.
+//
+//nolint:misspell
+func (t Transform) ApplyToColours(cs []color.RGBA) []color.RGBA {
+	rval := make([]rgba, 0, len(cs))
+	for _, c := range cs {
+		rval = append(rval, t(c))
+	}
+
+	return rval
+}
+
+// ApplyToImage returns a new image with the same bounds as the supplied
+// image and with the Transform applied to each pixel. The colour of each
+// pixel is passed to the Transform with its alpha value not premultiplied,
+// as for all the colours in this package, and the resulting image holds
+// colours in the same form. The work is shared between as many goroutines
+// as there are CPUs; use an [ImageTransformer] for more control.
+func (t Transform) ApplyToImage(img image.Image) *image.NRGBA {
+	return ImageTransformer{Transform: t}.Apply(img)
+}
@@ -0,0 +1,97 @@
+package colour
+
+import (
+	"image"
+	"image/color" //nolint:misspell
+	"testing"
+
+	"github.com/nickwells/colour.mod/v2/colourtesthelper"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestTransformThen(t *testing.T) {
+	c := rgba{R: 0x3b, G: 0x82, B: 0xf6, A: 0x80}
+
+	testCases := []struct {
+		testhelper.ID
+		t         Transform
+		expColour rgba
+	}{
+		{
+			ID:        testhelper.MkID("identity"),
+			t:         Identity(),
+			expColour: c,
+		},
+		{
+			ID:        testhelper.MkID("empty pipeline"),
+			t:         Pipeline(),
+			expColour: c,
+		},
+		{
+			ID:        testhelper.MkID("function as Transform"),
+			t:         Transform(Invert),
+			expColour: rgba{R: 0xc4, G: 0x7d, B: 0x09, A: 0x80},
+		},
+		{
+			ID:        testhelper.MkID("lighten then desaturate"),
+			t:         Lighten(0.1).Then(Desaturate(0.2)),
+			expColour: rgba{R: 0x7b, G: 0xa5, B: 0xe9, A: 0x80},
+		},
+		{
+			ID:        testhelper.MkID("pipeline: lighten, desaturate"),
+			t:         Pipeline(Lighten(0.1), Desaturate(0.2)),
+			expColour: rgba{R: 0x7b, G: 0xa5, B: 0xe9, A: 0x80},
+		},
+		{
+			ID:        testhelper.MkID("then: several"),
+			t:         Identity().Then(Invert, Invert),
+			expColour: c,
+		},
+	}
+
+	for _, tc := range testCases {
+		colourtesthelper.DiffRGBA(t, tc.IDStr(), "colour",
+			tc.t.Apply(c), tc.expColour)
+	}
+}
+
+func TestTransformApplyToColours(t *testing.T) {
+	cs := []rgba{
+		{R: 0xff, A: 0xff},
+		{G: 0xff, A: 0x80},
+	}
+	exp := []rgba{
+		{G: 0xff, B: 0xff, A: 0xff},
+		{R: 0xff, B: 0xff, A: 0x80},
+	}
+
+	act := Transform(Invert).ApplyToColours(cs)
+
+	if len(act) != len(exp) {
+		t.Fatalf("expected %d colours, got %d", len(exp), len(act))
+	}
+
+	for i := range exp {
+		colourtesthelper.DiffRGBA(t, "invert", "colour", act[i], exp[i])
+	}
+
+	colourtesthelper.DiffRGBA(t, "invert", "original colour",
+		cs[0], rgba{R: 0xff, A: 0xff})
+}
+
+func TestTransformApplyToImage(t *testing.T) {
+	img := image.NewRGBA(image.Rect(1, 1, 3, 2))
+	img.Set(1, 1, color.NRGBA{R: 0xff, A: 0xff}) //nolint:misspell
+	img.Set(2, 1, color.NRGBA{G: 0xff, A: 0x80}) //nolint:misspell
+
+	out := Transform(Invert).ApplyToImage(img)
+
+	if out.Bounds() != img.Bounds() {
+		t.Fatalf("bad bounds: expected %v, got %v", img.Bounds(), out.Bounds())
+	}
+
+	colourtesthelper.DiffRGBA(t, "invert", "pixel (1, 1)",
+		rgba(out.NRGBAAt(1, 1)), rgba{G: 0xff, B: 0xff, A: 0xff})
+	colourtesthelper.DiffRGBA(t, "invert", "pixel (2, 1)",
+		rgba(out.NRGBAAt(2, 1)), rgba{R: 0xff, B: 0xff, A: 0x80})
+}
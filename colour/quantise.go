@@ -0,0 +1,235 @@
+package colour
+
+import (
+	"fmt"
+	"image"
+	"image/color" //nolint:misspell
+	"math"
+)
+
+// DistanceMetric identifies the way that the distance between two colours
+// is measured when finding the closest colour
+type DistanceMetric int
+
+// These are the available distance metrics. RGBDistance is the Euclidean
+// distance in the RGB colour cube as used by [Families.ClosestN]; it is
+// fast but does not match perceived differences well. OklabDistance is the
+// Euclidean distance in the perceptually uniform Oklab colour space.
+const (
+	RGBDistance   DistanceMetric = iota // RGB
+	OklabDistance                       // Oklab
+)
+
+// DitherMethod identifies the dithering used when quantising an image.
+// Dithering spreads the difference between the colours of the original
+// image and those of the palette over neighbouring pixels so that areas of
+// the image show, on average, their original colour.
+type DitherMethod int
+
+// These are the available dithering methods. FloydSteinberg and Atkinson
+// are error diffusion methods; Atkinson diffuses only three quarters of the
+// error which gives higher contrast and less noise but loses detail in
+// very light and very dark areas. Bayer is ordered dithering using a 4x4
+// Bayer threshold matrix; it gives a regular cross-hatched pattern and each
+// pixel is quantised independently of the others.
+const (
+	NoDither       DitherMethod = iota // none
+	FloydSteinberg                     // Floyd-Steinberg
+	Atkinson                           // Atkinson
+	Bayer                              // ordered Bayer
+)
+
+// maxPaletteSize is the maximum number of colours in the palette of an
+// image.Paletted, whose pixels are indexes of type uint8
+const maxPaletteSize = math.MaxUint8 + 1
+
+// bayer4 is the 4x4 Bayer threshold matrix
+var bayer4 = [4][4]float64{
+	{0, 8, 2, 10},  //nolint:mnd
+	{12, 4, 14, 6}, //nolint:mnd
+	{3, 11, 1, 9},  //nolint:mnd
+	{15, 7, 13, 5}, //nolint:mnd
+}
+
+// diffusion describes how the error at a pixel is spread over the
+// neighbouring pixels: each entry gives the offset of the pixel and the
+// share of the error it receives
+type diffusion []struct {
+	dx, dy int
+	share  float64
+}
+
+// diffusions gives the error diffusion for each error diffusion method
+//
+//nolint:mnd
+var diffusions = map[DitherMethod]diffusion{
+	FloydSteinberg: {
+		{1, 0, 7.0 / 16},
+		{-1, 1, 3.0 / 16},
+		{0, 1, 5.0 / 16},
+		{1, 1, 1.0 / 16},
+	},
+	Atkinson: {
+		{1, 0, 1.0 / 8},
+		{2, 0, 1.0 / 8},
+		{-1, 1, 1.0 / 8},
+		{0, 1, 1.0 / 8},
+		{1, 1, 1.0 / 8},
+		{0, 2, 1.0 / 8},
+	},
+}
+
+// Quantiser re-renders images using only the colours of a palette. Use
+// [MakeQuantiser] or [Families.MakeQuantiser] to make one and then set the
+// Metric and Dither fields as needed.
+type Quantiser struct {
+	PerceptualPalette
+
+	// Dither is the dithering used
+	Dither DitherMethod
+}
+
+// MakeQuantiser returns a Quantiser using the given colours. The alpha
+// values of the colours are ignored and duplicate colours are removed. The
+// Metric is set to OklabDistance; set it to RGBDistance for a faster but
+// less faithful choice of colours. An error is returned if there are no
+// colours or if there are more than 256, the most that an image.Paletted
+// can hold.
+//
+//nolint:misspell
+func MakeQuantiser(cs []color.RGBA) (Quantiser, error) {
+	pp, err := MakePerceptualPalette(cs)
+	if err != nil {
+		return Quantiser{}, err
+	}
+
+	if len(pp.colours) > maxPaletteSize {
+		return Quantiser{},
+			fmt.Errorf("there are too many colours (%d) in the palette:"+
+				" the maximum is %d", len(pp.colours), maxPaletteSize)
+	}
+
+	return Quantiser{PerceptualPalette: pp}, nil
+}
+
+// MakeQuantiser returns a Quantiser using the colours in the Families. The
+// colours are placed in the palette sorted using RGBACompare. An error is
+// returned if any of the Families is not recognised or if they have more
+// than 256 distinct colours.
+//
+// If no families are given then the standard families are used.
+func (fl Families) MakeQuantiser() (Quantiser, error) {
+	p, err := fl.Palette()
+	if err != nil {
+		return Quantiser{}, err
+	}
+
+	return MakeQuantiser(paletteColours(p))
+}
+
+// toRGBA returns the colour with the given red, green and blue values,
+// rounded and clamped to the range [0, 255]
+func toRGBA(v [3]float64) rgba {
+	return rgba{
+		R: toUint8(min(max(v[0], 0), math.MaxUint8)),
+		G: toUint8(min(max(v[1], 0), math.MaxUint8)),
+		B: toUint8(min(max(v[2], 0), math.MaxUint8)),
+		A: math.MaxUint8,
+	}
+}
+
+// Quantise returns a new image with the same bounds as the supplied image
+// in which each pixel is set to one of the palette colours, chosen using
+// the Quantiser's Metric and Dither values. The alpha values of the pixels
+// of the supplied image are ignored.
+func (q Quantiser) Quantise(img image.Image) *image.Paletted {
+	b := img.Bounds()
+	out := image.NewPaletted(b, q.Palette())
+
+	cache := map[rgba]uint8{}
+	index := func(c rgba) uint8 {
+		idx, ok := cache[c]
+		if !ok {
+			idx = uint8(q.closest(c)) //nolint:gosec
+			cache[c] = idx
+		}
+
+		return idx
+	}
+
+	if q.Dither == Bayer {
+		q.bayerDither(img, out, index)
+		return out
+	}
+
+	w := b.Dx()
+	errs := make([][3]float64, w*b.Dy())
+	diff := diffusions[q.Dither]
+
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			c := pixelColour(img, x, y)
+			i := (y-b.Min.Y)*w + (x - b.Min.X)
+			v := [3]float64{
+				float64(c.R) + errs[i][0],
+				float64(c.G) + errs[i][1],
+				float64(c.B) + errs[i][2],
+			}
+
+			idx := index(toRGBA(v))
+			out.SetColorIndex(x, y, idx)
+
+			if len(diff) == 0 {
+				continue
+			}
+
+			pc := q.colours[idx]
+			e := [3]float64{
+				v[0] - float64(pc.R),
+				v[1] - float64(pc.G),
+				v[2] - float64(pc.B),
+			}
+
+			for _, d := range diff {
+				nx, ny := x+d.dx, y+d.dy
+				if nx < b.Min.X || nx >= b.Max.X || ny >= b.Max.Y {
+					continue
+				}
+
+				j := (ny-b.Min.Y)*w + (nx - b.Min.X)
+				for k := range e {
+					errs[j][k] += e[k] * d.share
+				}
+			}
+		}
+	}
+
+	return out
+}
+
+// bayerDither sets the pixels of the output image using ordered
+// dithering. The threshold offsets are scaled by the typical distance
+// between the colours of the palette, estimated from the number of
+// colours.
+func (q Quantiser) bayerDither(
+	img image.Image, out *image.Paletted, index func(rgba) uint8,
+) {
+	b := img.Bounds()
+	spread := math.MaxUint8 / math.Cbrt(float64(len(q.colours)))
+
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			c := pixelColour(img, x, y)
+			t := (bayer4[y&3][x&3]+0.5)/16 - 0.5 //nolint:mnd
+			offset := t * spread
+
+			v := [3]float64{
+				float64(c.R) + offset,
+				float64(c.G) + offset,
+				float64(c.B) + offset,
+			}
+
+			out.SetColorIndex(x, y, index(toRGBA(v)))
+		}
+	}
+}
@@ -0,0 +1,235 @@
+package colour
+
+import (
+	"image"
+	"image/color" //nolint:misspell
+	"slices"
+	"testing"
+
+	"github.com/nickwells/colour.mod/v2/colourtesthelper"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestMakeQuantiser(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		cs         []rgba
+		expPalette []rgba
+	}{
+		{
+			ID:     testhelper.MkID("no colours"),
+			ExpErr: testhelper.MkExpErr("there are no colours in the palette"),
+		},
+		{
+			ID: testhelper.MkID("too many colours"),
+			ExpErr: testhelper.MkExpErr(
+				"there are too many colours (257) in the palette:" +
+					" the maximum is 256"),
+			cs: func() []rgba {
+				cs := []rgba{}
+				for i := range 257 {
+					cs = append(cs, rgba{R: uint8(i), G: uint8(i >> 8)})
+				}
+
+				return cs
+			}(),
+		},
+		{
+			ID: testhelper.MkID("duplicates and alpha"),
+			cs: []rgba{
+				{R: 1, A: 0x80},
+				{R: 2, A: 0xff},
+				{R: 1, A: 0xff},
+			},
+			expPalette: []rgba{
+				{R: 1, A: 0xff},
+				{R: 2, A: 0xff},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		q, err := MakeQuantiser(tc.cs)
+		if testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc) &&
+			err == nil {
+			testhelper.DiffInt(t, tc.IDStr(), "metric",
+				int(q.Metric), int(OklabDistance))
+
+			p := q.Palette()
+			testhelper.DiffInt(t, tc.IDStr(), "palette size",
+				len(p), len(tc.expPalette))
+
+			for i, c := range p {
+				if i >= len(tc.expPalette) {
+					break
+				}
+
+				colourtesthelper.DiffRGBA(t, tc.IDStr(), "palette colour",
+					c.(rgba), tc.expPalette[i])
+			}
+		}
+	}
+}
+
+func TestFamiliesMakeQuantiser(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		fl      Families
+		expSize int
+	}{
+		{
+			ID:     testhelper.MkID("bad family"),
+			ExpErr: testhelper.MkExpErr(BadFamily),
+			fl:     Families{"nonesuch"},
+		},
+		{
+			ID: testhelper.MkID("too many colours"),
+			ExpErr: testhelper.MkExpErr(
+				"there are too many colours (949) in the palette"),
+			fl: Families{XKCDColours},
+		},
+		{
+			ID:      testhelper.MkID("CGA"),
+			fl:      Families{CGAColours},
+			expSize: 16,
+		},
+		{
+			ID:      testhelper.MkID("Crayola"),
+			fl:      Families{CrayolaColours},
+			expSize: 168,
+		},
+	}
+
+	for _, tc := range testCases {
+		q, err := tc.fl.MakeQuantiser()
+		if testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc) &&
+			err == nil {
+			p := q.Palette()
+			testhelper.DiffInt(t, tc.IDStr(), "palette size",
+				len(p), tc.expSize)
+
+			isSorted := slices.IsSortedFunc(p,
+				func(a, b color.Color) int { //nolint:misspell
+					return RGBACompare(a.(rgba), b.(rgba))
+				})
+			if !isSorted {
+				t.Log(tc.IDStr())
+				t.Errorf("\t: the palette should be sorted")
+			}
+		}
+	}
+}
+
+func TestQuantise(t *testing.T) {
+	grad := image.NewNRGBA(image.Rect(0, 0, 8, 4))
+
+	for y := range 4 {
+		for x := range 8 {
+			v := uint8(x * 0xff / 7)                    //nolint:gosec
+			c := color.NRGBA{R: v, G: v, B: v, A: 0xff} //nolint:misspell
+			grad.SetNRGBA(x, y, c)
+		}
+	}
+
+	bw, err := MakeQuantiser([]rgba{{}, {R: 0xff, G: 0xff, B: 0xff}})
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	cga, err := Families{CGAColours}.MakeQuantiser()
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	pair := image.NewNRGBA(image.Rect(0, 0, 2, 1))
+	pair.Set(0, 0, rgba{R: 0xff, G: 0x80, A: 0xff})
+	pair.Set(1, 0, rgba{R: 0x30, G: 0x30, B: 0x90, A: 0xff})
+
+	testCases := []struct {
+		testhelper.ID
+		q      Quantiser
+		metric DistanceMetric
+		dither DitherMethod
+		img    image.Image
+		expPix []uint8
+	}{
+		{
+			ID:  testhelper.MkID("no dithering"),
+			q:   bw,
+			img: grad,
+			expPix: []uint8{
+				0, 0, 0, 0, 1, 1, 1, 1,
+				0, 0, 0, 0, 1, 1, 1, 1,
+				0, 0, 0, 0, 1, 1, 1, 1,
+				0, 0, 0, 0, 1, 1, 1, 1,
+			},
+		},
+		{
+			ID:     testhelper.MkID("Floyd-Steinberg"),
+			q:      bw,
+			dither: FloydSteinberg,
+			img:    grad,
+			expPix: []uint8{
+				0, 0, 0, 1, 0, 1, 1, 1,
+				0, 0, 0, 1, 0, 1, 1, 1,
+				0, 0, 1, 0, 1, 1, 1, 1,
+				0, 0, 0, 1, 0, 1, 0, 1,
+			},
+		},
+		{
+			ID:     testhelper.MkID("Atkinson"),
+			q:      bw,
+			dither: Atkinson,
+			img:    grad,
+			expPix: []uint8{
+				0, 0, 0, 0, 1, 1, 1, 1,
+				0, 0, 0, 1, 1, 1, 1, 1,
+				0, 0, 0, 1, 0, 1, 1, 1,
+				0, 0, 0, 0, 1, 1, 1, 1,
+			},
+		},
+		{
+			ID:     testhelper.MkID("ordered Bayer"),
+			q:      bw,
+			dither: Bayer,
+			img:    grad,
+			expPix: []uint8{
+				0, 0, 0, 1, 0, 1, 1, 1,
+				0, 0, 1, 0, 1, 1, 1, 1,
+				0, 0, 0, 1, 0, 1, 1, 1,
+				0, 0, 1, 0, 1, 1, 1, 1,
+			},
+		},
+		{
+			ID:     testhelper.MkID("CGA, RGB distance"),
+			q:      cga,
+			metric: RGBDistance,
+			img:    pair,
+			expPix: []uint8{8, 3},
+		},
+		{
+			ID:     testhelper.MkID("CGA, Oklab distance"),
+			q:      cga,
+			metric: OklabDistance,
+			img:    pair,
+			expPix: []uint8{12, 3},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc.q.Metric = tc.metric
+		tc.q.Dither = tc.dither
+
+		out := tc.q.Quantise(tc.img)
+
+		if out.Bounds() != tc.img.Bounds() {
+			t.Log(tc.IDStr())
+			t.Errorf("\t: bad bounds: expected %v, got %v",
+				tc.img.Bounds(), out.Bounds())
+		}
+
+		testhelper.DiffSlice(t, tc.IDStr(), "pixels", out.Pix, tc.expPix)
+	}
+}
@@ -0,0 +1,170 @@
+package colour
+
+import "math"
+
+// WorkingSpace identifies the colour space in which a relative transform
+// adjusts the lightness, saturation or hue of a colour
+type WorkingSpace int
+
+// These are the available working spaces. HSL and HSV are simple and fast
+// but the changes they make are not perceptually even: lightening yellow
+// and blue by the same amount gives very different changes in the
+// perceived lightness. Oklch gives perceptually even changes.
+const (
+	HSLSpace   WorkingSpace = iota // HSL
+	HSVSpace                       // HSV
+	OklchSpace                     // Oklch
+)
+
+// oklchMaxChroma is the Oklch chroma treated as full saturation when
+// changing the saturation of a colour in the Oklch working space. This is
+// the value taken as 100% chroma by CSS.
+const oklchMaxChroma = 0.4
+
+// clamp01 returns the value clamped to the range [0, 1]
+func clamp01(v float64) float64 {
+	return min(max(v, 0), 1)
+}
+
+// adjustment is a function which is given the lightness, saturation and
+// hue of a colour in a working space and returns new values. The lightness
+// and saturation are in the range [0, 1] and the hue is in degrees.
+type adjustment func(l, s, h float64) (float64, float64, float64)
+
+// transform returns a Transform which applies the adjustment to the colour
+// in the working space. The lightness and saturation are clamped to the
+// range [0, 1] and the hue is normalised to [0, 360). The alpha value of
+// the colour is preserved. If the working space is not known the colour is
+// unchanged.
+func (ws WorkingSpace) transform(adj adjustment) Transform {
+	return func(c rgba) rgba {
+		var rval rgba
+
+		switch ws {
+		case HSLSpace:
+			hsl, _ := RGBA2HSLAndHSV(c)
+			l, s, h := adj(hsl.Luminance, hsl.Saturation, hsl.Hue)
+			rval = HSL{
+				Hue:        normaliseHue(h),
+				Saturation: clamp01(s),
+				Luminance:  clamp01(l),
+			}.ToRGBA()
+		case HSVSpace:
+			_, hsv := RGBA2HSLAndHSV(c)
+			v, s, h := adj(hsv.Value, hsv.Saturation, hsv.Hue)
+			rval = HSV{
+				Hue:        normaliseHue(h),
+				Saturation: clamp01(s),
+				Value:      clamp01(v),
+			}.ToRGBA()
+		case OklchSpace:
+			lch := RGBA2Oklch(c)
+			l, s, h := adj(lch.L, lch.C/oklchMaxChroma, lch.H)
+			lch = Oklch{
+				L: clamp01(l),
+				C: clamp01(s) * oklchMaxChroma,
+				H: normaliseHue(h),
+			}
+
+			return oklabToRGBA(lch.Oklab(), c.A)
+		default:
+			return c
+		}
+
+		rval.A = c.A
+
+		return rval
+	}
+}
+
+// Lighten returns a Transform which increases the lightness of a colour by
+// the given amount. The lightness is measured on a scale from 0 (black) to
+// 1 (white) so an amount of 0.2 makes the colour 20% lighter. The result is
+// clamped so the colour will never be lighter than white. A negative
+// amount darkens the colour. In the HSV working space the value is
+// changed.
+func (ws WorkingSpace) Lighten(amount float64) Transform {
+	if amount == 0 {
+		return Identity()
+	}
+
+	return ws.transform(func(l, s, h float64) (float64, float64, float64) {
+		return l + amount, s, h
+	})
+}
+
+// Darken returns a Transform which decreases the lightness of a colour by
+// the given amount. It is the same as Lighten with the amount negated.
+func (ws WorkingSpace) Darken(amount float64) Transform {
+	return ws.Lighten(-amount)
+}
+
+// Saturate returns a Transform which increases the saturation of a colour
+// by the given amount. The saturation is measured on a scale from 0 (grey)
+// to 1 (fully saturated) and the result is clamped to that range. A
+// negative amount desaturates the colour. In the Oklch working space the
+// chroma is changed, with a chroma of 0.4 taken as fully saturated; the
+// resulting colour is brought back into the sRGB gamut by reducing the
+// chroma if necessary.
+func (ws WorkingSpace) Saturate(amount float64) Transform {
+	if amount == 0 {
+		return Identity()
+	}
+
+	return ws.transform(func(l, s, h float64) (float64, float64, float64) {
+		return l, s + amount, h
+	})
+}
+
+// Desaturate returns a Transform which decreases the saturation of a colour
+// by the given amount. It is the same as Saturate with the amount negated.
+func (ws WorkingSpace) Desaturate(amount float64) Transform {
+	return ws.Saturate(-amount)
+}
+
+// RotateHue returns a Transform which rotates the hue of a colour by the
+// given number of degrees. Positive values rotate the hue from red towards
+// yellow and green and negative values rotate it the other way. Greys are
+// unchanged.
+func (ws WorkingSpace) RotateHue(degrees float64) Transform {
+	if math.Mod(degrees, maxHue) == 0 {
+		return Identity()
+	}
+
+	return ws.transform(func(l, s, h float64) (float64, float64, float64) {
+		return l, s, h + degrees
+	})
+}
+
+// Lighten returns a Transform which increases the lightness of a colour by
+// the given amount in the HSL working space. See [WorkingSpace.Lighten].
+func Lighten(amount float64) Transform {
+	return HSLSpace.Lighten(amount)
+}
+
+// Darken returns a Transform which decreases the lightness of a colour by
+// the given amount in the HSL working space. See [WorkingSpace.Darken].
+func Darken(amount float64) Transform {
+	return HSLSpace.Darken(amount)
+}
+
+// Saturate returns a Transform which increases the saturation of a colour
+// by the given amount in the HSL working space. See
+// [WorkingSpace.Saturate].
+func Saturate(amount float64) Transform {
+	return HSLSpace.Saturate(amount)
+}
+
+// Desaturate returns a Transform which decreases the saturation of a colour
+// by the given amount in the HSL working space. See
+// [WorkingSpace.Desaturate].
+func Desaturate(amount float64) Transform {
+	return HSLSpace.Desaturate(amount)
+}
+
+// RotateHue returns a Transform which rotates the hue of a colour by the
+// given number of degrees in the HSL working space. See
+// [WorkingSpace.RotateHue].
+func RotateHue(degrees float64) Transform {
+	return HSLSpace.RotateHue(degrees)
+}
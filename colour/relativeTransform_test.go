@@ -0,0 +1,199 @@
+package colour
+
+import (
+	"testing"
+
+	"github.com/nickwells/colour.mod/v2/colourtesthelper"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestRelativeTransforms(t *testing.T) {
+	c := rgba{R: 0x3b, G: 0x82, B: 0xf6, A: 0x80}
+
+	testCases := []struct {
+		testhelper.ID
+		t         Transform
+		expColour rgba
+	}{
+		{
+			ID:        testhelper.MkID("HSL: lighten 0.1"),
+			t:         HSLSpace.Lighten(0.1),
+			expColour: rgba{R: 0x6c, G: 0xa1, B: 0xf8, A: 0x80},
+		},
+		{
+			ID:        testhelper.MkID("HSL: darken 0.1"),
+			t:         HSLSpace.Darken(0.1),
+			expColour: rgba{R: 0x0b, G: 0x63, B: 0xf3, A: 0x80},
+		},
+		{
+			ID:        testhelper.MkID("HSL: saturate 0.1"),
+			t:         HSLSpace.Saturate(0.1),
+			expColour: rgba{R: 0x32, G: 0x80, B: 0xff, A: 0x80},
+		},
+		{
+			ID:        testhelper.MkID("HSL: desaturate 0.2"),
+			t:         HSLSpace.Desaturate(0.2),
+			expColour: rgba{R: 0x50, G: 0x87, B: 0xe2, A: 0x80},
+		},
+		{
+			ID:        testhelper.MkID("HSL: rotate hue 30"),
+			t:         HSLSpace.RotateHue(30),
+			expColour: rgba{R: 0x52, G: 0x3b, B: 0xf6, A: 0x80},
+		},
+		{
+			ID:        testhelper.MkID("HSL: rotate hue -90"),
+			t:         HSLSpace.RotateHue(-90),
+			expColour: rgba{R: 0x3b, G: 0xf6, B: 0x52, A: 0x80},
+		},
+		{
+			ID:        testhelper.MkID("HSL: lighten 2 (clamped)"),
+			t:         HSLSpace.Lighten(2),
+			expColour: rgba{R: 0xff, G: 0xff, B: 0xff, A: 0x80},
+		},
+		{
+			ID:        testhelper.MkID("HSL: darken 2 (clamped)"),
+			t:         HSLSpace.Darken(2),
+			expColour: rgba{R: 0x00, G: 0x00, B: 0x00, A: 0x80},
+		},
+		{
+			ID:        testhelper.MkID("HSL: desaturate 2 (clamped)"),
+			t:         HSLSpace.Desaturate(2),
+			expColour: rgba{R: 0x99, G: 0x99, B: 0x99, A: 0x80},
+		},
+		{
+			ID:        testhelper.MkID("HSV: lighten 0.1"),
+			t:         HSVSpace.Lighten(0.1),
+			expColour: rgba{R: 0x3d, G: 0x87, B: 0xff, A: 0x80},
+		},
+		{
+			ID:        testhelper.MkID("HSV: darken 0.1"),
+			t:         HSVSpace.Darken(0.1),
+			expColour: rgba{R: 0x35, G: 0x75, B: 0xdd, A: 0x80},
+		},
+		{
+			ID:        testhelper.MkID("HSV: saturate 0.1"),
+			t:         HSVSpace.Saturate(0.1),
+			expColour: rgba{R: 0x22, G: 0x73, B: 0xf6, A: 0x80},
+		},
+		{
+			ID:        testhelper.MkID("HSV: desaturate 0.2"),
+			t:         HSVSpace.Desaturate(0.2),
+			expColour: rgba{R: 0x6c, G: 0xa1, B: 0xf6, A: 0x80},
+		},
+		{
+			ID:        testhelper.MkID("HSV: rotate hue 30"),
+			t:         HSVSpace.RotateHue(30),
+			expColour: rgba{R: 0x52, G: 0x3b, B: 0xf6, A: 0x80},
+		},
+		{
+			ID:        testhelper.MkID("HSV: rotate hue -90"),
+			t:         HSVSpace.RotateHue(-90),
+			expColour: rgba{R: 0x3b, G: 0xf6, B: 0x52, A: 0x80},
+		},
+		{
+			ID:        testhelper.MkID("HSV: lighten 2 (clamped)"),
+			t:         HSVSpace.Lighten(2),
+			expColour: rgba{R: 0x3d, G: 0x87, B: 0xff, A: 0x80},
+		},
+		{
+			ID:        testhelper.MkID("HSV: darken 2 (clamped)"),
+			t:         HSVSpace.Darken(2),
+			expColour: rgba{R: 0x00, G: 0x00, B: 0x00, A: 0x80},
+		},
+		{
+			ID:        testhelper.MkID("HSV: desaturate 2 (clamped)"),
+			t:         HSVSpace.Desaturate(2),
+			expColour: rgba{R: 0xf6, G: 0xf6, B: 0xf6, A: 0x80},
+		},
+		{
+			ID:        testhelper.MkID("Oklch: lighten 0.1"),
+			t:         OklchSpace.Lighten(0.1),
+			expColour: rgba{R: 0x64, G: 0xa4, B: 0xff, A: 0x80},
+		},
+		{
+			ID:        testhelper.MkID("Oklch: darken 0.1"),
+			t:         OklchSpace.Darken(0.1),
+			expColour: rgba{R: 0x1a, G: 0x62, B: 0xd3, A: 0x80},
+		},
+		{
+			ID:        testhelper.MkID("Oklch: saturate 0.1"),
+			t:         OklchSpace.Saturate(0.1),
+			expColour: rgba{R: 0x21, G: 0x7d, B: 0xff, A: 0x80},
+		},
+		{
+			ID:        testhelper.MkID("Oklch: desaturate 0.2"),
+			t:         OklchSpace.Desaturate(0.2),
+			expColour: rgba{R: 0x60, G: 0x87, B: 0xc8, A: 0x80},
+		},
+		{
+			ID:        testhelper.MkID("Oklch: rotate hue 30"),
+			t:         OklchSpace.RotateHue(30),
+			expColour: rgba{R: 0x88, G: 0x6c, B: 0xee, A: 0x80},
+		},
+		{
+			ID:        testhelper.MkID("Oklch: rotate hue -90"),
+			t:         OklchSpace.RotateHue(-90),
+			expColour: rgba{R: 0x00, G: 0xa2, B: 0x79, A: 0x80},
+		},
+		{
+			ID:        testhelper.MkID("Oklch: lighten 2 (clamped)"),
+			t:         OklchSpace.Lighten(2),
+			expColour: rgba{R: 0xff, G: 0xff, B: 0xff, A: 0x80},
+		},
+		{
+			ID:        testhelper.MkID("Oklch: darken 2 (clamped)"),
+			t:         OklchSpace.Darken(2),
+			expColour: rgba{R: 0x00, G: 0x00, B: 0x00, A: 0x80},
+		},
+		{
+			ID:        testhelper.MkID("Oklch: desaturate 2 (clamped)"),
+			t:         OklchSpace.Desaturate(2),
+			expColour: rgba{R: 0x87, G: 0x87, B: 0x87, A: 0x80},
+		},
+		{
+			ID:        testhelper.MkID("HSL: no change"),
+			t:         HSLSpace.Lighten(0),
+			expColour: c,
+		},
+		{
+			ID:        testhelper.MkID("HSL: full rotation"),
+			t:         HSLSpace.RotateHue(360),
+			expColour: c,
+		},
+		{
+			ID:        testhelper.MkID("unknown working space"),
+			t:         WorkingSpace(99).Lighten(0.1),
+			expColour: c,
+		},
+		{
+			ID:        testhelper.MkID("default: lighten"),
+			t:         Lighten(0.1),
+			expColour: HSLSpace.Lighten(0.1)(c),
+		},
+		{
+			ID:        testhelper.MkID("default: darken"),
+			t:         Darken(0.1),
+			expColour: HSLSpace.Darken(0.1)(c),
+		},
+		{
+			ID:        testhelper.MkID("default: saturate"),
+			t:         Saturate(0.1),
+			expColour: HSLSpace.Saturate(0.1)(c),
+		},
+		{
+			ID:        testhelper.MkID("default: desaturate"),
+			t:         Desaturate(0.2),
+			expColour: HSLSpace.Desaturate(0.2)(c),
+		},
+		{
+			ID:        testhelper.MkID("default: rotate hue"),
+			t:         RotateHue(30),
+			expColour: HSLSpace.RotateHue(30)(c),
+		},
+	}
+
+	for _, tc := range testCases {
+		colourtesthelper.DiffRGBA(t, tc.IDStr(), "colour",
+			tc.t.Apply(c), tc.expColour)
+	}
+}
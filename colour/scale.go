@@ -0,0 +1,267 @@
+package colour
+
+import (
+	"fmt"
+	"image/color" //nolint:misspell
+	"math"
+	"slices"
+	"strings"
+)
+
+// ChromaCurve gives the proportion of the chroma of the anchor colour to be
+// used at a step of a LightnessScale. It is passed the position of the step
+// relative to the anchor step: -1 for the lightest step, 0 for the anchor
+// step and 1 for the darkest step, with the steps in between spread
+// according to their labels. The returned value should be in the range
+// [0, 1].
+type ChromaCurve func(pos float64) float64
+
+// DefaultChromaCurve is the ChromaCurve used if none is given. It reduces
+// the chroma towards the ends of the scale, more quickly for the lighter
+// steps than for the darker ones, giving pale tints and rich shades.
+func DefaultChromaCurve(pos float64) float64 {
+	if pos < 0 {
+		return 1 - 0.8*pos*pos //nolint:mnd
+	}
+
+	return 1 - 0.4*pos*pos //nolint:mnd
+}
+
+// FlatChromaCurve is a ChromaCurve which keeps the chroma of the anchor
+// colour at every step. Steps which cannot be shown in sRGB at that chroma
+// will still have their chroma reduced.
+func FlatChromaCurve(_ float64) float64 {
+	return 1
+}
+
+// AutoAnchor is the AnchorStep of a LightnessScale whose anchor step is to
+// be chosen from the colour
+const AutoAnchor = math.MinInt
+
+// LightnessScale describes a scale of colours of the same hue running from
+// light to dark, as used in design systems such as Tailwind. The colours are
+// generated in the Oklch colour space so that the steps in lightness are
+// perceptually even.
+type LightnessScale struct {
+	// Steps gives the labels of the steps of the scale, from lightest to
+	// darkest. The labels must be strictly increasing and the lightness of
+	// each step is spread according to its label.
+	Steps []int
+	// AnchorStep is the step at which the original colour is kept. If this
+	// is AutoAnchor the step whose lightness is closest to that of the
+	// colour is chosen.
+	AnchorStep int
+	// Lightest is the Oklch lightness of the first step, in the range [0, 1]
+	Lightest float64
+	// Darkest is the Oklch lightness of the last step, in the range [0, 1]
+	Darkest float64
+	// Chroma gives the proportion of the original chroma used at each step.
+	// If this is nil the DefaultChromaCurve is used.
+	Chroma ChromaCurve
+}
+
+// TailwindSteps returns the labels of the steps used by Tailwind CSS
+//
+//nolint:mnd
+func TailwindSteps() []int {
+	return []int{50, 100, 200, 300, 400, 500, 600, 700, 800, 900, 950}
+}
+
+// DefaultLightnessScale returns a LightnessScale with Tailwind-style steps
+// (50 to 950) and lightnesses similar to those of the Tailwind palette. The
+// anchor step is chosen from the colour.
+func DefaultLightnessScale() LightnessScale {
+	return LightnessScale{
+		Steps:      TailwindSteps(),
+		AnchorStep: AutoAnchor,
+		Lightest:   0.97, //nolint:mnd
+		Darkest:    0.25, //nolint:mnd
+		Chroma:     DefaultChromaCurve,
+	}
+}
+
+// check returns a non-nil error if the LightnessScale is invalid
+func (ls LightnessScale) check() error {
+	if len(ls.Steps) < 2 { //nolint:mnd
+		return fmt.Errorf("the scale must have at least 2 steps, it has %d",
+			len(ls.Steps))
+	}
+
+	for i := 1; i < len(ls.Steps); i++ {
+		if ls.Steps[i] <= ls.Steps[i-1] {
+			return fmt.Errorf("the steps must be strictly increasing:"+
+				" step %d (%d) is not greater than step %d (%d)",
+				i, ls.Steps[i], i-1, ls.Steps[i-1])
+		}
+	}
+
+	if ls.AnchorStep != AutoAnchor &&
+		!slices.Contains(ls.Steps, ls.AnchorStep) {
+		return fmt.Errorf("the anchor step (%d) is not one of the steps",
+			ls.AnchorStep)
+	}
+
+	if ls.Lightest < 0 || ls.Lightest > 1 {
+		return fmt.Errorf("the lightest lightness (%.2f) must be in [0, 1]",
+			ls.Lightest)
+	}
+
+	if ls.Darkest < 0 || ls.Darkest > 1 {
+		return fmt.Errorf("the darkest lightness (%.2f) must be in [0, 1]",
+			ls.Darkest)
+	}
+
+	if ls.Darkest >= ls.Lightest {
+		return fmt.Errorf("the darkest lightness (%.2f)"+
+			" must be less than the lightest (%.2f)",
+			ls.Darkest, ls.Lightest)
+	}
+
+	return nil
+}
+
+// stepPos returns the position of the step in the range between the first
+// and last steps, from 0 (the first step) to 1 (the last step)
+func (ls LightnessScale) stepPos(step int) float64 {
+	first := ls.Steps[0]
+	last := ls.Steps[len(ls.Steps)-1]
+
+	return float64(step-first) / float64(last-first)
+}
+
+// anchorIndex returns the index of the anchor step. If the anchor step is
+// AutoAnchor then the step whose default lightness is closest to the given
+// lightness is chosen.
+func (ls LightnessScale) anchorIndex(l float64) int {
+	if ls.AnchorStep != AutoAnchor {
+		return slices.Index(ls.Steps, ls.AnchorStep)
+	}
+
+	best := 0
+	bestDiff := math.Inf(1)
+
+	for i, step := range ls.Steps {
+		stepL := ls.Lightest + (ls.Darkest-ls.Lightest)*ls.stepPos(step)
+		if diff := math.Abs(stepL - l); diff < bestDiff {
+			best, bestDiff = i, diff
+		}
+	}
+
+	return best
+}
+
+// Make generates the scale of colours from the given colour. The colour
+// is kept unchanged at the anchor step; the lighter steps have lightnesses
+// spread evenly between the Lightest value and that of the colour and the
+// darker steps between that of the colour and the Darkest value. The hue of
+// the colour is kept at every step and the chroma is scaled by the
+// ChromaCurve, being further reduced where necessary to keep the colour in
+// the sRGB gamut. The alpha value of the colour is preserved.
+//
+// The colours are returned as a Palette with the given name and with each
+// colour named as the palette name followed by a hyphen and the step label
+// (for instance, "brand-500"). An error is returned if the scale is invalid
+// or if the colour is too light or dark to be placed at the anchor step.
+//
+//nolint:misspell
+func (ls LightnessScale) Make(name string, c color.RGBA) (Palette, error) {
+	if err := ls.check(); err != nil {
+		return Palette{}, fmt.Errorf("bad lightness scale: %w", err)
+	}
+
+	curve := ls.Chroma
+	if curve == nil {
+		curve = DefaultChromaCurve
+	}
+
+	lch := RGBA2Oklch(c)
+	anchor := ls.anchorIndex(lch.L)
+	anchorStep := ls.Steps[anchor]
+	lastIdx := len(ls.Steps) - 1
+
+	if anchor > 0 && lch.L >= ls.Lightest {
+		return Palette{}, fmt.Errorf(
+			"the colour's lightness (%.2f) is too light for step %d:"+
+				" it must be less than %.2f",
+			lch.L, anchorStep, ls.Lightest)
+	}
+
+	if anchor < lastIdx && lch.L <= ls.Darkest {
+		return Palette{}, fmt.Errorf(
+			"the colour's lightness (%.2f) is too dark for step %d:"+
+				" it must be greater than %.2f",
+			lch.L, anchorStep, ls.Darkest)
+	}
+
+	anchorPos := ls.stepPos(anchorStep)
+	p := Palette{Name: name}
+
+	for i, step := range ls.Steps {
+		stepName := fmt.Sprintf("%s-%d", name, step)
+
+		if i == anchor {
+			p.Colours = append(p.Colours, MakeNamedColour(stepName, c))
+			continue
+		}
+
+		var l, pos float64
+
+		if i < anchor {
+			pos = (ls.stepPos(step) - anchorPos) / anchorPos
+			l = lch.L - (ls.Lightest-lch.L)*pos
+		} else {
+			pos = (ls.stepPos(step) - anchorPos) / (1 - anchorPos)
+			l = lch.L + (ls.Darkest-lch.L)*pos
+		}
+
+		stepLCh := Oklch{L: l, C: lch.C * max(curve(pos), 0), H: lch.H}
+		p.Colours = append(p.Colours,
+			MakeNamedColour(stepName, oklabToRGBA(stepLCh.Oklab(), c.A)))
+	}
+
+	return p, nil
+}
+
+// Palette is a named, ordered collection of named colours such as that
+// generated by a LightnessScale
+type Palette struct {
+	// Name is the name of the palette
+	Name string
+	// Colours holds the colours in the palette, in order
+	Colours []NamedColour
+}
+
+// Colour returns the colour in the palette with the given name. If there is
+// no such colour an error is returned.
+//
+//nolint:misspell
+func (p Palette) Colour(name string) (color.RGBA, error) {
+	for _, nc := range p.Colours {
+		if nc.Name() == name {
+			return nc.Colour(), nil
+		}
+	}
+
+	return rgba{}, fmt.Errorf("there is no colour called %q in palette %q",
+		name, p.Name)
+}
+
+// CSS returns the palette as a block of CSS custom property declarations,
+// one per line, such as "--brand-500: #3b82f6;". Colours which are not
+// fully opaque are given with an alpha component.
+func (p Palette) CSS() string {
+	var b strings.Builder
+
+	for _, nc := range p.Colours {
+		c := nc.Colour()
+		fmt.Fprintf(&b, "--%s: #%02x%02x%02x", nc.Name(), c.R, c.G, c.B)
+
+		if c.A != math.MaxUint8 {
+			fmt.Fprintf(&b, "%02x", c.A)
+		}
+
+		b.WriteString(";\n")
+	}
+
+	return b.String()
+}
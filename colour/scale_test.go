@@ -0,0 +1,228 @@
+package colour
+
+import (
+	"testing"
+
+	"github.com/nickwells/colour.mod/v2/colourtesthelper"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestLightnessScale(t *testing.T) {
+	blue := rgba{R: 0x3b, G: 0x82, B: 0xf6, A: 0xff}
+	white := rgba{R: 0xff, G: 0xff, B: 0xff, A: 0x80}
+
+	withAnchor := func(ls LightnessScale, step int) LightnessScale {
+		ls.AnchorStep = step
+		return ls
+	}
+
+	flat := DefaultLightnessScale()
+	flat.Chroma = FlatChromaCurve
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		ls        LightnessScale
+		c         rgba
+		expCSS    string
+		expAnchor string
+	}{
+		{
+			ID: testhelper.MkID("bad scale: too few steps"),
+			ExpErr: testhelper.MkExpErr("bad lightness scale",
+				"the scale must have at least 2 steps, it has 1"),
+			ls: LightnessScale{
+				Steps: []int{1}, AnchorStep: AutoAnchor, Lightest: 1,
+			},
+			c: blue,
+		},
+		{
+			ID: testhelper.MkID("bad scale: steps out of order"),
+			ExpErr: testhelper.MkExpErr("bad lightness scale",
+				"the steps must be strictly increasing:"+
+					" step 2 (2) is not greater than step 1 (3)"),
+			ls: LightnessScale{
+				Steps: []int{1, 3, 2}, AnchorStep: AutoAnchor, Lightest: 1,
+			},
+			c: blue,
+		},
+		{
+			ID: testhelper.MkID("bad scale: bad anchor"),
+			ExpErr: testhelper.MkExpErr("bad lightness scale",
+				"the anchor step (550) is not one of the steps"),
+			ls: withAnchor(DefaultLightnessScale(), 550),
+			c:  blue,
+		},
+		{
+			ID: testhelper.MkID("bad scale: zero anchor, not a step"),
+			ExpErr: testhelper.MkExpErr("bad lightness scale",
+				"the anchor step (0) is not one of the steps"),
+			ls: LightnessScale{Steps: []int{1, 2}, Lightest: 1},
+			c:  blue,
+		},
+		{
+			ID: testhelper.MkID("bad scale: bad lightest"),
+			ExpErr: testhelper.MkExpErr("bad lightness scale",
+				"the lightest lightness (1.10) must be in [0, 1]"),
+			ls: LightnessScale{
+				Steps: []int{1, 2}, AnchorStep: AutoAnchor, Lightest: 1.1,
+			},
+			c: blue,
+		},
+		{
+			ID: testhelper.MkID("bad scale: bad darkest"),
+			ExpErr: testhelper.MkExpErr("bad lightness scale",
+				"the darkest lightness (-0.10) must be in [0, 1]"),
+			ls: LightnessScale{
+				Steps: []int{1, 2}, AnchorStep: AutoAnchor,
+				Lightest: 1, Darkest: -0.1,
+			},
+			c: blue,
+		},
+		{
+			ID: testhelper.MkID("bad scale: darkest too light"),
+			ExpErr: testhelper.MkExpErr("bad lightness scale",
+				"the darkest lightness (0.50)"+
+					" must be less than the lightest (0.50)"),
+			ls: LightnessScale{
+				Steps: []int{1, 2}, AnchorStep: AutoAnchor,
+				Lightest: 0.5, Darkest: 0.5,
+			},
+			c: blue,
+		},
+		{
+			ID: testhelper.MkID("colour too light for the anchor"),
+			ExpErr: testhelper.MkExpErr(
+				"the colour's lightness (1.00) is too light for step 500:" +
+					" it must be less than 0.97"),
+			ls: withAnchor(DefaultLightnessScale(), 500),
+			c:  white,
+		},
+		{
+			ID: testhelper.MkID("colour too dark for the anchor"),
+			ExpErr: testhelper.MkExpErr(
+				"the colour's lightness (0.00) is too dark for step 500:" +
+					" it must be greater than 0.25"),
+			ls: withAnchor(DefaultLightnessScale(), 500),
+			c:  rgba{A: 0xff},
+		},
+		{
+			ID: testhelper.MkID("default scale"),
+			ls: DefaultLightnessScale(),
+			c:  blue,
+			expCSS: "--brand-50: #e8f6ff;\n" +
+				"--brand-100: #d4eaff;\n" +
+				"--brand-200: #abd1ff;\n" +
+				"--brand-300: #82b7ff;\n" +
+				"--brand-400: #579bff;\n" +
+				"--brand-500: #3b82f6;\n" +
+				"--brand-600: #2368d7;\n" +
+				"--brand-700: #0c50b5;\n" +
+				"--brand-800: #003990;\n" +
+				"--brand-900: #00256a;\n" +
+				"--brand-950: #001b56;\n",
+			expAnchor: "brand-500",
+		},
+		{
+			ID: testhelper.MkID("flat chroma, anchored at 700"),
+			ls: withAnchor(flat, 700),
+			c:  blue,
+			expCSS: "--brand-50: #e8f6ff;\n" +
+				"--brand-100: #daeeff;\n" +
+				"--brand-200: #bedcff;\n" +
+				"--brand-300: #a2cbff;\n" +
+				"--brand-400: #85b9ff;\n" +
+				"--brand-500: #68a6ff;\n" +
+				"--brand-600: #4b93ff;\n" +
+				"--brand-700: #3b82f6;\n" +
+				"--brand-800: #0453c3;\n" +
+				"--brand-900: #002191;\n" +
+				"--brand-950: #000079;\n",
+			expAnchor: "brand-700",
+		},
+		{
+			ID: testhelper.MkID("white, anchored at 50, with alpha"),
+			ls: withAnchor(DefaultLightnessScale(), 50),
+			c:  white,
+			expCSS: "--brand-50: #ffffff80;\n" +
+				"--brand-100: #f1f1f180;\n" +
+				"--brand-200: #d6d6d680;\n" +
+				"--brand-300: #bbbbbb80;\n" +
+				"--brand-400: #a1a1a180;\n" +
+				"--brand-500: #87878780;\n" +
+				"--brand-600: #6f6f6f80;\n" +
+				"--brand-700: #57575780;\n" +
+				"--brand-800: #41414180;\n" +
+				"--brand-900: #2c2c2c80;\n" +
+				"--brand-950: #22222280;\n",
+			expAnchor: "brand-50",
+		},
+		{
+			ID: testhelper.MkID("anchored at step 0"),
+			ls: LightnessScale{
+				Steps:      []int{0, 5, 10},
+				AnchorStep: 0,
+				Lightest:   0.97,
+				Darkest:    0.25,
+			},
+			c: blue,
+			expCSS: "--brand-0: #3b82f6;\n" +
+				"--brand-5: #074aac;\n" +
+				"--brand-10: #001b56;\n",
+			expAnchor: "brand-0",
+		},
+	}
+
+	for _, tc := range testCases {
+		p, err := tc.ls.Make("brand", tc.c)
+		if testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc) &&
+			err == nil {
+			testhelper.DiffString(t, tc.IDStr(), "palette name",
+				p.Name, "brand")
+			testhelper.DiffString(t, tc.IDStr(), "CSS", p.CSS(), tc.expCSS)
+
+			c, err := p.Colour(tc.expAnchor)
+			if err != nil {
+				t.Fatal(tc.IDStr(), ": unexpected error:", err)
+			}
+
+			colourtesthelper.DiffRGBA(t, tc.IDStr(), "anchor colour",
+				c, tc.c)
+		}
+	}
+}
+
+func TestPaletteColour(t *testing.T) {
+	p := Palette{
+		Name: "test",
+		Colours: []NamedColour{
+			MakeNamedColour("a", rgba{R: 1}),
+			MakeNamedColour("b", rgba{G: 2}),
+		},
+	}
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		name      string
+		expColour rgba
+	}{
+		{
+			ID:        testhelper.MkID("found"),
+			name:      "b",
+			expColour: rgba{G: 2},
+		},
+		{
+			ID: testhelper.MkID("not found"),
+			ExpErr: testhelper.MkExpErr(
+				`there is no colour called "c" in palette "test"`),
+			name: "c",
+		},
+	}
+
+	for _, tc := range testCases {
+		c, err := p.Colour(tc.name)
+		testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc)
+		colourtesthelper.DiffRGBA(t, tc.IDStr(), "colour", c, tc.expColour)
+	}
+}
@@ -0,0 +1,366 @@
+package colour
+
+import (
+	"bufio"
+	"errors"
+	"image/color" //nolint:misspell
+	"io"
+	"strconv"
+	"strings"
+)
+
+// TerminalPalette holds the colours that a terminal shows for each of the
+// 256 indexed colours. Terminals often change the first 16 (the ANSI
+// colours) to suit a colour scheme.
+type TerminalPalette [xtermColourCount]color.RGBA //nolint:misspell
+
+// DefaultTerminalPalette returns the standard xterm 256-colour palette,
+// with the CGA colours as the first 16 colours
+func DefaultTerminalPalette() TerminalPalette {
+	var tp TerminalPalette
+
+	copy(tp[:], xterm256ColoursByNum)
+
+	return tp
+}
+
+// SGRSpan is a piece of text together with the colours that a terminal
+// would show it in. If HasForeground or HasBackground is false the
+// corresponding colour is the terminal's default and the colour value
+// should be ignored.
+type SGRSpan struct {
+	Text          string
+	Foreground    color.RGBA //nolint:misspell
+	HasForeground bool
+	Background    color.RGBA //nolint:misspell
+	HasBackground bool
+}
+
+// sameColours returns true if the two spans have the same colours
+func (s SGRSpan) sameColours(other SGRSpan) bool {
+	return s.HasForeground == other.HasForeground &&
+		s.HasBackground == other.HasBackground &&
+		(!s.HasForeground || s.Foreground == other.Foreground) &&
+		(!s.HasBackground || s.Background == other.Background)
+}
+
+// SGRParser splits text containing SGR (Select Graphic Rendition) escape
+// sequences into spans of text with the colours in effect. The 16 and
+// 256-colour indexes are resolved using the Palette. Use [NewSGRParser]
+// to make one with the default palette.
+type SGRParser struct {
+	Palette TerminalPalette
+}
+
+// NewSGRParser returns an SGRParser using the default terminal palette
+func NewSGRParser() SGRParser {
+	return SGRParser{Palette: DefaultTerminalPalette()}
+}
+
+// ParseString returns the spans of text in the string. See
+// [SGRParser.Scan] for details.
+func (p SGRParser) ParseString(s string) []SGRSpan {
+	spans := []SGRSpan{}
+
+	_ = p.Scan(strings.NewReader(s), func(span SGRSpan) {
+		spans = append(spans, span)
+	})
+
+	return spans
+}
+
+// Scan reads the text from the Reader and calls f with each span of text
+// as it is completed. A new span starts whenever an SGR sequence changes
+// the colours. The escape sequences are not included in the span text and
+// any other escape sequences are removed. SGR attributes other than
+// colours (bold, underline and so on) are ignored. Both the ";" and ":"
+// separated forms of the 256-colour and truecolour sequences are
+// recognised. Any error from the Reader, apart from io.EOF, is returned.
+func (p SGRParser) Scan(r io.Reader, f func(SGRSpan)) error {
+	const esc = 0x1b
+
+	br := bufio.NewReader(r)
+
+	var (
+		text strings.Builder
+		cur  SGRSpan
+	)
+
+	flush := func() {
+		if text.Len() > 0 {
+			cur.Text = text.String()
+			f(cur)
+			text.Reset()
+		}
+	}
+
+	for {
+		b, err := br.ReadByte()
+		if err != nil {
+			flush()
+
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return err
+		}
+
+		if b != esc {
+			text.WriteByte(b)
+			continue
+		}
+
+		params, final, err := readEscape(br)
+		if err != nil && !errors.Is(err, io.EOF) {
+			flush()
+			return err
+		}
+
+		if final != 'm' {
+			continue
+		}
+
+		next := p.apply(cur, params)
+		if !next.sameColours(cur) {
+			flush()
+		}
+
+		cur = next
+	}
+}
+
+// readEscape reads the rest of an escape sequence, the escape character
+// having already been read. For a CSI sequence (one starting "ESC [") it
+// returns the parameters and the final byte. A control string (an OSC,
+// DCS, APC, PM or SOS sequence, such as a window title or a hyperlink) is
+// read up to and including its terminator, either BEL or ST ("ESC \"), and
+// a sequence with intermediate bytes (such as "ESC ( B") is read up to its
+// final byte. For any other sequence it returns the byte following the
+// escape character as the final byte.
+func readEscape(br *bufio.Reader) (string, byte, error) {
+	const (
+		csiStart        = '['
+		finalMin        = 0x40
+		finalMax        = 0x7e
+		intermediateMin = 0x20
+		intermediateMax = 0x2f
+	)
+
+	b, err := br.ReadByte()
+	if err != nil {
+		return "", b, err
+	}
+
+	switch {
+	case b == csiStart:
+	case strings.IndexByte(controlStringStarts, b) >= 0:
+		return "", b, skipControlString(br)
+	case b >= intermediateMin && b <= intermediateMax:
+		return "", b, skipToFinal(br)
+	default:
+		return "", b, nil
+	}
+
+	var params strings.Builder
+
+	for {
+		b, err := br.ReadByte()
+		if err != nil {
+			return "", 0, err
+		}
+
+		if b >= finalMin && b <= finalMax {
+			return params.String(), b, nil
+		}
+
+		params.WriteByte(b)
+	}
+}
+
+// controlStringStarts holds the bytes which, following the escape
+// character, start a control string: OSC, DCS, APC, PM and SOS
+// respectively
+const controlStringStarts = "]P_^X"
+
+// skipControlString reads the rest of a control string up to and including
+// the terminator, which is either BEL or ST ("ESC \")
+func skipControlString(br *bufio.Reader) error {
+	const (
+		bel = 0x07
+		esc = 0x1b
+		st  = '\\'
+	)
+
+	for {
+		b, err := br.ReadByte()
+		if err != nil {
+			return err
+		}
+
+		switch b {
+		case bel:
+			return nil
+		case esc:
+			b, err = br.ReadByte()
+			if err != nil || b == st {
+				return err
+			}
+		}
+	}
+}
+
+// skipToFinal reads the rest of an escape sequence having intermediate
+// bytes up to and including its final byte
+func skipToFinal(br *bufio.Reader) error {
+	const (
+		finalMin = 0x30
+		finalMax = 0x7e
+	)
+
+	for {
+		b, err := br.ReadByte()
+		if err != nil {
+			return err
+		}
+
+		if b >= finalMin && b <= finalMax {
+			return nil
+		}
+	}
+}
+
+// apply returns the span with its colours changed by the parameters of an
+// SGR sequence
+func (p SGRParser) apply(span SGRSpan, params string) SGRSpan {
+	const (
+		reset        = 0
+		fgBase       = 30
+		fgExtended   = 38
+		fgDefault    = 39
+		bgBase       = 40
+		bgExtended   = 48
+		bgDefault    = 49
+		fgBrightBase = 90
+		bgBrightBase = 100
+		baseCount    = 8
+	)
+
+	args := strings.Split(params, ";")
+
+	for i := 0; i < len(args); i++ {
+		subArgs := strings.Split(args[i], ":")
+
+		code := 0
+		if subArgs[0] != "" {
+			var err error
+			if code, err = strconv.Atoi(subArgs[0]); err != nil {
+				continue
+			}
+		}
+
+		switch {
+		case code == reset:
+			span = SGRSpan{}
+		case code >= fgBase && code < fgBase+baseCount:
+			span.Foreground = p.Palette[code-fgBase]
+			span.HasForeground = true
+		case code >= fgBrightBase && code < fgBrightBase+baseCount:
+			span.Foreground = p.Palette[code-fgBrightBase+baseCount]
+			span.HasForeground = true
+		case code == fgDefault:
+			span.Foreground, span.HasForeground = rgba{}, false
+		case code >= bgBase && code < bgBase+baseCount:
+			span.Background = p.Palette[code-bgBase]
+			span.HasBackground = true
+		case code >= bgBrightBase && code < bgBrightBase+baseCount:
+			span.Background = p.Palette[code-bgBrightBase+baseCount]
+			span.HasBackground = true
+		case code == bgDefault:
+			span.Background, span.HasBackground = rgba{}, false
+		case code == fgExtended || code == bgExtended:
+			var (
+				c  rgba
+				ok bool
+			)
+
+			if len(subArgs) > 1 {
+				c, ok, _ = p.extendedColour(subArgs[1:], true)
+			} else {
+				var used int
+				c, ok, used = p.extendedColour(args[i+1:], false)
+				i += used
+			}
+
+			if !ok {
+				continue
+			}
+
+			if code == fgExtended {
+				span.Foreground, span.HasForeground = c, true
+			} else {
+				span.Background, span.HasBackground = c, true
+			}
+		}
+	}
+
+	return span
+}
+
+// extendedColour returns the colour given by the arguments following a 38
+// or 48 SGR code, whether the arguments were valid and the number of
+// arguments used. If the arguments are ':'-separated (colonForm is true) a
+// truecolour value may have a colour space identifier before the red,
+// green and blue values; this is ignored.
+func (p SGRParser) extendedColour(args []string, colonForm bool) (
+	rgba, bool, int,
+) {
+	const (
+		indexed    = "5"
+		trueColour = "2"
+		rgbCount   = 3
+	)
+
+	if len(args) == 0 {
+		return rgba{}, false, 0
+	}
+
+	switch args[0] {
+	case indexed:
+		if len(args) < 2 { //nolint:mnd
+			return rgba{}, false, len(args)
+		}
+
+		idx, err := strconv.Atoi(args[1])
+		if err != nil || idx < 0 || idx >= xtermColourCount {
+			return rgba{}, false, 2 //nolint:mnd
+		}
+
+		return p.Palette[idx], true, 2 //nolint:mnd
+	case trueColour:
+		vals := args[1:]
+		if colonForm && len(vals) > rgbCount {
+			vals = vals[len(vals)-rgbCount:]
+		}
+
+		if len(vals) < rgbCount {
+			return rgba{}, false, len(args)
+		}
+
+		var rgb [rgbCount]uint8
+
+		for i := range rgbCount {
+			v, err := strconv.ParseUint(vals[i], 10, 8)
+			if err != nil {
+				return rgba{}, false, 1 + rgbCount
+			}
+
+			rgb[i] = uint8(v)
+		}
+
+		return rgba{R: rgb[0], G: rgb[1], B: rgb[2], A: 0xff},
+			true, 1 + rgbCount
+	}
+
+	return rgba{}, false, 1
+}
@@ -0,0 +1,214 @@
+package colour
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestSGRParserParseString(t *testing.T) {
+	lowRed := rgba{R: 0x80, A: 0xff}
+	orange := rgba{R: 0xff, G: 0x87, A: 0xff}
+
+	custom := NewSGRParser()
+	custom.Palette[1] = rgba{R: 0xdc, G: 0x32, B: 0x2f, A: 0xff}
+
+	testCases := []struct {
+		testhelper.ID
+		p        SGRParser
+		s        string
+		expSpans []SGRSpan
+	}{
+		{
+			ID:       testhelper.MkID("empty"),
+			p:        NewSGRParser(),
+			expSpans: []SGRSpan{},
+		},
+		{
+			ID:       testhelper.MkID("plain text"),
+			p:        NewSGRParser(),
+			s:        "plain",
+			expSpans: []SGRSpan{{Text: "plain"}},
+		},
+		{
+			ID: testhelper.MkID("ANSI colour and reset"),
+			p:  NewSGRParser(),
+			s:  "a\x1b[31mred\x1b[0m b",
+			expSpans: []SGRSpan{
+				{Text: "a"},
+				{Text: "red", Foreground: lowRed, HasForeground: true},
+				{Text: " b"},
+			},
+		},
+		{
+			ID: testhelper.MkID("custom palette"),
+			p:  custom,
+			s:  "\x1b[31mred",
+			expSpans: []SGRSpan{
+				{
+					Text:          "red",
+					Foreground:    rgba{R: 0xdc, G: 0x32, B: 0x2f, A: 0xff},
+					HasForeground: true,
+				},
+			},
+		},
+		{
+			ID: testhelper.MkID("bright background"),
+			p:  NewSGRParser(),
+			s:  "\x1b[104mblue",
+			expSpans: []SGRSpan{
+				{
+					Text:          "blue",
+					Background:    rgba{B: 0xff, A: 0xff},
+					HasBackground: true,
+				},
+			},
+		},
+		{
+			ID: testhelper.MkID("256 colour and truecolour, then default fg"),
+			p:  NewSGRParser(),
+			s:  "\x1b[38;5;208;48;2;1;2;3morange\x1b[39mx\x1b[m",
+			expSpans: []SGRSpan{
+				{
+					Text:          "orange",
+					Foreground:    orange,
+					HasForeground: true,
+					Background:    rgba{R: 1, G: 2, B: 3, A: 0xff},
+					HasBackground: true,
+				},
+				{
+					Text:          "x",
+					Background:    rgba{R: 1, G: 2, B: 3, A: 0xff},
+					HasBackground: true,
+				},
+			},
+		},
+		{
+			ID: testhelper.MkID("colon form with colour space"),
+			p:  NewSGRParser(),
+			s:  "\x1b[38:2::10:20:30mc",
+			expSpans: []SGRSpan{
+				{
+					Text:          "c",
+					Foreground:    rgba{R: 10, G: 20, B: 30, A: 0xff},
+					HasForeground: true,
+				},
+			},
+		},
+		{
+			ID: testhelper.MkID("other attributes and sequences ignored"),
+			p:  NewSGRParser(),
+			s:  "\x1b[1;31mbold\x1b[1mmore\x1b[2Kclr",
+			expSpans: []SGRSpan{
+				{
+					Text:          "boldmoreclr",
+					Foreground:    lowRed,
+					HasForeground: true,
+				},
+			},
+		},
+		{
+			ID:       testhelper.MkID("OSC title ended by BEL"),
+			p:        NewSGRParser(),
+			s:        "a\x1b]0;title\x07b",
+			expSpans: []SGRSpan{{Text: "ab"}},
+		},
+		{
+			ID: testhelper.MkID("OSC hyperlink ended by ST"),
+			p:  NewSGRParser(),
+			s: "\x1b]8;;https://example.com\x1b\\" +
+				"\x1b[31mlink\x1b]8;;\x1b\\",
+			expSpans: []SGRSpan{
+				{Text: "link", Foreground: lowRed, HasForeground: true},
+			},
+		},
+		{
+			ID: testhelper.MkID("DCS, APC, PM and SOS strings"),
+			p:  NewSGRParser(),
+			s: "\x1bPq#0\x1b\\a\x1b_apc\x1b\\b" +
+				"\x1b^pm\x07c\x1bXsos\x1b\\d",
+			expSpans: []SGRSpan{{Text: "abcd"}},
+		},
+		{
+			ID:       testhelper.MkID("character set designation"),
+			p:        NewSGRParser(),
+			s:        "\x1b(Bplain\x1b)0",
+			expSpans: []SGRSpan{{Text: "plain"}},
+		},
+		{
+			ID:       testhelper.MkID("unterminated control string"),
+			p:        NewSGRParser(),
+			s:        "trail\x1b]0;title",
+			expSpans: []SGRSpan{{Text: "trail"}},
+		},
+		{
+			ID:       testhelper.MkID("bad values ignored"),
+			p:        NewSGRParser(),
+			s:        "\x1b[38;5mbad\x1b[38;2;1;2;300m!",
+			expSpans: []SGRSpan{{Text: "bad!"}},
+		},
+		{
+			ID:       testhelper.MkID("unterminated sequence"),
+			p:        NewSGRParser(),
+			s:        "trail\x1b[31",
+			expSpans: []SGRSpan{{Text: "trail"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		spans := tc.p.ParseString(tc.s)
+		testhelper.DiffSlice(t, tc.IDStr(), "spans", spans, tc.expSpans)
+	}
+}
+
+func TestSGRParserScan(t *testing.T) {
+	readErr := errors.New("read failed")
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		r        io.Reader
+		expTexts []string
+	}{
+		{
+			ID:       testhelper.MkID("good reader"),
+			r:        strings.NewReader("a\x1b[32mb\x1b[0mc"),
+			expTexts: []string{"a", "b", "c"},
+		},
+		{
+			ID:     testhelper.MkID("failing reader"),
+			ExpErr: testhelper.MkExpErr("read failed"),
+			r: io.MultiReader(strings.NewReader("a\x1b[32mb"),
+				iotest.ErrReader(readErr)),
+			expTexts: []string{"a", "b"},
+		},
+	}
+
+	for _, tc := range testCases {
+		texts := []string{}
+		err := NewSGRParser().Scan(tc.r, func(s SGRSpan) {
+			texts = append(texts, s.Text)
+		})
+		testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc)
+		testhelper.DiffSlice(t, tc.IDStr(), "texts", texts, tc.expTexts)
+	}
+}
+
+func TestSGRRoundTrip(t *testing.T) {
+	c := rgba{R: 0xff, A: 0xff}
+
+	for _, m := range []TerminalMode{TermANSI16, TermXTerm256, TermTrueColour} {
+		spans := NewSGRParser().ParseString(m.Colourise("x", c))
+		if len(spans) != 1 {
+			t.Fatalf("%s: expected 1 span, got %d", m, len(spans))
+		}
+
+		testhelper.DiffString(t, m.String(), "description",
+			Describe(spans[0].Foreground), `"HTML:red", "Web:red",`+
+				` "X11:red" or "CGA:high red"`)
+	}
+}
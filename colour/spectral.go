@@ -0,0 +1,502 @@
+package colour
+
+import (
+	"errors"
+	"fmt"
+	"image/color" //nolint:misspell
+	"math"
+	"slices"
+)
+
+// These constants give the range of wavelengths (in nanometres) of visible
+// light covered by the colour matching functions.
+const (
+	MinWavelength = 380
+	MaxWavelength = 780
+)
+
+// integrationStep is the interval (in nanometres) between the wavelengths
+// at which spectra are sampled when they are integrated
+const integrationStep = 1
+
+// Spectrum holds the values of a spectral power distribution (for an
+// emitter of light) or of a spectral reflectance curve (for a surface)
+// sampled at regular wavelength intervals. Values between the sampled
+// wavelengths are linearly interpolated and values outside the range of
+// the samples are taken to be zero.
+type Spectrum struct {
+	start  float64
+	step   float64
+	values []float64
+}
+
+// MakeSpectrum returns a Spectrum whose first value is at the start
+// wavelength and with subsequent values at intervals of step (both given in
+// nanometres). It returns a non-nil error if the start or the step is not
+// greater than zero, if fewer than two values are given or if any value is
+// negative or not a number.
+func MakeSpectrum(start, step float64, values ...float64) (Spectrum, error) {
+	if start <= 0 {
+		return Spectrum{},
+			fmt.Errorf("the start wavelength (%gnm) must be > 0", start)
+	}
+
+	if step <= 0 {
+		return Spectrum{},
+			fmt.Errorf("the wavelength step (%gnm) must be > 0", step)
+	}
+
+	const minValues = 2
+	if len(values) < minValues {
+		return Spectrum{},
+			fmt.Errorf("too few spectral values (%d), at least %d are needed",
+				len(values), minValues)
+	}
+
+	for i, v := range values {
+		if v < 0 || math.IsNaN(v) {
+			return Spectrum{},
+				fmt.Errorf("bad spectral value (%g) at %gnm, it must be >= 0",
+					v, start+float64(i)*step)
+		}
+	}
+
+	return Spectrum{
+		start:  start,
+		step:   step,
+		values: slices.Clone(values),
+	}, nil
+}
+
+// Start returns the first wavelength (in nanometres) of the Spectrum
+func (s Spectrum) Start() float64 {
+	return s.start
+}
+
+// Step returns the interval (in nanometres) between the wavelengths of the
+// Spectrum values
+func (s Spectrum) Step() float64 {
+	return s.step
+}
+
+// End returns the last wavelength (in nanometres) of the Spectrum
+func (s Spectrum) End() float64 {
+	return s.start + s.step*float64(len(s.values)-1)
+}
+
+// Values returns a copy of the sampled values of the Spectrum
+func (s Spectrum) Values() []float64 {
+	return slices.Clone(s.values)
+}
+
+// At returns the value of the Spectrum at the given wavelength (in
+// nanometres). Values between the sampled wavelengths are linearly
+// interpolated and values outside the range of the Spectrum are zero.
+func (s Spectrum) At(wavelength float64) float64 {
+	if len(s.values) == 0 || wavelength < s.start || wavelength > s.End() {
+		return 0
+	}
+
+	pos := (wavelength - s.start) / s.step
+	i := int(pos)
+
+	if i >= len(s.values)-1 {
+		return s.values[len(s.values)-1]
+	}
+
+	frac := pos - float64(i)
+
+	return s.values[i] + frac*(s.values[i+1]-s.values[i])
+}
+
+// Observer identifies a CIE standard colorimetric observer. This is a set
+// of colour matching functions, giving the response of the average human
+// eye to light of each wavelength.
+type Observer int
+
+// These are the available standard observers. They differ in the size of
+// the field of view that the colour matching functions were measured for.
+const (
+	CIE1931Observer Observer = iota // CIE 1931 2 degree
+	CIE1964Observer                 // CIE 1964 10 degree
+)
+
+// cmf returns the colour matching functions for the Observer. It returns a
+// non-nil error if the Observer is not recognised.
+func (o Observer) cmf() (spectralTable, error) {
+	switch o {
+	case CIE1931Observer:
+		return cie1931CMF, nil
+	case CIE1964Observer:
+		return cie1964CMF, nil
+	}
+
+	return spectralTable{}, fmt.Errorf("bad observer: %d", int(o))
+}
+
+// integrate returns the unnormalised XYZ value of the product of the
+// spectra, weighted by the colour matching functions of the Observer.
+func (o Observer) integrate(spectra ...Spectrum) (XYZ, error) {
+	cmf, err := o.cmf()
+	if err != nil {
+		return XYZ{}, err
+	}
+
+	var xyz [3]float64
+
+	for wl := cmf.start; wl <= cmf.end(); wl += integrationStep {
+		power := 1.0
+		for _, s := range spectra {
+			power *= s.At(wl)
+		}
+
+		wts := cmf.at(wl)
+		xyz[0] += power * wts[0]
+		xyz[1] += power * wts[1]
+		xyz[2] += power * wts[2]
+	}
+
+	return makeXYZ(xyz), nil
+}
+
+// errNoLuminance is returned when a spectrum has no visible light
+var errNoLuminance = errors.New("the spectrum has no luminance")
+
+// EmissionToXYZ returns the XYZ value of light with the given spectral
+// power distribution as seen by the Observer. The value is normalised so
+// that Y is 1. It returns a non-nil error if the Observer is not recognised
+// or if the spectrum has no luminance.
+func (o Observer) EmissionToXYZ(s Spectrum) (XYZ, error) {
+	xyz, err := o.integrate(s)
+	if err != nil {
+		return xyz, err
+	}
+
+	if xyz.Y <= 0 {
+		return XYZ{}, errNoLuminance
+	}
+
+	return XYZ{X: xyz.X / xyz.Y, Y: 1, Z: xyz.Z / xyz.Y}, nil
+}
+
+// WhitePoint returns the XYZ value of the Illuminant as seen by the
+// Observer, normalised so that Y is 1. It returns a non-nil error if either
+// the Observer or the Illuminant is not recognised.
+func (o Observer) WhitePoint(il Illuminant) (XYZ, error) {
+	s, err := il.Spectrum()
+	if err != nil {
+		return XYZ{}, err
+	}
+
+	return o.EmissionToXYZ(s)
+}
+
+// ReflectanceToXYZ returns the XYZ value of a surface with the given
+// spectral reflectance, lit by the Illuminant and seen by the Observer. The
+// reflectance values should be in the range [0, 1] and the value is
+// normalised so that a perfect white reflector has a Y value of 1. It
+// returns a non-nil error if either the Observer or the Illuminant is not
+// recognised.
+func (o Observer) ReflectanceToXYZ(
+	r Spectrum, il Illuminant,
+) (
+	XYZ, error,
+) {
+	s, err := il.Spectrum()
+	if err != nil {
+		return XYZ{}, err
+	}
+
+	white, err := o.integrate(s)
+	if err != nil {
+		return XYZ{}, err
+	}
+
+	xyz, err := o.integrate(s, r)
+	if err != nil {
+		return XYZ{}, err
+	}
+
+	return XYZ{X: xyz.X / white.Y, Y: xyz.Y / white.Y, Z: xyz.Z / white.Y},
+		nil
+}
+
+// ReflectanceToRGBA returns the sRGB colour of a surface with the given
+// spectral reflectance, lit by the Illuminant and seen by the Observer. The
+// reflectance values should be in the range [0, 1]. The colour is adapted
+// (using the Bradford transform) from the white point of the Illuminant to
+// the D65 white point of the sRGB colour space so that a perfect white
+// reflector gives white. Colours outside the sRGB gamut are clipped. It
+// returns a non-nil error if either the Observer or the Illuminant is not
+// recognised.
+func (o Observer) ReflectanceToRGBA(
+	r Spectrum, il Illuminant,
+) (
+	color.RGBA, error, //nolint:misspell
+) {
+	xyz, err := o.ReflectanceToXYZ(r, il)
+	if err != nil {
+		return rgba{}, err
+	}
+
+	white, err := o.WhitePoint(il)
+	if err != nil {
+		return rgba{}, err
+	}
+
+	xyz, err = Bradford.Adapt(xyz, white, WhiteD65)
+	if err != nil {
+		return rgba{}, err
+	}
+
+	return xyz.ToRGBA(), nil
+}
+
+// brightestRGBA returns the colour with the given linear light values,
+// desaturated (if necessary) to bring it into the sRGB gamut and scaled to
+// the greatest brightness possible for its chromaticity.
+func brightestRGBA(lin [3]float64) rgba {
+	if darkest := min(lin[0], lin[1], lin[2]); darkest < 0 {
+		for i := range lin {
+			lin[i] -= darkest
+		}
+	}
+
+	brightest := max(lin[0], lin[1], lin[2])
+	if brightest <= 0 {
+		return rgba{A: math.MaxUint8}
+	}
+
+	for i := range lin {
+		lin[i] /= brightest
+	}
+
+	return linearToRGBA(lin, math.MaxUint8)
+}
+
+// EmissionToRGBA returns the sRGB colour of light with the given spectral
+// power distribution as seen by the Observer. The colour is given at the
+// greatest brightness possible for its chromaticity. Colours outside the
+// sRGB gamut are desaturated by adding white until they are in the gamut.
+// It returns a non-nil error if the Observer is not recognised or if the
+// spectrum has no luminance.
+//
+//nolint:misspell
+func (o Observer) EmissionToRGBA(s Spectrum) (color.RGBA, error) {
+	xyz, err := o.EmissionToXYZ(s)
+	if err != nil {
+		return rgba{}, err
+	}
+
+	return brightestRGBA(xyzToLinearSRGB.apply(xyz.vec())), nil
+}
+
+// WavelengthToRGBA returns an sRGB approximation to the colour of
+// monochromatic light of the given wavelength (in nanometres) as seen by
+// the CIE 1931 standard observer. Spectral colours all lie outside the sRGB
+// gamut and so any negative red, green or blue components are clipped to
+// zero. The colours are shown at their greatest brightness except towards
+// the ends of the visible spectrum where they fade towards black to reflect
+// the falling sensitivity of the eye. The wavelength must be between
+// MinWavelength and MaxWavelength inclusive, otherwise an error is returned.
+//
+//nolint:misspell
+func WavelengthToRGBA(wavelength float64) (color.RGBA, error) {
+	if wavelength < MinWavelength || math.IsNaN(wavelength) {
+		return rgba{}, fmt.Errorf("the wavelength (%gnm) must be >= %dnm",
+			wavelength, MinWavelength)
+	}
+
+	if wavelength > MaxWavelength {
+		return rgba{}, fmt.Errorf("the wavelength (%gnm) must be <= %dnm",
+			wavelength, MaxWavelength)
+	}
+
+	const (
+		fadeMin      = 0.3
+		fadeVioletWL = 420
+		fadeRedWL    = 700
+	)
+
+	fade := 1.0
+
+	switch {
+	case wavelength < fadeVioletWL:
+		fade = fadeMin + (1-fadeMin)*
+			(wavelength-MinWavelength)/(fadeVioletWL-MinWavelength)
+	case wavelength > fadeRedWL:
+		fade = fadeMin + (1-fadeMin)*
+			(MaxWavelength-wavelength)/(MaxWavelength-fadeRedWL)
+	}
+
+	lin := xyzToLinearSRGB.apply(cie1931CMF.at(wavelength))
+	for i := range lin {
+		lin[i] = max(lin[i], 0)
+	}
+
+	brightest := max(lin[0], lin[1], lin[2])
+	if brightest <= 0 {
+		return rgba{A: math.MaxUint8}, nil
+	}
+
+	for i := range lin {
+		lin[i] *= fade / brightest
+	}
+
+	return linearToRGBA(lin, math.MaxUint8), nil
+}
+
+// Illuminant identifies a CIE standard illuminant. This is a theoretical
+// source of light with a published spectral power distribution.
+type Illuminant int
+
+// These are the available standard illuminants. The CIE fluorescent
+// illuminants are the standard halophosphate lamps (F1 to F6), the
+// broadband lamps (F7 to F9) and the narrow tri-band lamps (F11 and F12);
+// F2 (cool white) and F11 are the usual choices. F10, the third of the
+// tri-band lamps, is not available.
+const (
+	IlluminantA   Illuminant = iota // A
+	IlluminantD50                   // D50
+	IlluminantD55                   // D55
+	IlluminantD65                   // D65
+	IlluminantD75                   // D75
+	IlluminantE                     // E
+	IlluminantF1                    // F1
+	IlluminantF2                    // F2
+	IlluminantF3                    // F3
+	IlluminantF4                    // F4
+	IlluminantF5                    // F5
+	IlluminantF6                    // F6
+	IlluminantF7                    // F7
+	IlluminantF8                    // F8
+	IlluminantF9                    // F9
+	IlluminantF11                   // F11
+	IlluminantF12                   // F12
+)
+
+// Spectrum returns the relative spectral power distribution of the
+// Illuminant, normalised to a value of 100 at 560nm. It returns a non-nil
+// error if the Illuminant is not recognised.
+func (il Illuminant) Spectrum() (Spectrum, error) {
+	const (
+		// the nominal temperatures of the daylight illuminants are
+		// adjusted for the change in the value of the second radiation
+		// constant since they were defined
+		c2Ratio = 1.4388 / 1.4380
+
+		illuminantAKelvin = 2856
+		fluorescentStart  = 380
+		fluorescentStep   = 5
+		equalEnergy       = 100
+	)
+
+	switch il {
+	case IlluminantA:
+		return BlackbodySpectrum(illuminantAKelvin)
+	case IlluminantD50:
+		return DaylightSpectrum(5000 * c2Ratio) //nolint:mnd
+	case IlluminantD55:
+		return DaylightSpectrum(5500 * c2Ratio) //nolint:mnd
+	case IlluminantD65:
+		return DaylightSpectrum(6500 * c2Ratio) //nolint:mnd
+	case IlluminantD75:
+		return DaylightSpectrum(7500 * c2Ratio) //nolint:mnd
+	case IlluminantE:
+		return MakeSpectrum(MinWavelength, MaxWavelength-MinWavelength,
+			equalEnergy, equalEnergy)
+	}
+
+	if spd, ok := fluorescentIlluminants[il]; ok {
+		return MakeSpectrum(fluorescentStart, fluorescentStep, spd...)
+	}
+
+	return Spectrum{}, fmt.Errorf("bad illuminant: %d", int(il))
+}
+
+// spectrumStep is the interval (in nanometres) between the wavelengths of
+// generated spectra
+const spectrumStep = 5
+
+// BlackbodySpectrum returns the relative spectral power distribution of a
+// blackbody radiator at the given temperature (in Kelvin) from
+// MinWavelength to MaxWavelength, normalised to a value of 100 at
+// 560nm. The temperature must be between MinKelvin and MaxKelvin inclusive,
+// otherwise an error is returned.
+func BlackbodySpectrum(kelvin float64) (Spectrum, error) {
+	if err := checkKelvin(kelvin); err != nil {
+		return Spectrum{}, err
+	}
+
+	const (
+		c2        = 1.4388e7 // the second radiation constant (nm.K)
+		normWL    = 560
+		normPower = 100
+	)
+
+	planck := func(wl float64) float64 {
+		return 1 / (math.Pow(wl, 5) * //nolint:mnd
+			(math.Exp(c2/(wl*kelvin)) - 1))
+	}
+
+	scale := normPower / planck(normWL)
+	values := []float64{}
+
+	for wl := float64(MinWavelength); wl <= MaxWavelength; wl += spectrumStep {
+		values = append(values, scale*planck(wl))
+	}
+
+	return MakeSpectrum(MinWavelength, spectrumStep, values...)
+}
+
+// These constants give the range of temperatures (in Kelvin) for which the
+// CIE daylight illuminant is defined
+const (
+	minDaylightKelvin = 4000
+	maxDaylightKelvin = 25000
+)
+
+// DaylightSpectrum returns the relative spectral power distribution of the
+// CIE daylight illuminant with the given correlated colour temperature (in
+// Kelvin) from MinWavelength to MaxWavelength, normalised to a value of
+// 100 at 560nm. The temperature must be between 4000K and 25000K
+// inclusive, otherwise an error is returned.
+//
+//nolint:mnd
+func DaylightSpectrum(kelvin float64) (Spectrum, error) {
+	if kelvin < minDaylightKelvin {
+		return Spectrum{},
+			fmt.Errorf("the daylight temperature (%.0fK) must be >= %dK",
+				kelvin, minDaylightKelvin)
+	}
+
+	if kelvin > maxDaylightKelvin {
+		return Spectrum{},
+			fmt.Errorf("the daylight temperature (%.0fK) must be <= %dK",
+				kelvin, maxDaylightKelvin)
+	}
+
+	t := 1 / kelvin
+
+	var x float64
+	if kelvin <= 7000 {
+		x = ((-4.6070e9*t+2.9678e6)*t+0.09911e3)*t + 0.244063
+	} else {
+		x = ((-2.0064e9*t+1.9018e6)*t+0.24748e3)*t + 0.237040
+	}
+
+	y := -3*x*x + 2.870*x - 0.275
+
+	m := 0.0241 + 0.2562*x - 0.7341*y
+	m1 := math.Round((-1.3515-1.7703*x+5.9114*y)/m*1000) / 1000
+	m2 := math.Round((0.0300-31.4424*x+30.0717*y)/m*1000) / 1000
+
+	values := []float64{}
+
+	for wl := float64(MinWavelength); wl <= MaxWavelength; wl += spectrumStep {
+		s := daylightComponents.at(wl)
+		values = append(values, s[0]+m1*s[1]+m2*s[2])
+	}
+
+	return MakeSpectrum(MinWavelength, spectrumStep, values...)
+}
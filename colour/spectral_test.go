@@ -0,0 +1,454 @@
+package colour
+
+import (
+	"testing"
+
+	"github.com/nickwells/colour.mod/v2/colourtesthelper"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestMakeSpectrum(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		start  float64
+		step   float64
+		values []float64
+		wl     float64
+		expVal float64
+	}{
+		{
+			ID: testhelper.MkID("bad start"),
+			ExpErr: testhelper.MkExpErr(
+				"the start wavelength (0nm) must be > 0"),
+			step:   10,
+			values: []float64{1, 2},
+		},
+		{
+			ID: testhelper.MkID("bad step"),
+			ExpErr: testhelper.MkExpErr(
+				"the wavelength step (-1nm) must be > 0"),
+			start:  380,
+			step:   -1,
+			values: []float64{1, 2},
+		},
+		{
+			ID: testhelper.MkID("too few values"),
+			ExpErr: testhelper.MkExpErr(
+				"too few spectral values (1), at least 2 are needed"),
+			start:  380,
+			step:   10,
+			values: []float64{1},
+		},
+		{
+			ID: testhelper.MkID("bad value"),
+			ExpErr: testhelper.MkExpErr(
+				"bad spectral value (-1) at 390nm, it must be >= 0"),
+			start:  380,
+			step:   10,
+			values: []float64{1, -1},
+		},
+		{
+			ID:     testhelper.MkID("good: at a sample"),
+			start:  400,
+			step:   10,
+			values: []float64{1, 2, 4},
+			wl:     410,
+			expVal: 2,
+		},
+		{
+			ID:     testhelper.MkID("good: between samples"),
+			start:  400,
+			step:   10,
+			values: []float64{1, 2, 4},
+			wl:     415,
+			expVal: 3,
+		},
+		{
+			ID:     testhelper.MkID("good: last sample"),
+			start:  400,
+			step:   10,
+			values: []float64{1, 2, 4},
+			wl:     420,
+			expVal: 4,
+		},
+		{
+			ID:     testhelper.MkID("good: before the samples"),
+			start:  400,
+			step:   10,
+			values: []float64{1, 2, 4},
+			wl:     390,
+		},
+		{
+			ID:     testhelper.MkID("good: after the samples"),
+			start:  400,
+			step:   10,
+			values: []float64{1, 2, 4},
+			wl:     421,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			s, err := MakeSpectrum(tc.start, tc.step, tc.values...)
+			if testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc) &&
+				err == nil {
+				const epsilon = 0.000001
+				testhelper.DiffFloat(t, tc.IDStr(), "spectral value",
+					s.At(tc.wl), tc.expVal, epsilon)
+				testhelper.DiffFloat(t, tc.IDStr(), "end",
+					s.End(), tc.start+tc.step*float64(len(tc.values)-1),
+					epsilon)
+			}
+		})
+	}
+}
+
+func TestWhitePoint(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		o          Observer
+		il         Illuminant
+		expX, expY float64
+	}{
+		{
+			ID:     testhelper.MkID("bad observer"),
+			ExpErr: testhelper.MkExpErr("bad observer: 99"),
+			o:      Observer(99),
+			il:     IlluminantD65,
+		},
+		{
+			ID:     testhelper.MkID("bad illuminant"),
+			ExpErr: testhelper.MkExpErr("bad illuminant: 99"),
+			o:      CIE1931Observer,
+			il:     Illuminant(99),
+		},
+		{
+			ID:   testhelper.MkID("2 degree: A"),
+			o:    CIE1931Observer,
+			il:   IlluminantA,
+			expX: 0.44757, expY: 0.40745,
+		},
+		{
+			ID:   testhelper.MkID("2 degree: D50"),
+			o:    CIE1931Observer,
+			il:   IlluminantD50,
+			expX: 0.34567, expY: 0.35850,
+		},
+		{
+			ID:   testhelper.MkID("2 degree: D65"),
+			o:    CIE1931Observer,
+			il:   IlluminantD65,
+			expX: 0.31271, expY: 0.32902,
+		},
+		{
+			ID:   testhelper.MkID("2 degree: E"),
+			o:    CIE1931Observer,
+			il:   IlluminantE,
+			expX: 1.0 / 3, expY: 1.0 / 3,
+		},
+		{
+			ID:   testhelper.MkID("2 degree: F1"),
+			o:    CIE1931Observer,
+			il:   IlluminantF1,
+			expX: 0.31310, expY: 0.33727,
+		},
+		{
+			ID:   testhelper.MkID("2 degree: F2"),
+			o:    CIE1931Observer,
+			il:   IlluminantF2,
+			expX: 0.37208, expY: 0.37529,
+		},
+		{
+			ID:   testhelper.MkID("2 degree: F3"),
+			o:    CIE1931Observer,
+			il:   IlluminantF3,
+			expX: 0.40910, expY: 0.39430,
+		},
+		{
+			ID:   testhelper.MkID("2 degree: F4"),
+			o:    CIE1931Observer,
+			il:   IlluminantF4,
+			expX: 0.44018, expY: 0.40329,
+		},
+		{
+			ID:   testhelper.MkID("2 degree: F5"),
+			o:    CIE1931Observer,
+			il:   IlluminantF5,
+			expX: 0.31379, expY: 0.34531,
+		},
+		{
+			ID:   testhelper.MkID("2 degree: F6"),
+			o:    CIE1931Observer,
+			il:   IlluminantF6,
+			expX: 0.37790, expY: 0.38835,
+		},
+		{
+			ID:   testhelper.MkID("2 degree: F7"),
+			o:    CIE1931Observer,
+			il:   IlluminantF7,
+			expX: 0.31292, expY: 0.32933,
+		},
+		{
+			ID:   testhelper.MkID("2 degree: F8"),
+			o:    CIE1931Observer,
+			il:   IlluminantF8,
+			expX: 0.34588, expY: 0.35875,
+		},
+		{
+			ID:   testhelper.MkID("2 degree: F9"),
+			o:    CIE1931Observer,
+			il:   IlluminantF9,
+			expX: 0.37417, expY: 0.37281,
+		},
+		{
+			ID:   testhelper.MkID("2 degree: F11"),
+			o:    CIE1931Observer,
+			il:   IlluminantF11,
+			expX: 0.38052, expY: 0.37713,
+		},
+		{
+			ID:   testhelper.MkID("2 degree: F12"),
+			o:    CIE1931Observer,
+			il:   IlluminantF12,
+			expX: 0.43695, expY: 0.40441,
+		},
+		{
+			ID:   testhelper.MkID("10 degree: D65"),
+			o:    CIE1964Observer,
+			il:   IlluminantD65,
+			expX: 0.31382, expY: 0.33100,
+		},
+		{
+			ID:   testhelper.MkID("10 degree: A"),
+			o:    CIE1964Observer,
+			il:   IlluminantA,
+			expX: 0.45117, expY: 0.40594,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			xyz, err := tc.o.WhitePoint(tc.il)
+			if testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc) &&
+				err == nil {
+				x, y := xyz.Chromaticity()
+
+				const epsilon = 0.0003
+				testhelper.DiffFloat(t, tc.IDStr(), "x", x, tc.expX, epsilon)
+				testhelper.DiffFloat(t, tc.IDStr(), "y", y, tc.expY, epsilon)
+			}
+		})
+	}
+}
+
+func TestReflectanceToRGBA(t *testing.T) {
+	white, _ := MakeSpectrum(380, 400, 1, 1)
+	grey, _ := MakeSpectrum(380, 400, 0.5, 0.5)
+	longPass, _ := MakeSpectrum(380, 10,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1)
+
+	testCases := []struct {
+		testhelper.ID
+		r         Spectrum
+		expColour rgba
+	}{
+		{
+			ID:        testhelper.MkID("white"),
+			r:         white,
+			expColour: rgba{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("grey"),
+			r:         grey,
+			expColour: rgba{R: 0xbc, G: 0xbc, B: 0xbc, A: 0xff},
+		},
+		{
+			ID: testhelper.MkID("red"),
+			r:  longPass,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			for _, il := range []Illuminant{
+				IlluminantA, IlluminantD50, IlluminantD65, IlluminantF11,
+			} {
+				for _, o := range []Observer{CIE1931Observer, CIE1964Observer} {
+					c, err := o.ReflectanceToRGBA(tc.r, il)
+					if err != nil {
+						t.Error(tc.IDStr(), "unexpected error:", err)
+						continue
+					}
+
+					if tc.r.At(MinWavelength) == 0 {
+						if c.R < 0xa0 || c.G > 0x20 || c.B > 0x20 {
+							t.Log(tc.IDStr())
+							t.Logf("\t: %s, %s: %#v", il, o, c)
+							t.Error("\t: the colour should be red")
+						}
+
+						continue
+					}
+
+					colourtesthelper.DiffRGBA(t,
+						tc.IDStr()+": "+il.String()+": "+o.String(),
+						"reflected colour", c, tc.expColour)
+				}
+			}
+		})
+	}
+}
+
+func TestWavelengthToRGBA(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		wl        float64
+		expColour rgba
+	}{
+		{
+			ID: testhelper.MkID("too short"),
+			ExpErr: testhelper.MkExpErr(
+				"the wavelength (379nm) must be >= 380nm"),
+			wl: 379,
+		},
+		{
+			ID: testhelper.MkID("too long"),
+			ExpErr: testhelper.MkExpErr(
+				"the wavelength (781nm) must be <= 780nm"),
+			wl: 781,
+		},
+		{
+			ID:        testhelper.MkID("violet"),
+			wl:        400,
+			expColour: rgba{R: 0x5c, G: 0x00, B: 0xd3, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("blue"),
+			wl:        460,
+			expColour: rgba{R: 0x1a, G: 0x00, B: 0xff, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("green"),
+			wl:        530,
+			expColour: rgba{R: 0x00, G: 0xff, B: 0x00, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("orange"),
+			wl:        600,
+			expColour: rgba{R: 0xff, G: 0x47, B: 0x00, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("deep red"),
+			wl:        740,
+			expColour: rgba{R: 0xd3, G: 0x00, B: 0x00, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("end of the spectrum"),
+			wl:        780,
+			expColour: rgba{R: 0x95, G: 0x00, B: 0x00, A: 0xff},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			c, err := WavelengthToRGBA(tc.wl)
+			if testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc) &&
+				err == nil {
+				colourtesthelper.DiffRGBA(t, tc.IDStr(), "colour",
+					c, tc.expColour)
+			}
+		})
+	}
+}
+
+func TestEmissionToRGBA(t *testing.T) {
+	for _, kelvin := range []float64{2000, 3000, 5000, 10000} {
+		s, err := BlackbodySpectrum(kelvin)
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		c, err := CIE1931Observer.EmissionToRGBA(s)
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		expC, err := KelvinToRGBA(kelvin)
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+
+		if err := Compare(c, expC, 2); err != nil {
+			t.Log("blackbody:", kelvin)
+			t.Errorf("\t: %s", err)
+		}
+	}
+
+	dark, _ := MakeSpectrum(800, 10, 1, 1)
+
+	_, err := CIE1931Observer.EmissionToRGBA(dark)
+	testhelper.DiffErr(t, "no visible light", "error", err, errNoLuminance)
+}
+
+func TestDaylightSpectrum(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		kelvin float64
+	}{
+		{
+			ID: testhelper.MkID("too cold"),
+			ExpErr: testhelper.MkExpErr(
+				"the daylight temperature (3999K) must be >= 4000K"),
+			kelvin: 3999,
+		},
+		{
+			ID: testhelper.MkID("too hot"),
+			ExpErr: testhelper.MkExpErr(
+				"the daylight temperature (25001K) must be <= 25000K"),
+			kelvin: 25001,
+		},
+		{
+			ID:     testhelper.MkID("5000K"),
+			kelvin: 5000,
+		},
+		{
+			ID:     testhelper.MkID("20000K"),
+			kelvin: 20000,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			s, err := DaylightSpectrum(tc.kelvin)
+			if testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc) &&
+				err == nil {
+				const epsilon = 0.01
+				testhelper.DiffFloat(t, tc.IDStr(), "value at 560nm",
+					s.At(560), 100, epsilon)
+
+				c, err := CIE1931Observer.EmissionToRGBA(s)
+				if err != nil {
+					t.Fatal("unexpected error:", err)
+				}
+
+				cct, err := CCT(c)
+				if err != nil {
+					t.Fatal("unexpected error:", err)
+				}
+
+				const pctTolerance = 0.02
+				if d := (cct - tc.kelvin) / tc.kelvin; d > pctTolerance ||
+					d < -pctTolerance {
+					t.Log(tc.IDStr())
+					t.Logf("\t: CCT: %.0fK", cct)
+					t.Error("\t: bad correlated colour temperature")
+				}
+			}
+		})
+	}
+}
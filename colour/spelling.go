@@ -45,11 +45,23 @@ func MakeDistinctNamedColorsFromFamilyColor(fc FamilyColour) []NamedColour {
 	return MakeDistinctNamedColoursFromFamilyColour(fc)
 }
 
+// MakePaintFromNamedColor - see [MakePaintFromNamedColour]
+func MakePaintFromNamedColor(nc NamedColour, parts float64) Paint {
+	return MakePaintFromNamedColour(nc, parts)
+}
+
 // ParseNamedColor - see [ParseNamedColour]
 func ParseNamedColor(fl Families, s string) (NamedColour, error) {
 	return ParseNamedColour(fl, s)
 }
 
+// ParseNamedColorIn - see [ParseNamedColourIn]
+func ParseNamedColorIn(l Language, fl Families, s string) (
+	NamedColour, error,
+) {
+	return ParseNamedColourIn(l, fl, s)
+}
+
 // ColorsMatchingByRegexp - see [ColoursMatchingByRegexp]
 func ColorsMatchingByRegexp(fl Families, re *regexp.Regexp) (
 	[]NamedColour, error,
@@ -114,6 +126,18 @@ func (f Family) Color(cName string) (color.RGBA, error) {
 	return f.Colour(cName)
 }
 
+// ThemedColor - see [Family.ThemedColour]
+func (f Family) ThemedColor(t CSSSystemTheme, cName string) (
+	color.RGBA, error,
+) {
+	return f.ThemedColour(t, cName)
+}
+
+// Color - see [CSSSystemTheme.Colour]
+func (t CSSSystemTheme) Color(name string) (color.RGBA, error) {
+	return t.Colour(name)
+}
+
 // ColorNameCount - see [Family.ColourNameCount]
 func (f Family) ColorNameCount() (int, error) {
 	return f.ColourNameCount()
@@ -124,6 +148,11 @@ func (f Family) DistinctColorCount() (int, error) {
 	return f.DistinctColourCount()
 }
 
+// EvalCSSColor - see [EvalCSSColour]
+func EvalCSSColor(fl Families, s string) (color.RGBA, error) {
+	return EvalCSSColour(fl, s)
+}
+
 // ToGray - see [ToGrey]
 func ToGray(c color.RGBA) color.RGBA {
 	return ToGrey(c)
@@ -160,3 +189,58 @@ func MakeGray(g uint8) color.RGBA {
 func IsAColorAlias(s1, s2 string) (string, bool) {
 	return IsAColourAlias(s1, s2)
 }
+
+// Color - see [Palette.Colour]
+func (p Palette) Color(name string) (color.RGBA, error) {
+	return p.Colour(name)
+}
+
+// ApplyToColors - see [Transform.ApplyToColours]
+func (t Transform) ApplyToColors(cs []color.RGBA) []color.RGBA {
+	return t.ApplyToColours(cs)
+}
+
+// Recolor - see [Families.Recolour]
+func (fl Families) Recolor() (Transform, error) {
+	return fl.Recolour()
+}
+
+// DescribeColorPalette - see [DescribeColourPalette]
+func DescribeColorPalette(p color.Palette) []string {
+	return DescribeColourPalette(p)
+}
+
+// DescribeColorPalette - see [Families.DescribeColourPalette]
+func (fl Families) DescribeColorPalette(p color.Palette) []string {
+	return fl.DescribeColourPalette(p)
+}
+
+// NamedColorPalette - see [NamedColourPalette]
+func NamedColorPalette(ncs []NamedColour) color.Palette {
+	return NamedColourPalette(ncs)
+}
+
+// Colorize - see [TerminalMode.Colourise]
+func (m TerminalMode) Colorize(s string, fg color.RGBA) string {
+	return m.Colourise(s, fg)
+}
+
+// XTerm256Color - see [XTerm256Colour]
+func XTerm256Color(i int) (color.RGBA, error) {
+	return XTerm256Colour(i)
+}
+
+// ANSI16Color - see [ANSI16Colour]
+func ANSI16Color(i int) (color.RGBA, error) {
+	return ANSI16Colour(i)
+}
+
+// CSSSystemColorNames - see [CSSSystemColourNames]
+func CSSSystemColorNames() []string {
+	return CSSSystemColourNames()
+}
+
+// ColorName - see [Language.ColourName]
+func (l Language) ColorName(name string) (string, bool) {
+	return l.ColourName(name)
+}
@@ -0,0 +1,240 @@
+package colour
+
+import (
+	"errors"
+	"fmt"
+	"image/color" //nolint:misspell
+	"math"
+)
+
+// These constants give the range of colour temperatures (in Kelvin) which
+// can be converted into RGBA colours.
+const (
+	MinKelvin = 1000
+	MaxKelvin = 40000
+)
+
+// mired is the number of mireds (micro reciprocal degrees) in the reciprocal
+// of one Kelvin. A temperature in Kelvin can be converted to mireds by
+// dividing this value by the temperature.
+const mired = 1e6
+
+// blackbodyXYZ returns the CIE XYZ value of the light emitted by a
+// blackbody radiator (a Planckian radiator) at the given temperature (in
+// Kelvin). The value is normalised so that Y is 1.
+func blackbodyXYZ(kelvin float64) XYZ {
+	const (
+		c2         = 1.4388e-2 // the second radiation constant (m.K)
+		nmPerMetre = 1e9
+	)
+
+	var xyz [3]float64
+
+	for i, cmf := range cie1931CMF.vals {
+		wavelength := (cie1931CMF.start + float64(i)*cie1931CMF.step) /
+			nmPerMetre
+		power := 1 / (math.Pow(wavelength, 5) * //nolint:mnd
+			(math.Exp(c2/(wavelength*kelvin)) - 1))
+
+		xyz[0] += power * cmf[0]
+		xyz[1] += power * cmf[1]
+		xyz[2] += power * cmf[2]
+	}
+
+	return XYZ{X: xyz[0] / xyz[1], Y: 1, Z: xyz[2] / xyz[1]}
+}
+
+// checkKelvin returns a non-nil error if the temperature is outside the
+// range [MinKelvin, MaxKelvin] or is not a number.
+func checkKelvin(kelvin float64) error {
+	if math.IsNaN(kelvin) {
+		return errors.New("the temperature must be a number")
+	}
+
+	if kelvin < MinKelvin {
+		return fmt.Errorf("the temperature (%.0fK) must be >= %dK",
+			kelvin, MinKelvin)
+	}
+
+	if kelvin > MaxKelvin {
+		return fmt.Errorf("the temperature (%.0fK) must be <= %dK",
+			kelvin, MaxKelvin)
+	}
+
+	return nil
+}
+
+// blackbodyLinearRGB returns the linear sRGB values of the light emitted by
+// a blackbody radiator at the given temperature (in Kelvin). Negative values
+// (for colours outside the sRGB gamut) are clipped to zero.
+func blackbodyLinearRGB(kelvin float64) [3]float64 {
+	lin := xyzToLinearSRGB.apply(blackbodyXYZ(kelvin).vec())
+
+	return [3]float64{max(lin[0], 0), max(lin[1], 0), max(lin[2], 0)}
+}
+
+// KelvinToRGBA returns the colour of a blackbody radiator at the given
+// temperature (in Kelvin). The colour is given at the greatest brightness
+// possible for its chromaticity, so at least one of the red, green or blue
+// values will be at the maximum. Colours outside the sRGB gamut (those with
+// the lowest temperatures) are clipped.
+//
+// The temperature must be between MinKelvin and MaxKelvin inclusive,
+// otherwise an error is returned.
+func KelvinToRGBA(kelvin float64) (color.RGBA, error) { //nolint:misspell
+	if err := checkKelvin(kelvin); err != nil {
+		return rgba{}, err
+	}
+
+	lin := blackbodyLinearRGB(kelvin)
+	brightest := max(lin[0], lin[1], lin[2])
+
+	return linearToRGBA(
+		[3]float64{lin[0] / brightest, lin[1] / brightest, lin[2] / brightest},
+		math.MaxUint8), nil
+}
+
+// errNoTemperature is returned when a colour temperature is requested for
+// black which has no chromaticity
+var errNoTemperature = errors.New(
+	"the colour is black and so has no colour temperature")
+
+// CCTMcCamy returns an estimate of the correlated colour temperature (in
+// Kelvin) of the colour using McCamy's cubic approximation. The estimate is
+// reasonably accurate for colours close to the Planckian locus with
+// temperatures between about 2000K and 12500K. A non-nil error is returned
+// if the colour is black.
+//
+// See also [CCT].
+func CCTMcCamy(c color.RGBA) (float64, error) { //nolint:misspell
+	xyz := RGBA2XYZ(c)
+	if xyz.Y == 0 {
+		return 0, errNoTemperature
+	}
+
+	const (
+		epicentreX = 0.3320
+		epicentreY = 0.1858
+
+		k3 = 449
+		k2 = 3525
+		k1 = 6823.3
+		k0 = 5520.33
+	)
+
+	x, y := xyz.Chromaticity()
+	n := (x - epicentreX) / (epicentreY - y)
+
+	return ((k3*n+k2)*n+k1)*n + k0, nil
+}
+
+// robertsonIsotherm is an entry in the table of isotemperature lines used by
+// Robertson's method. It gives the reciprocal temperature (in mireds), the
+// CIE 1960 UCS u and v coordinates of the point where the isotherm crosses
+// the Planckian locus and the slope of the isotherm.
+type robertsonIsotherm struct {
+	mireds float64
+	u      float64
+	v      float64
+	slope  float64
+}
+
+// robertsonIsotherms is the table of isotemperature lines from Wyszecki and
+// Stiles, Color Science (2nd edition).
+//
+//nolint:mnd
+var robertsonIsotherms = []robertsonIsotherm{
+	{0, 0.18006, 0.26352, -0.24341},
+	{10, 0.18066, 0.26589, -0.25479},
+	{20, 0.18133, 0.26846, -0.26876},
+	{30, 0.18208, 0.27119, -0.28539},
+	{40, 0.18293, 0.27407, -0.30470},
+	{50, 0.18388, 0.27709, -0.32675},
+	{60, 0.18494, 0.28021, -0.35156},
+	{70, 0.18611, 0.28342, -0.37915},
+	{80, 0.18740, 0.28668, -0.40955},
+	{90, 0.18880, 0.28997, -0.44278},
+	{100, 0.19032, 0.29326, -0.47888},
+	{125, 0.19462, 0.30141, -0.58204},
+	{150, 0.19962, 0.30921, -0.70471},
+	{175, 0.20525, 0.31647, -0.84901},
+	{200, 0.21142, 0.32312, -1.0182},
+	{225, 0.21807, 0.32909, -1.2168},
+	{250, 0.22511, 0.33439, -1.4512},
+	{275, 0.23247, 0.33904, -1.7298},
+	{300, 0.24010, 0.34308, -2.0637},
+	{325, 0.24792, 0.34655, -2.4681},
+	{350, 0.25591, 0.34951, -2.9641},
+	{375, 0.26400, 0.35200, -3.5814},
+	{400, 0.27218, 0.35407, -4.3633},
+	{425, 0.28039, 0.35577, -5.3762},
+	{450, 0.28863, 0.35714, -6.7262},
+	{475, 0.29685, 0.35823, -8.5955},
+	{500, 0.30505, 0.35907, -11.324},
+	{525, 0.31320, 0.35968, -15.628},
+	{550, 0.32129, 0.36011, -23.325},
+	{575, 0.32931, 0.36038, -40.770},
+	{600, 0.33724, 0.36051, -116.45},
+}
+
+// CCT returns the correlated colour temperature (in Kelvin) of the colour
+// using Robertson's method. This is the temperature of the blackbody
+// radiator whose colour is closest to the given colour. A non-nil error is
+// returned if the colour is black or if the colour is too far from the
+// Planckian locus or the correlated colour temperature is below about 1667K
+// (600 mireds).
+//
+// See also [CCTMcCamy] and [Duv].
+func CCT(c color.RGBA) (float64, error) { //nolint:misspell
+	xyz := RGBA2XYZ(c)
+	if xyz.Y == 0 {
+		return 0, errNoTemperature
+	}
+
+	u, v := xyz.ucs1960()
+
+	var prevDist, dist float64
+
+	for i, iso := range robertsonIsotherms {
+		prevDist = dist
+		dist = (v - iso.v) - iso.slope*(u-iso.u)
+
+		if i > 0 && (dist < 0) != (prevDist < 0) {
+			prev := robertsonIsotherms[i-1]
+			prevDist /= math.Sqrt(1 + prev.slope*prev.slope)
+			dist /= math.Sqrt(1 + iso.slope*iso.slope)
+			p := prevDist / (prevDist - dist)
+
+			return mired / (prev.mireds + p*(iso.mireds-prev.mireds)), nil
+		}
+	}
+
+	lastIso := robertsonIsotherms[len(robertsonIsotherms)-1]
+
+	return 0, fmt.Errorf(
+		"the correlated colour temperature is outside the range"+
+			" covered by Robertson's method (%.0fK and above)",
+		mired/lastIso.mireds)
+}
+
+// Duv returns the distance of the colour from the Planckian locus in the
+// CIE 1960 UCS colour space. The value is positive for colours above the
+// locus (towards green) and negative for colours below it (towards
+// magenta). A non-nil error is returned if the correlated colour
+// temperature cannot be found (see [CCT]).
+func Duv(c color.RGBA) (float64, error) { //nolint:misspell
+	cct, err := CCT(c)
+	if err != nil {
+		return 0, err
+	}
+
+	u, v := RGBA2XYZ(c).ucs1960()
+	uP, vP := blackbodyXYZ(cct).ucs1960()
+
+	duv := math.Hypot(u-uP, v-vP)
+	if v < vP {
+		return -duv, nil
+	}
+
+	return duv, nil
+}
@@ -0,0 +1,164 @@
+package colour
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/nickwells/colour.mod/v2/colourtesthelper"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestKelvinToRGBA(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		kelvin    float64
+		expColour rgba
+	}{
+		{
+			ID: testhelper.MkID("too cold"),
+			ExpErr: testhelper.MkExpErr(
+				"the temperature (999K) must be >= 1000K"),
+			kelvin: 999,
+		},
+		{
+			ID: testhelper.MkID("too hot"),
+			ExpErr: testhelper.MkExpErr(
+				"the temperature (40001K) must be <= 40000K"),
+			kelvin: 40001,
+		},
+		{
+			ID:     testhelper.MkID("not a number"),
+			ExpErr: testhelper.MkExpErr("the temperature must be a number"),
+			kelvin: math.NaN(),
+		},
+		{
+			ID:        testhelper.MkID("candle"),
+			kelvin:    1900,
+			expColour: rgba{R: 0xff, G: 0x84, B: 0x00, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("incandescent"),
+			kelvin:    2700,
+			expColour: rgba{R: 0xff, G: 0xad, B: 0x59, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("daylight"),
+			kelvin:    6500,
+			expColour: rgba{R: 0xff, G: 0xf8, B: 0xfe, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("blue sky"),
+			kelvin:    10000,
+			expColour: rgba{R: 0xcd, G: 0xd9, B: 0xff, A: 0xff},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			c, err := KelvinToRGBA(tc.kelvin)
+			if testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc) &&
+				err == nil {
+				colourtesthelper.DiffRGBA(t, tc.IDStr(), "colour",
+					c, tc.expColour)
+			}
+		})
+	}
+}
+
+func TestCCT(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		c      rgba
+		expCCT float64
+		expDuv float64
+	}{
+		{
+			ID: testhelper.MkID("black"),
+			ExpErr: testhelper.MkExpErr(
+				"the colour is black and so has no colour temperature"),
+			c: rgba{A: 0xff},
+		},
+		{
+			ID: testhelper.MkID("red"),
+			ExpErr: testhelper.MkExpErr(
+				"the correlated colour temperature is outside the range"),
+			c: rgba{R: 0xff, A: 0xff},
+		},
+		{
+			ID:     testhelper.MkID("white (D65)"),
+			c:      rgba{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+			expCCT: 6504,
+			expDuv: 0.0032,
+		},
+		{
+			ID:     testhelper.MkID("grey (D65)"),
+			c:      rgba{R: 0x80, G: 0x80, B: 0x80, A: 0xff},
+			expCCT: 6504,
+			expDuv: 0.0032,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			cct, err := CCT(tc.c)
+			if testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc) &&
+				err == nil {
+				const epsilon = 5
+				testhelper.DiffFloat(t, tc.IDStr(), "CCT",
+					cct, tc.expCCT, epsilon)
+			}
+
+			duv, err := Duv(tc.c)
+			if testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc) &&
+				err == nil {
+				const epsilon = 0.0001
+				testhelper.DiffFloat(t, tc.IDStr(), "Duv",
+					duv, tc.expDuv, epsilon)
+			}
+		})
+	}
+}
+
+func TestCCTRoundTrip(t *testing.T) {
+	for _, kelvin := range []float64{2000, 3000, 4500, 6500, 9000} {
+		c, err := KelvinToRGBA(kelvin)
+		if err != nil {
+			t.Fatalf("unexpected error converting %.0fK: %s", kelvin, err)
+		}
+
+		id := fmt.Sprintf("round trip: %.0fK", kelvin)
+
+		const pctTolerance = 0.01
+
+		cct, err := CCT(c)
+		if err != nil {
+			t.Error(id, "unexpected error:", err)
+		} else if math.Abs(cct-kelvin)/kelvin > pctTolerance {
+			t.Log(id)
+			t.Logf("\t: expected CCT: %.0fK", kelvin)
+			t.Logf("\t:   actual CCT: %.0fK", cct)
+			t.Error("\t: bad CCT (Robertson)")
+		}
+
+		cct, err = CCTMcCamy(c)
+		if err != nil {
+			t.Error(id, "unexpected error:", err)
+		} else if math.Abs(cct-kelvin)/kelvin > pctTolerance {
+			t.Log(id)
+			t.Logf("\t: expected CCT: %.0fK", kelvin)
+			t.Logf("\t:   actual CCT: %.0fK", cct)
+			t.Error("\t: bad CCT (McCamy)")
+		}
+
+		duv, err := Duv(c)
+		if err != nil {
+			t.Error(id, "unexpected error:", err)
+		} else {
+			const epsilon = 0.001
+			testhelper.DiffFloat(t, id, "Duv", duv, 0, epsilon)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package colour
+
+import (
+	"fmt"
+	"image/color" //nolint:misspell
+	"strings"
+)
+
+// TerminalMode identifies the colours that a terminal can show and so the
+// form of the SGR (Select Graphic Rendition) escape sequences used to set
+// the colour of the text
+type TerminalMode int
+
+// These are the available terminal modes. TermNone shows no colours at all
+// and no escape sequences are generated. TermANSI16 uses the 16 standard
+// ANSI colours, TermXTerm256 uses the xterm 256-colour palette and
+// TermTrueColour sets the exact 24-bit RGB colour.
+const (
+	TermNone       TerminalMode = iota // none
+	TermANSI16                         // ANSI 16
+	TermXTerm256                       // xterm 256
+	TermTrueColour                     // truecolour
+
+	TermTrueColor = TermTrueColour
+)
+
+// SGRReset is the SGR escape sequence which restores the default terminal
+// colours and attributes
+const SGRReset = "\x1b[0m"
+
+// TerminalModeFromEnv returns the TerminalMode to use given the values of
+// the COLORTERM, TERM and NO_COLOR environment variables, which should be
+// passed as empty strings if they are not set. Following the convention
+// described at https://no-color.org a non-empty NO_COLOR value turns
+// colour off. Otherwise a COLORTERM of "truecolor" or "24bit" gives
+// truecolour, a TERM of "dumb" or an empty TERM gives no colour and a TERM
+// ending in "-256color" gives the xterm 256-colour palette. Any other TERM
+// gives the 16 ANSI colours.
+func TerminalModeFromEnv(colorTerm, term, noColor string) TerminalMode {
+	if noColor != "" {
+		return TermNone
+	}
+
+	switch strings.ToLower(colorTerm) {
+	case "truecolor", "24bit":
+		return TermTrueColour
+	}
+
+	term = strings.ToLower(term)
+
+	switch {
+	case term == "" || term == "dumb":
+		return TermNone
+	case strings.HasSuffix(term, "-direct"):
+		return TermTrueColour
+	case strings.HasSuffix(term, "-256color"):
+		return TermXTerm256
+	}
+
+	return TermANSI16
+}
+
+// Foreground returns the SGR escape sequence which sets the text colour to
+// the given colour, or the closest colour that the terminal can show. The
+// closest colour is found in the Oklab colour space. For the xterm
+// 256-colour palette the first 16 colours are not used as terminals often
+// change them. The alpha value is ignored. For TermNone, or any unknown
+// mode, an empty string is returned.
+//
+//nolint:misspell
+func (m TerminalMode) Foreground(c color.RGBA) string {
+	const (
+		fgBase       = 30
+		fgBrightBase = 90
+		fgExtended   = 38
+	)
+
+	return m.sgr(c, fgBase, fgBrightBase, fgExtended)
+}
+
+// Background returns the SGR escape sequence which sets the background
+// colour to the given colour, or the closest colour that the terminal can
+// show. See [TerminalMode.Foreground] for details.
+//
+//nolint:misspell
+func (m TerminalMode) Background(c color.RGBA) string {
+	const (
+		bgBase       = 40
+		bgBrightBase = 100
+		bgExtended   = 48
+	)
+
+	return m.sgr(c, bgBase, bgBrightBase, bgExtended)
+}
+
+// sgr returns the SGR escape sequence for the colour using the codes
+// given. The base code is used for the first 8 ANSI colours, the bright
+// base for the rest and the extended code for the 256-colour and
+// truecolour forms.
+func (m TerminalMode) sgr(c rgba, base, brightBase, extended int) string {
+	const brightStart = 8
+
+	switch m {
+	case TermANSI16:
+		idx := ANSI16Index(c)
+		if idx < brightStart {
+			return fmt.Sprintf("\x1b[%dm", base+idx)
+		}
+
+		return fmt.Sprintf("\x1b[%dm", brightBase+idx-brightStart)
+	case TermXTerm256:
+		return fmt.Sprintf("\x1b[%d;5;%dm", extended, XTerm256Index(c))
+	case TermTrueColour:
+		return fmt.Sprintf("\x1b[%d;2;%d;%d;%dm", extended, c.R, c.G, c.B)
+	}
+
+	return ""
+}
+
+// Reset returns the SGR escape sequence which restores the default
+// terminal colours. For TermNone, or any unknown mode, an empty string is
+// returned.
+func (m TerminalMode) Reset() string {
+	switch m {
+	case TermANSI16, TermXTerm256, TermTrueColour:
+		return SGRReset
+	}
+
+	return ""
+}
+
+// Colourise returns the string with the escape sequences to show it in the
+// given text colour and then reset the colours
+//
+//nolint:misspell
+func (m TerminalMode) Colourise(s string, fg color.RGBA) string {
+	return m.Foreground(fg) + s + m.Reset()
+}
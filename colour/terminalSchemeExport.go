@@ -0,0 +1,220 @@
+package colour
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+)
+
+// SchemeFormat identifies a file format for terminal colour schemes
+type SchemeFormat int
+
+// These are the available scheme formats. ITerm2 is the XML property list
+// used by .itermcolors files, WindowsTerminal is the JSON object used in
+// the "schemes" list of the Windows Terminal settings, Alacritty is the
+// TOML used in the Alacritty configuration file and Xresources is the X
+// resources format used by xterm, urxvt and other X terminals.
+const (
+	ITerm2          SchemeFormat = iota // iTerm2
+	WindowsTerminal                     // Windows Terminal
+	Alacritty                           // Alacritty
+	Xresources                          // Xresources
+)
+
+// hexRGB returns the colour in the form "#rrggbb"
+func hexRGB(c rgba) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
+// Write writes the TerminalScheme to the Writer in the given format. A
+// non-nil error is returned if the format is not known or if the write
+// fails.
+func (ts TerminalScheme) Write(w io.Writer, format SchemeFormat) error {
+	var (
+		s   string
+		err error
+	)
+
+	switch format {
+	case ITerm2:
+		s = ts.iTerm2()
+	case WindowsTerminal:
+		s, err = ts.windowsTerminal()
+	case Alacritty:
+		s = ts.alacritty()
+	case Xresources:
+		s = ts.xresources()
+	default:
+		err = fmt.Errorf("unknown scheme format: %s", format)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, s)
+
+	return err
+}
+
+// WriteTerminalScheme writes the Family's TerminalScheme to the Writer in
+// the given format. A non-nil error is returned if the Family has no
+// TerminalScheme, if the format is not known or if the write fails.
+func (f Family) WriteTerminalScheme(w io.Writer, format SchemeFormat) error {
+	ts, err := f.TerminalScheme()
+	if err != nil {
+		return err
+	}
+
+	return ts.Write(w, format)
+}
+
+// iTerm2 returns the scheme as an iTerm2 .itermcolors property list
+func (ts TerminalScheme) iTerm2() string {
+	var b strings.Builder
+
+	b.WriteString(`<?xml version="1.0" encoding="UTF-8"?>` + "\n" +
+		`<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN"` +
+		` "http://www.apple.com/DTDs/PropertyList-1.0.dtd">` + "\n" +
+		`<plist version="1.0">` + "\n" +
+		"<dict>\n")
+
+	component := func(name string, v uint8) {
+		const maxVal = 255.0
+
+		fmt.Fprintf(&b, "\t\t<key>%s Component</key>\n", name)
+		fmt.Fprintf(&b, "\t\t<real>%.6f</real>\n", float64(v)/maxVal)
+	}
+
+	entry := func(key string, c rgba) {
+		fmt.Fprintf(&b, "\t<key>%s</key>\n\t<dict>\n", key)
+		component("Alpha", math.MaxUint8)
+		component("Blue", c.B)
+		b.WriteString("\t\t<key>Color Space</key>\n" +
+			"\t\t<string>sRGB</string>\n")
+		component("Green", c.G)
+		component("Red", c.R)
+		b.WriteString("\t</dict>\n")
+	}
+
+	for i, c := range ts.ANSI {
+		entry(fmt.Sprintf("Ansi %d Color", i), c)
+	}
+
+	entry("Background Color", ts.Background)
+	entry("Cursor Color", ts.Cursor)
+	entry("Foreground Color", ts.Foreground)
+	entry("Selection Color", ts.Selection)
+
+	b.WriteString("</dict>\n</plist>\n")
+
+	return b.String()
+}
+
+// windowsTerminal returns the scheme as a Windows Terminal scheme object
+func (ts TerminalScheme) windowsTerminal() (string, error) {
+	type wtScheme struct {
+		Name                string `json:"name"`
+		Background          string `json:"background"`
+		Foreground          string `json:"foreground"`
+		CursorColor         string `json:"cursorColor"`
+		SelectionBackground string `json:"selectionBackground"`
+		Black               string `json:"black"`
+		Red                 string `json:"red"`
+		Green               string `json:"green"`
+		Yellow              string `json:"yellow"`
+		Blue                string `json:"blue"`
+		Purple              string `json:"purple"`
+		Cyan                string `json:"cyan"`
+		White               string `json:"white"`
+		BrightBlack         string `json:"brightBlack"`
+		BrightRed           string `json:"brightRed"`
+		BrightGreen         string `json:"brightGreen"`
+		BrightYellow        string `json:"brightYellow"`
+		BrightBlue          string `json:"brightBlue"`
+		BrightPurple        string `json:"brightPurple"`
+		BrightCyan          string `json:"brightCyan"`
+		BrightWhite         string `json:"brightWhite"`
+	}
+
+	a := [ansiColourCount]string{}
+	for i, c := range ts.ANSI {
+		a[i] = hexRGB(c)
+	}
+
+	out, err := json.MarshalIndent(wtScheme{
+		Name:                ts.Name,
+		Background:          hexRGB(ts.Background),
+		Foreground:          hexRGB(ts.Foreground),
+		CursorColor:         hexRGB(ts.Cursor),
+		SelectionBackground: hexRGB(ts.Selection),
+		Black:               a[0],
+		Red:                 a[1],
+		Green:               a[2],
+		Yellow:              a[3],
+		Blue:                a[4],
+		Purple:              a[5],
+		Cyan:                a[6],
+		White:               a[7],
+		BrightBlack:         a[8],
+		BrightRed:           a[9],
+		BrightGreen:         a[10],
+		BrightYellow:        a[11],
+		BrightBlue:          a[12],
+		BrightPurple:        a[13],
+		BrightCyan:          a[14],
+		BrightWhite:         a[15],
+	}, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(out) + "\n", nil
+}
+
+// alacritty returns the scheme as an Alacritty TOML colours table
+func (ts TerminalScheme) alacritty() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# %s\n", ts.Name)
+	fmt.Fprintf(&b, "\n[colors.primary]\n")
+	fmt.Fprintf(&b, "background = %q\n", hexRGB(ts.Background))
+	fmt.Fprintf(&b, "foreground = %q\n", hexRGB(ts.Foreground))
+	fmt.Fprintf(&b, "\n[colors.cursor]\n")
+	fmt.Fprintf(&b, "cursor = %q\n", hexRGB(ts.Cursor))
+	fmt.Fprintf(&b, "text = %q\n", hexRGB(ts.Background))
+	fmt.Fprintf(&b, "\n[colors.selection]\n")
+	fmt.Fprintf(&b, "background = %q\n", hexRGB(ts.Selection))
+	fmt.Fprintf(&b, "text = %q\n", hexRGB(ts.Foreground))
+
+	const baseCount = ansiColourCount / 2
+
+	for i, table := range []string{"normal", "bright"} {
+		fmt.Fprintf(&b, "\n[colors.%s]\n", table)
+
+		for j, name := range ansiColourNames[:baseCount] {
+			fmt.Fprintf(&b, "%s = %q\n", name, hexRGB(ts.ANSI[i*baseCount+j]))
+		}
+	}
+
+	return b.String()
+}
+
+// xresources returns the scheme as X resources. There is no standard
+// resource for the selection colour and so it is not given.
+func (ts TerminalScheme) xresources() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "! %s\n", ts.Name)
+	fmt.Fprintf(&b, "*.foreground: %s\n", hexRGB(ts.Foreground))
+	fmt.Fprintf(&b, "*.background: %s\n", hexRGB(ts.Background))
+	fmt.Fprintf(&b, "*.cursorColor: %s\n", hexRGB(ts.Cursor))
+
+	for i, c := range ts.ANSI {
+		fmt.Fprintf(&b, "*.color%d: %s\n", i, hexRGB(c))
+	}
+
+	return b.String()
+}
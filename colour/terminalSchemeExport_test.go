@@ -0,0 +1,153 @@
+package colour
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestTerminalSchemeWrite(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		f      Family
+		format SchemeFormat
+		expOut string
+	}{
+		{
+			ID:     testhelper.MkID("Xresources"),
+			f:      NordColours,
+			format: Xresources,
+			expOut: "! Nord\n" +
+				"*.foreground: #d8dee9\n" +
+				"*.background: #2e3440\n" +
+				"*.cursorColor: #d8dee9\n" +
+				"*.color0: #3b4252\n" +
+				"*.color1: #bf616a\n" +
+				"*.color2: #a3be8c\n" +
+				"*.color3: #ebcb8b\n" +
+				"*.color4: #81a1c1\n" +
+				"*.color5: #b48ead\n" +
+				"*.color6: #88c0d0\n" +
+				"*.color7: #e5e9f0\n" +
+				"*.color8: #4c566a\n" +
+				"*.color9: #bf616a\n" +
+				"*.color10: #a3be8c\n" +
+				"*.color11: #ebcb8b\n" +
+				"*.color12: #81a1c1\n" +
+				"*.color13: #b48ead\n" +
+				"*.color14: #8fbcbb\n" +
+				"*.color15: #eceff4\n",
+		},
+		{
+			ID:     testhelper.MkID("Alacritty"),
+			f:      NordColours,
+			format: Alacritty,
+			expOut: "# Nord\n" +
+				"\n[colors.primary]\n" +
+				"background = \"#2e3440\"\n" +
+				"foreground = \"#d8dee9\"\n" +
+				"\n[colors.cursor]\n" +
+				"cursor = \"#d8dee9\"\n" +
+				"text = \"#2e3440\"\n" +
+				"\n[colors.selection]\n" +
+				"background = \"#434c5e\"\n" +
+				"text = \"#d8dee9\"\n" +
+				"\n[colors.normal]\n" +
+				"black = \"#3b4252\"\n" +
+				"red = \"#bf616a\"\n" +
+				"green = \"#a3be8c\"\n" +
+				"yellow = \"#ebcb8b\"\n" +
+				"blue = \"#81a1c1\"\n" +
+				"magenta = \"#b48ead\"\n" +
+				"cyan = \"#88c0d0\"\n" +
+				"white = \"#e5e9f0\"\n" +
+				"\n[colors.bright]\n" +
+				"black = \"#4c566a\"\n" +
+				"red = \"#bf616a\"\n" +
+				"green = \"#a3be8c\"\n" +
+				"yellow = \"#ebcb8b\"\n" +
+				"blue = \"#81a1c1\"\n" +
+				"magenta = \"#b48ead\"\n" +
+				"cyan = \"#8fbcbb\"\n" +
+				"white = \"#eceff4\"\n",
+		},
+		{
+			ID:     testhelper.MkID("not a scheme"),
+			ExpErr: testhelper.MkExpErr("is not a terminal colour scheme"),
+			f:      WebColours,
+			format: Xresources,
+		},
+		{
+			ID: testhelper.MkID("bad format"),
+			ExpErr: testhelper.MkExpErr(
+				"unknown scheme format: SchemeFormat(99)"),
+			f:      NordColours,
+			format: SchemeFormat(99),
+		},
+	}
+
+	for _, tc := range testCases {
+		var b strings.Builder
+
+		err := tc.f.WriteTerminalScheme(&b, tc.format)
+		testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc)
+		testhelper.DiffString(t, tc.IDStr(), "output", b.String(), tc.expOut)
+	}
+}
+
+func TestTerminalSchemeWriteWindowsTerminal(t *testing.T) {
+	var b strings.Builder
+
+	err := DraculaColours.WriteTerminalScheme(&b, WindowsTerminal)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	m := map[string]string{}
+	if err := json.Unmarshal([]byte(b.String()), &m); err != nil {
+		t.Fatal("cannot parse the JSON:", err)
+	}
+
+	testhelper.DiffInt(t, "Windows Terminal", "entries", len(m), 21)
+
+	for k, v := range map[string]string{
+		"name":                "Dracula",
+		"background":          "#282a36",
+		"selectionBackground": "#44475a",
+		"purple":              "#ff79c6",
+		"brightWhite":         "#ffffff",
+	} {
+		testhelper.DiffString(t, "Windows Terminal", k, m[k], v)
+	}
+}
+
+func TestTerminalSchemeWriteITerm2(t *testing.T) {
+	var b strings.Builder
+
+	err := GruvboxColours.WriteTerminalScheme(&b, ITerm2)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+
+	out := b.String()
+
+	for _, s := range []string{
+		"<plist version=\"1.0\">\n<dict>\n\t<key>Ansi 0 Color</key>\n",
+		"\t<key>Ansi 15 Color</key>\n",
+		"\t<key>Background Color</key>\n",
+		"\t<key>Selection Color</key>\n",
+		// the red component of the gruvbox background, 0x28
+		"\t\t<key>Red Component</key>\n\t\t<real>0.156863</real>\n",
+		"</dict>\n</plist>\n",
+	} {
+		if !strings.Contains(out, s) {
+			t.Errorf("iTerm2: the output should contain %q", s)
+		}
+	}
+
+	testhelper.DiffInt(t, "iTerm2", "colour entries",
+		strings.Count(out, "<key>Color Space</key>"), 20)
+}
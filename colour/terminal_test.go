@@ -0,0 +1,188 @@
+package colour
+
+import (
+	"testing"
+
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestTerminalModeFromEnv(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		colorTerm string
+		term      string
+		noColor   string
+		expMode   TerminalMode
+	}{
+		{
+			ID:      testhelper.MkID("nothing set"),
+			expMode: TermNone,
+		},
+		{
+			ID:        testhelper.MkID("NO_COLOR overrides all"),
+			colorTerm: "truecolor",
+			term:      "xterm-256color",
+			noColor:   "1",
+			expMode:   TermNone,
+		},
+		{
+			ID:        testhelper.MkID("COLORTERM truecolor"),
+			colorTerm: "truecolor",
+			term:      "xterm",
+			expMode:   TermTrueColour,
+		},
+		{
+			ID:        testhelper.MkID("COLORTERM 24bit"),
+			colorTerm: "24bit",
+			expMode:   TermTrueColour,
+		},
+		{
+			ID:      testhelper.MkID("TERM direct"),
+			term:    "xterm-direct",
+			expMode: TermTrueColour,
+		},
+		{
+			ID:      testhelper.MkID("TERM dumb"),
+			term:    "dumb",
+			expMode: TermNone,
+		},
+		{
+			ID:      testhelper.MkID("TERM 256 colours"),
+			term:    "screen-256color",
+			expMode: TermXTerm256,
+		},
+		{
+			ID:        testhelper.MkID("TERM plain, COLORTERM unknown"),
+			colorTerm: "yes",
+			term:      "xterm",
+			expMode:   TermANSI16,
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.DiffInt(t, tc.IDStr(), "mode",
+			TerminalModeFromEnv(tc.colorTerm, tc.term, tc.noColor),
+			tc.expMode)
+	}
+}
+
+func TestTerminalModeSGR(t *testing.T) {
+	red := rgba{R: 0xff, A: 0xff}
+	orange := rgba{R: 0xff, G: 0x87, A: 0xff}
+	steel := rgba{R: 0x30, G: 0x60, B: 0x90, A: 0x80}
+	grey := rgba{R: 0x80, G: 0x80, B: 0x80, A: 0xff}
+	navy := rgba{B: 0x80, A: 0xff}
+
+	testCases := []struct {
+		testhelper.ID
+		mode  TerminalMode
+		c     rgba
+		expFG string
+		expBG string
+	}{
+		{
+			ID:   testhelper.MkID("none"),
+			mode: TermNone,
+			c:    red,
+		},
+		{
+			ID:   testhelper.MkID("unknown mode"),
+			mode: TerminalMode(99),
+			c:    red,
+		},
+		{
+			ID:    testhelper.MkID("ANSI 16, bright red"),
+			mode:  TermANSI16,
+			c:     red,
+			expFG: "\x1b[91m",
+			expBG: "\x1b[101m",
+		},
+		{
+			ID:    testhelper.MkID("ANSI 16, navy"),
+			mode:  TermANSI16,
+			c:     navy,
+			expFG: "\x1b[34m",
+			expBG: "\x1b[44m",
+		},
+		{
+			ID:    testhelper.MkID("ANSI 16, steel blue"),
+			mode:  TermANSI16,
+			c:     steel,
+			expFG: "\x1b[36m",
+			expBG: "\x1b[46m",
+		},
+		{
+			ID:    testhelper.MkID("ANSI 16, grey"),
+			mode:  TermANSI16,
+			c:     grey,
+			expFG: "\x1b[90m",
+			expBG: "\x1b[100m",
+		},
+		{
+			ID:    testhelper.MkID("xterm 256, red"),
+			mode:  TermXTerm256,
+			c:     red,
+			expFG: "\x1b[38;5;196m",
+			expBG: "\x1b[48;5;196m",
+		},
+		{
+			ID:    testhelper.MkID("xterm 256, orange"),
+			mode:  TermXTerm256,
+			c:     orange,
+			expFG: "\x1b[38;5;208m",
+			expBG: "\x1b[48;5;208m",
+		},
+		{
+			ID:    testhelper.MkID("xterm 256, grey"),
+			mode:  TermXTerm256,
+			c:     grey,
+			expFG: "\x1b[38;5;244m",
+			expBG: "\x1b[48;5;244m",
+		},
+		{
+			ID:    testhelper.MkID("truecolour"),
+			mode:  TermTrueColour,
+			c:     steel,
+			expFG: "\x1b[38;2;48;96;144m",
+			expBG: "\x1b[48;2;48;96;144m",
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.DiffString(t, tc.IDStr(), "foreground",
+			tc.mode.Foreground(tc.c), tc.expFG)
+		testhelper.DiffString(t, tc.IDStr(), "background",
+			tc.mode.Background(tc.c), tc.expBG)
+	}
+}
+
+func TestTerminalModeColourise(t *testing.T) {
+	red := rgba{R: 0xff, A: 0xff}
+
+	testCases := []struct {
+		testhelper.ID
+		mode   TerminalMode
+		expStr string
+	}{
+		{
+			ID:     testhelper.MkID("none"),
+			mode:   TermNone,
+			expStr: "hello",
+		},
+		{
+			ID:     testhelper.MkID("ANSI 16"),
+			mode:   TermANSI16,
+			expStr: "\x1b[91mhello\x1b[0m",
+		},
+		{
+			ID:     testhelper.MkID("truecolour"),
+			mode:   TermTrueColour,
+			expStr: "\x1b[38;2;255;0;0mhello\x1b[0m",
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.DiffString(t, tc.IDStr(), "string",
+			tc.mode.Colourise("hello", red), tc.expStr)
+	}
+}
@@ -106,3 +106,152 @@ func Complement(c color.RGBA) color.RGBA { //nolint:misspell
 
 	return hsl.ToRGBA()
 }
+
+// referenceKelvin is the temperature (in Kelvin) taken as the starting
+// point when shifting colours along the Planckian locus. It is the
+// correlated colour temperature of the D65 white point used by sRGB.
+const referenceKelvin = 6504
+
+// shiftTemperature returns the colour adapted as if the illuminating light
+// had moved along the Planckian locus by the given number of mireds from the
+// reference temperature. The luminance of white is preserved.
+func shiftTemperature(
+	c color.RGBA, mireds float64, //nolint:misspell
+) (
+	color.RGBA, error, //nolint:misspell
+) {
+	targetMireds := mired/referenceKelvin + mireds
+	if targetMireds <= 0 {
+		return c, fmt.Errorf("cannot shift the colour temperature:"+
+			" the shift (%.2f mireds) is too large", math.Abs(mireds))
+	}
+
+	kelvin := mired / targetMireds
+	if err := checkKelvin(kelvin); err != nil {
+		return c, fmt.Errorf("cannot shift the colour temperature: %w", err)
+	}
+
+	from := blackbodyLinearRGB(referenceKelvin)
+	to := blackbodyLinearRGB(kelvin)
+
+	gain := [3]float64{to[0] / from[0], to[1] / from[1], to[2] / from[2]}
+	whiteY := linearSRGBToXYZ.apply(gain)[1]
+
+	lin := rgbLinear(c)
+	for i := range lin {
+		lin[i] *= gain[i] / whiteY
+	}
+
+	return linearToRGBA(lin, c.A), nil
+}
+
+// Warmer returns a colour shifted towards the red end of the Planckian
+// locus. The shift is given in mireds (micro reciprocal degrees) which gives
+// a more perceptually even change than a shift in Kelvin. The colour is
+// adjusted as if the light illuminating it had changed from the D65 white
+// point to a lower colour temperature. The shift must not be negative and
+// the resulting temperature must not be below MinKelvin, otherwise an error
+// is returned.
+func Warmer(
+	c color.RGBA, mireds float64, //nolint:misspell
+) (
+	color.RGBA, error, //nolint:misspell
+) {
+	if mireds < 0 {
+		return c,
+			fmt.Errorf("the temperature shift (%.2f mireds) must be >= 0",
+				mireds)
+	}
+
+	return shiftTemperature(c, mireds)
+}
+
+// Cooler returns a colour shifted towards the blue end of the Planckian
+// locus. The shift is given in mireds (micro reciprocal degrees) which gives
+// a more perceptually even change than a shift in Kelvin. The colour is
+// adjusted as if the light illuminating it had changed from the D65 white
+// point to a higher colour temperature. The shift must not be negative and
+// the resulting temperature must not be above MaxKelvin, otherwise an error
+// is returned.
+func Cooler(
+	c color.RGBA, mireds float64, //nolint:misspell
+) (
+	color.RGBA, error, //nolint:misspell
+) {
+	if mireds < 0 {
+		return c,
+			fmt.Errorf("the temperature shift (%.2f mireds) must be >= 0",
+				mireds)
+	}
+
+	return shiftTemperature(c, -mireds)
+}
+
+// mixOklab returns the colour mixed with the target colour in the Oklab
+// colour space. The amount gives the proportion of the target colour in the
+// mix and must be in the range [0, 1], otherwise an error is returned. The
+// name is used to describe the amount in any error. The alpha value of the
+// colour is preserved.
+func mixOklab(
+	c, target color.RGBA, amount float64, name string, //nolint:misspell
+) (
+	color.RGBA, error, //nolint:misspell
+) {
+	if amount < 0 {
+		return c, fmt.Errorf("the %s amount (%.2f) must be >= 0", name, amount)
+	}
+
+	if amount > 1 {
+		return c, fmt.Errorf("the %s amount (%.2f) must be <= 1", name, amount)
+	}
+
+	from := RGBA2Oklab(c)
+	to := RGBA2Oklab(target)
+
+	mixed := Oklab{
+		L: from.L + (to.L-from.L)*amount,
+		A: from.A + (to.A-from.A)*amount,
+		B: from.B + (to.B-from.B)*amount,
+	}
+
+	return oklabToRGBA(mixed, c.A), nil
+}
+
+// Tint returns the colour mixed with white. The amount gives the proportion
+// of white in the mix, from 0 (the colour is unchanged) to 1 (white). The
+// colours are mixed in the Oklab colour space so that the lightness changes
+// evenly as the amount increases. The alpha value is preserved. If the
+// amount is outside the range [0, 1] an error is returned.
+func Tint(
+	c color.RGBA, amount float64, //nolint:misspell
+) (
+	color.RGBA, error, //nolint:misspell
+) {
+	return mixOklab(c, rgba{R: 0xff, G: 0xff, B: 0xff}, amount, "tint")
+}
+
+// Shade returns the colour mixed with black. The amount gives the proportion
+// of black in the mix, from 0 (the colour is unchanged) to 1 (black). The
+// colours are mixed in the Oklab colour space so that the lightness changes
+// evenly as the amount increases. The alpha value is preserved. If the
+// amount is outside the range [0, 1] an error is returned.
+func Shade(
+	c color.RGBA, amount float64, //nolint:misspell
+) (
+	color.RGBA, error, //nolint:misspell
+) {
+	return mixOklab(c, rgba{}, amount, "shade")
+}
+
+// Tone returns the colour mixed with a mid grey (#808080). The amount gives
+// the proportion of grey in the mix, from 0 (the colour is unchanged) to 1
+// (grey). The colours are mixed in the Oklab colour space. The alpha value
+// is preserved. If the amount is outside the range [0, 1] an error is
+// returned.
+func Tone(
+	c color.RGBA, amount float64, //nolint:misspell
+) (
+	color.RGBA, error, //nolint:misspell
+) {
+	return mixOklab(c, rgba{R: 0x80, G: 0x80, B: 0x80}, amount, "tone")
+}
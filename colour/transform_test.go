@@ -240,3 +240,193 @@ func TestComplement(t *testing.T) {
 		})
 	}
 }
+
+func TestWarmerCooler(t *testing.T) {
+	var (
+		white = rgba{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+		grey  = rgba{R: 0x80, G: 0x80, B: 0x80, A: 0x80}
+	)
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		c         rgba
+		mireds    float64
+		f         func(rgba, float64) (rgba, error)
+		expColour rgba
+	}{
+		{
+			ID: testhelper.MkID("warmer: bad shift"),
+			ExpErr: testhelper.MkExpErr(
+				"the temperature shift (-1.00 mireds) must be >= 0"),
+			c:         white,
+			mireds:    -1,
+			f:         Warmer,
+			expColour: white,
+		},
+		{
+			ID: testhelper.MkID("cooler: bad shift"),
+			ExpErr: testhelper.MkExpErr(
+				"the temperature shift (-1.00 mireds) must be >= 0"),
+			c:         white,
+			mireds:    -1,
+			f:         Cooler,
+			expColour: white,
+		},
+		{
+			ID: testhelper.MkID("warmer: too warm"),
+			ExpErr: testhelper.MkExpErr(
+				"cannot shift the colour temperature",
+				"must be >= 1000K"),
+			c:         white,
+			mireds:    900,
+			f:         Warmer,
+			expColour: white,
+		},
+		{
+			ID: testhelper.MkID("cooler: too cool"),
+			ExpErr: testhelper.MkExpErr(
+				"cannot shift the colour temperature",
+				"must be <= 40000K"),
+			c:         white,
+			mireds:    140,
+			f:         Cooler,
+			expColour: white,
+		},
+		{
+			ID: testhelper.MkID("cooler: infinitely cool"),
+			ExpErr: testhelper.MkExpErr(
+				"cannot shift the colour temperature",
+				"the shift (200.00 mireds) is too large"),
+			c:         white,
+			mireds:    200,
+			f:         Cooler,
+			expColour: white,
+		},
+		{
+			ID:        testhelper.MkID("warmer: no shift"),
+			c:         grey,
+			f:         Warmer,
+			expColour: grey,
+		},
+		{
+			ID:        testhelper.MkID("warmer: white"),
+			c:         white,
+			mireds:    50,
+			f:         Warmer,
+			expColour: rgba{R: 0xff, G: 0xfc, B: 0xdc, A: 0xff},
+		},
+		{
+			ID:        testhelper.MkID("cooler: white"),
+			c:         white,
+			mireds:    50,
+			f:         Cooler,
+			expColour: rgba{R: 0xed, G: 0xff, B: 0xff, A: 0xff},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			c, err := tc.f(tc.c, tc.mireds)
+			testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc)
+			colourtesthelper.DiffRGBA(t, tc.IDStr(), "shifted colour",
+				c, tc.expColour)
+		})
+	}
+
+	for _, mireds := range []float64{10, 50, 100} {
+		warm, _ := Warmer(white, mireds)
+		cool, _ := Cooler(white, mireds)
+
+		if warm.R <= warm.B || cool.B <= cool.R {
+			t.Logf("shift: %.0f mireds", mireds)
+			t.Logf("\t: warmer: %#v", warm)
+			t.Logf("\t: cooler: %#v", cool)
+			t.Error("\t: bad temperature shift")
+		}
+	}
+}
+
+func TestTintShadeTone(t *testing.T) {
+	blue := rgba{R: 0x3b, G: 0x82, B: 0xf6, A: 0x80}
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		amount    float64
+		f         func(rgba, float64) (rgba, error)
+		expColour rgba
+	}{
+		{
+			ID: testhelper.MkID("tint: bad amount: too small"),
+			ExpErr: testhelper.MkExpErr(
+				"the tint amount (-0.10) must be >= 0"),
+			amount:    -0.1,
+			f:         Tint,
+			expColour: blue,
+		},
+		{
+			ID: testhelper.MkID("shade: bad amount: too big"),
+			ExpErr: testhelper.MkExpErr(
+				"the shade amount (1.10) must be <= 1"),
+			amount:    1.1,
+			f:         Shade,
+			expColour: blue,
+		},
+		{
+			ID: testhelper.MkID("tone: bad amount: too big"),
+			ExpErr: testhelper.MkExpErr(
+				"the tone amount (2.00) must be <= 1"),
+			amount:    2,
+			f:         Tone,
+			expColour: blue,
+		},
+		{
+			ID:        testhelper.MkID("tint: none"),
+			f:         Tint,
+			expColour: blue,
+		},
+		{
+			ID:        testhelper.MkID("tint: half"),
+			amount:    0.5,
+			f:         Tint,
+			expColour: rgba{R: 0x9e, G: 0xc3, B: 0xff, A: 0x80},
+		},
+		{
+			ID:        testhelper.MkID("tint: full"),
+			amount:    1,
+			f:         Tint,
+			expColour: rgba{R: 0xff, G: 0xff, B: 0xff, A: 0x80},
+		},
+		{
+			ID:        testhelper.MkID("shade: half"),
+			amount:    0.5,
+			f:         Shade,
+			expColour: rgba{R: 0x11, G: 0x2f, B: 0x5f, A: 0x80},
+		},
+		{
+			ID:        testhelper.MkID("shade: full"),
+			amount:    1,
+			f:         Shade,
+			expColour: rgba{A: 0x80},
+		},
+		{
+			ID:        testhelper.MkID("tone: half"),
+			amount:    0.5,
+			f:         Tone,
+			expColour: rgba{R: 0x62, G: 0x84, B: 0xbc, A: 0x80},
+		},
+		{
+			ID:        testhelper.MkID("tone: full"),
+			amount:    1,
+			f:         Tone,
+			expColour: rgba{R: 0x80, G: 0x80, B: 0x80, A: 0x80},
+		},
+	}
+
+	for _, tc := range testCases {
+		c, err := tc.f(blue, tc.amount)
+		testhelper.CheckExpErrWithID(t, tc.IDStr(), err, tc)
+		colourtesthelper.DiffRGBA(t, tc.IDStr(), "colour", c, tc.expColour)
+	}
+}
@@ -0,0 +1,218 @@
+package colour
+
+import (
+	"fmt"
+	"image/color" //nolint:misspell
+	"math"
+)
+
+// matrix3 is a 3x3 matrix used for linear transformations between colour
+// spaces
+type matrix3 [3][3]float64
+
+// apply returns the result of multiplying the vector by the matrix
+func (m matrix3) apply(v [3]float64) [3]float64 {
+	return [3]float64{
+		m[0][0]*v[0] + m[0][1]*v[1] + m[0][2]*v[2],
+		m[1][0]*v[0] + m[1][1]*v[1] + m[1][2]*v[2],
+		m[2][0]*v[0] + m[2][1]*v[1] + m[2][2]*v[2],
+	}
+}
+
+// mul returns the matrix product m x o
+func (m matrix3) mul(o matrix3) matrix3 {
+	var p matrix3
+
+	for i := range 3 {
+		for j := range 3 {
+			for k := range 3 {
+				p[i][j] += m[i][k] * o[k][j]
+			}
+		}
+	}
+
+	return p
+}
+
+// inverse returns the inverse of the matrix. The matrix must not be
+// singular.
+func (m matrix3) inverse() matrix3 {
+	cofactor := func(r0, r1, c0, c1 int) float64 {
+		return m[r0][c0]*m[r1][c1] - m[r0][c1]*m[r1][c0]
+	}
+
+	adj := matrix3{
+		{cofactor(1, 2, 1, 2), -cofactor(0, 2, 1, 2), cofactor(0, 1, 1, 2)},
+		{-cofactor(1, 2, 0, 2), cofactor(0, 2, 0, 2), -cofactor(0, 1, 0, 2)},
+		{cofactor(1, 2, 0, 1), -cofactor(0, 2, 0, 1), cofactor(0, 1, 0, 1)},
+	}
+
+	det := m[0][0]*adj[0][0] + m[0][1]*adj[1][0] + m[0][2]*adj[2][0]
+
+	for i := range 3 {
+		for j := range 3 {
+			adj[i][j] /= det
+		}
+	}
+
+	return adj
+}
+
+// diagonal returns a matrix with the given values on the diagonal and zero
+// elsewhere
+func diagonal(v [3]float64) matrix3 {
+	return matrix3{
+		{v[0], 0, 0},
+		{0, v[1], 0},
+		{0, 0, v[2]},
+	}
+}
+
+// The matrices for converting between linear sRGB values and CIE XYZ
+// values. The reference white is D65.
+//
+//nolint:mnd
+var (
+	linearSRGBToXYZ = matrix3{
+		{0.4124564, 0.3575761, 0.1804375},
+		{0.2126729, 0.7151522, 0.0721750},
+		{0.0193339, 0.1191920, 0.9503041},
+	}
+	xyzToLinearSRGB = matrix3{
+		{3.2404542, -1.5371385, -0.4985314},
+		{-0.9692660, 1.8760108, 0.0415560},
+		{0.0556434, -0.2040259, 1.0572252},
+	}
+)
+
+// srgbToLinear converts a gamma-encoded sRGB component value in the range
+// [0, 1] into a linear light value.
+func srgbToLinear(v float64) float64 {
+	const (
+		threshold = 0.04045
+		linScale  = 12.92
+		offset    = 0.055
+		scale     = 1.055
+		gamma     = 2.4
+	)
+
+	if v <= threshold {
+		return v / linScale
+	}
+
+	return math.Pow((v+offset)/scale, gamma)
+}
+
+// linearToSRGB converts a linear light value in the range [0, 1] into a
+// gamma-encoded sRGB component value.
+func linearToSRGB(v float64) float64 {
+	const (
+		threshold = 0.0031308
+		linScale  = 12.92
+		offset    = 0.055
+		scale     = 1.055
+		gamma     = 2.4
+	)
+
+	if v <= threshold {
+		return v * linScale
+	}
+
+	return scale*math.Pow(v, 1/gamma) - offset
+}
+
+// rgbLinear generates linear light red, green and blue values (in that
+// order) from an RGBA colour value.
+func rgbLinear(c color.RGBA) [3]float64 { //nolint:misspell
+	r, g, b := rgbNormalised(c)
+
+	return [3]float64{srgbToLinear(r), srgbToLinear(g), srgbToLinear(b)}
+}
+
+// linearToRGBA converts the linear light red, green and blue values into an
+// RGBA colour with the given alpha value. Values outside the range [0, 1]
+// are clipped.
+func linearToRGBA(lin [3]float64, a uint8) rgba {
+	return rgba{
+		R: toUint8(linearToSRGB(min(max(lin[0], 0), 1)) * math.MaxUint8),
+		G: toUint8(linearToSRGB(min(max(lin[1], 0), 1)) * math.MaxUint8),
+		B: toUint8(linearToSRGB(min(max(lin[2], 0), 1)) * math.MaxUint8),
+		A: a,
+	}
+}
+
+// XYZ represents a colour in the CIE 1931 XYZ colour space. The reference
+// white is D65 and the Y value of the reference white is 1.
+type XYZ struct {
+	// X is a mix of the cone responses, chosen to be non-negative
+	X float64
+	// Y is the relative luminance
+	Y float64
+	// Z is approximately the response of the short wavelength cones
+	Z float64
+}
+
+// String returns a string representation of the XYZ value
+func (xyz XYZ) String() string {
+	return fmt.Sprintf("{X:%0.4f Y:%0.4f Z:%0.4f}", xyz.X, xyz.Y, xyz.Z)
+}
+
+// vec returns the XYZ value as a vector
+func (xyz XYZ) vec() [3]float64 {
+	return [3]float64{xyz.X, xyz.Y, xyz.Z}
+}
+
+// makeXYZ returns the vector as an XYZ value
+func makeXYZ(v [3]float64) XYZ {
+	return XYZ{X: v[0], Y: v[1], Z: v[2]}
+}
+
+// RGBA2XYZ converts an RGBA colour value into a CIE XYZ value. The RGBA
+// value is taken to be in the sRGB colour space.
+func RGBA2XYZ(c color.RGBA) XYZ { //nolint:misspell
+	return makeXYZ(linearSRGBToXYZ.apply(rgbLinear(c)))
+}
+
+// ToRGBA converts an XYZ colour value into an RGBA value in the sRGB colour
+// space. The alpha value is forced to 0xff. Colours outside the sRGB gamut
+// are clipped.
+func (xyz XYZ) ToRGBA() color.RGBA { //nolint:misspell
+	return linearToRGBA(xyzToLinearSRGB.apply(xyz.vec()), math.MaxUint8)
+}
+
+// RGBA satisfies the Color interface from the [image/color] package
+//
+//nolint:misspell
+func (xyz XYZ) RGBA() (r, g, b, a uint32) {
+	c := xyz.ToRGBA()
+	return c.RGBA()
+}
+
+// Chromaticity returns the CIE 1931 x and y chromaticity coordinates of the
+// colour. The values are both zero for black.
+func (xyz XYZ) Chromaticity() (x, y float64) {
+	sum := xyz.X + xyz.Y + xyz.Z
+	if sum == 0 {
+		return 0, 0
+	}
+
+	return xyz.X / sum, xyz.Y / sum
+}
+
+// ucs1960 returns the CIE 1960 UCS u and v chromaticity coordinates of the
+// colour. The values are both zero for black.
+func (xyz XYZ) ucs1960() (u, v float64) {
+	const (
+		uScale = 4
+		vScale = 6
+		yWt    = 15
+		zWt    = 3
+	)
+
+	denom := xyz.X + yWt*xyz.Y + zWt*xyz.Z
+	if denom == 0 {
+		return 0, 0
+	}
+
+	return uScale * xyz.X / denom, vScale * xyz.Y / denom
+}
@@ -0,0 +1,82 @@
+package colour
+
+import (
+	"testing"
+
+	"github.com/nickwells/colour.mod/v2/colourtesthelper"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestRGBA2XYZ(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		c      rgba
+		expXYZ XYZ
+	}{
+		{
+			ID:     testhelper.MkID("black"),
+			c:      rgba{A: 0xff},
+			expXYZ: XYZ{},
+		},
+		{
+			ID:     testhelper.MkID("white"),
+			c:      rgba{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+			expXYZ: XYZ{X: 0.9505, Y: 1, Z: 1.0888},
+		},
+		{
+			ID:     testhelper.MkID("red"),
+			c:      rgba{R: 0xff, A: 0xff},
+			expXYZ: XYZ{X: 0.4125, Y: 0.2127, Z: 0.0193},
+		},
+		{
+			ID:     testhelper.MkID("grey"),
+			c:      rgba{R: 0x80, G: 0x80, B: 0x80, A: 0xff},
+			expXYZ: XYZ{X: 0.2052, Y: 0.2159, Z: 0.2351},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			xyz := RGBA2XYZ(tc.c)
+
+			const epsilon = 0.0001
+			testhelper.DiffFloat(t, tc.IDStr(), "X",
+				xyz.X, tc.expXYZ.X, epsilon)
+			testhelper.DiffFloat(t, tc.IDStr(), "Y",
+				xyz.Y, tc.expXYZ.Y, epsilon)
+			testhelper.DiffFloat(t, tc.IDStr(), "Z",
+				xyz.Z, tc.expXYZ.Z, epsilon)
+
+			colourtesthelper.DiffRGBA(t, tc.IDStr(), "round trip",
+				xyz.ToRGBA(), tc.c)
+		})
+	}
+}
+
+func TestXYZChromaticity(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		xyz  XYZ
+		expX float64
+		expY float64
+	}{
+		{
+			ID:  testhelper.MkID("black"),
+			xyz: XYZ{},
+		},
+		{
+			ID:   testhelper.MkID("D65"),
+			xyz:  XYZ{X: 0.95047, Y: 1, Z: 1.08883},
+			expX: 0.3127,
+			expY: 0.3290,
+		},
+	}
+
+	for _, tc := range testCases {
+		x, y := tc.xyz.Chromaticity()
+
+		const epsilon = 0.0001
+		testhelper.DiffFloat(t, tc.IDStr(), "x", x, tc.expX, epsilon)
+		testhelper.DiffFloat(t, tc.IDStr(), "y", y, tc.expY, epsilon)
+	}
+}